@@ -0,0 +1,142 @@
+package approval_chains
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles approval chain HTTP requests
+type ApprovalChainHandler struct {
+	chainService interfaces.ApprovalChainService
+}
+
+// creates a new ApprovalChainHandler instance
+func NewApprovalChainHandler(ctx context.Context, chainService interfaces.ApprovalChainService) *ApprovalChainHandler {
+	return &ApprovalChainHandler{chainService: chainService}
+}
+
+func (h *ApprovalChainHandler) CreateChain(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleChainError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	var chain models.ApprovalChain
+	if err := c.ShouldBindJSON(&chain); err != nil {
+		handleChainError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	created, err := h.chainService.CreateChain(ctx, role, chain)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Created(c, "Approval chain created successfully", created)
+}
+
+func (h *ApprovalChainHandler) GetChains(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleChainError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	chains, err := h.chainService.GetChains(ctx, role)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "Approval chains fetched successfully", chains)
+}
+
+func (h *ApprovalChainHandler) DeleteChain(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleChainError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	chainID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleChainError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.chainService.DeleteChain(ctx, role, chainID); err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "Approval chain deleted successfully", nil)
+}
+
+func (h *ApprovalChainHandler) GetLeaveSteps(c *gin.Context) {
+	h.getRequestSteps(c, "LEAVE")
+}
+
+func (h *ApprovalChainHandler) GetExpenseSteps(c *gin.Context) {
+	h.getRequestSteps(c, "EXPENSE")
+}
+
+func (h *ApprovalChainHandler) GetDiscountSteps(c *gin.Context) {
+	h.getRequestSteps(c, "DISCOUNT")
+}
+
+func (h *ApprovalChainHandler) getRequestSteps(c *gin.Context, requestType string) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleChainError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	steps, err := h.chainService.GetRequestSteps(ctx, role, userID, requestType, requestID)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "Approval steps fetched successfully", steps)
+}
+
+func handleChainError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrApprovalChainNotFound, apperrors.ErrLeaveRequestNotFound,
+		apperrors.ErrExpenseRequestNotFound, apperrors.ErrDiscountRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID,
+		apperrors.ErrChainNameRequired, apperrors.ErrInvalidRequestType,
+		apperrors.ErrNegativeValue, apperrors.ErrInvalidAmountBand,
+		apperrors.ErrChainStepsRequired, apperrors.ErrInvalidApproverType,
+		apperrors.ErrApproverTargetRequired, apperrors.ErrInvalidRouteTarget,
+		apperrors.ErrUserNotFound:
+		status = http.StatusBadRequest
+	case apperrors.ErrApprovalChainOverlap:
+		status = http.StatusConflict
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,860 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApprovalChainRepository is an autogenerated mock type for the ApprovalChainRepository type
+type ApprovalChainRepository struct {
+	mock.Mock
+}
+
+type ApprovalChainRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ApprovalChainRepository) EXPECT() *ApprovalChainRepository_Expecter {
+	return &ApprovalChainRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tx, chain
+func (_m *ApprovalChainRepository) Create(ctx context.Context, tx interfaces.Tx, chain *models.ApprovalChain) error {
+	ret := _m.Called(ctx, tx, chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.ApprovalChain) error); ok {
+		r0 = rf(ctx, tx, chain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ApprovalChainRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - chain *models.ApprovalChain
+func (_e *ApprovalChainRepository_Expecter) Create(ctx interface{}, tx interface{}, chain interface{}) *ApprovalChainRepository_Create_Call {
+	return &ApprovalChainRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, chain)}
+}
+
+func (_c *ApprovalChainRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, chain *models.ApprovalChain)) *ApprovalChainRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.ApprovalChain))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Create_Call) Return(_a0 error) *ApprovalChainRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.ApprovalChain) error) *ApprovalChainRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRequestSteps provides a mock function with given fields: ctx, tx, steps
+func (_m *ApprovalChainRepository) CreateRequestSteps(ctx context.Context, tx interfaces.Tx, steps []models.RequestApprovalStep) error {
+	ret := _m.Called(ctx, tx, steps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequestSteps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, []models.RequestApprovalStep) error); ok {
+		r0 = rf(ctx, tx, steps)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_CreateRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRequestSteps'
+type ApprovalChainRepository_CreateRequestSteps_Call struct {
+	*mock.Call
+}
+
+// CreateRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - steps []models.RequestApprovalStep
+func (_e *ApprovalChainRepository_Expecter) CreateRequestSteps(ctx interface{}, tx interface{}, steps interface{}) *ApprovalChainRepository_CreateRequestSteps_Call {
+	return &ApprovalChainRepository_CreateRequestSteps_Call{Call: _e.mock.On("CreateRequestSteps", ctx, tx, steps)}
+}
+
+func (_c *ApprovalChainRepository_CreateRequestSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, steps []models.RequestApprovalStep)) *ApprovalChainRepository_CreateRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].([]models.RequestApprovalStep))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_CreateRequestSteps_Call) Return(_a0 error) *ApprovalChainRepository_CreateRequestSteps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_CreateRequestSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, []models.RequestApprovalStep) error) *ApprovalChainRepository_CreateRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deactivate provides a mock function with given fields: ctx, chainID
+func (_m *ApprovalChainRepository) Deactivate(ctx context.Context, chainID int64) error {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deactivate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_Deactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deactivate'
+type ApprovalChainRepository_Deactivate_Call struct {
+	*mock.Call
+}
+
+// Deactivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID int64
+func (_e *ApprovalChainRepository_Expecter) Deactivate(ctx interface{}, chainID interface{}) *ApprovalChainRepository_Deactivate_Call {
+	return &ApprovalChainRepository_Deactivate_Call{Call: _e.mock.On("Deactivate", ctx, chainID)}
+}
+
+func (_c *ApprovalChainRepository_Deactivate_Call) Run(run func(ctx context.Context, chainID int64)) *ApprovalChainRepository_Deactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Deactivate_Call) Return(_a0 error) *ApprovalChainRepository_Deactivate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Deactivate_Call) RunAndReturn(run func(context.Context, int64) error) *ApprovalChainRepository_Deactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecideStep provides a mock function with given fields: ctx, tx, stepID, status, decidedBy, delegation, comment
+func (_m *ApprovalChainRepository) DecideStep(ctx context.Context, tx interfaces.Tx, stepID int64, status string, decidedBy int64, delegation *models.ApprovalDelegation, comment string) error {
+	ret := _m.Called(ctx, tx, stepID, status, decidedBy, delegation, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64, *models.ApprovalDelegation, string) error); ok {
+		r0 = rf(ctx, tx, stepID, status, decidedBy, delegation, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_DecideStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecideStep'
+type ApprovalChainRepository_DecideStep_Call struct {
+	*mock.Call
+}
+
+// DecideStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - stepID int64
+//   - status string
+//   - decidedBy int64
+//   - delegation *models.ApprovalDelegation
+//   - comment string
+func (_e *ApprovalChainRepository_Expecter) DecideStep(ctx interface{}, tx interface{}, stepID interface{}, status interface{}, decidedBy interface{}, delegation interface{}, comment interface{}) *ApprovalChainRepository_DecideStep_Call {
+	return &ApprovalChainRepository_DecideStep_Call{Call: _e.mock.On("DecideStep", ctx, tx, stepID, status, decidedBy, delegation, comment)}
+}
+
+func (_c *ApprovalChainRepository_DecideStep_Call) Run(run func(ctx context.Context, tx interfaces.Tx, stepID int64, status string, decidedBy int64, delegation *models.ApprovalDelegation, comment string)) *ApprovalChainRepository_DecideStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(int64), args[5].(*models.ApprovalDelegation), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_DecideStep_Call) Return(_a0 error) *ApprovalChainRepository_DecideStep_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_DecideStep_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, int64, *models.ApprovalDelegation, string) error) *ApprovalChainRepository_DecideStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveForAmount provides a mock function with given fields: ctx, tx, requestType, amount
+func (_m *ApprovalChainRepository) GetActiveForAmount(ctx context.Context, tx interfaces.Tx, requestType string, amount float64) (*models.ApprovalChain, error) {
+	ret := _m.Called(ctx, tx, requestType, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveForAmount")
+	}
+
+	var r0 *models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, float64) (*models.ApprovalChain, error)); ok {
+		return rf(ctx, tx, requestType, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, float64) *models.ApprovalChain); ok {
+		r0 = rf(ctx, tx, requestType, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, float64) error); ok {
+		r1 = rf(ctx, tx, requestType, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetActiveForAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveForAmount'
+type ApprovalChainRepository_GetActiveForAmount_Call struct {
+	*mock.Call
+}
+
+// GetActiveForAmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - amount float64
+func (_e *ApprovalChainRepository_Expecter) GetActiveForAmount(ctx interface{}, tx interface{}, requestType interface{}, amount interface{}) *ApprovalChainRepository_GetActiveForAmount_Call {
+	return &ApprovalChainRepository_GetActiveForAmount_Call{Call: _e.mock.On("GetActiveForAmount", ctx, tx, requestType, amount)}
+}
+
+func (_c *ApprovalChainRepository_GetActiveForAmount_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, amount float64)) *ApprovalChainRepository_GetActiveForAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetActiveForAmount_Call) Return(_a0 *models.ApprovalChain, _a1 error) *ApprovalChainRepository_GetActiveForAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetActiveForAmount_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, float64) (*models.ApprovalChain, error)) *ApprovalChainRepository_GetActiveForAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ApprovalChainRepository) GetAll(ctx context.Context) ([]models.ApprovalChain, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ApprovalChain, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ApprovalChain); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ApprovalChainRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ApprovalChainRepository_Expecter) GetAll(ctx interface{}) *ApprovalChainRepository_GetAll_Call {
+	return &ApprovalChainRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *ApprovalChainRepository_GetAll_Call) Run(run func(ctx context.Context)) *ApprovalChainRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetAll_Call) Return(_a0 []models.ApprovalChain, _a1 error) *ApprovalChainRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.ApprovalChain, error)) *ApprovalChainRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingRequestSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) GetPendingRequestSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingRequestSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetPendingRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingRequestSteps'
+type ApprovalChainRepository_GetPendingRequestSteps_Call struct {
+	*mock.Call
+}
+
+// GetPendingRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) GetPendingRequestSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_GetPendingRequestSteps_Call {
+	return &ApprovalChainRepository_GetPendingRequestSteps_Call{Call: _e.mock.On("GetPendingRequestSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_GetPendingRequestSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_GetPendingRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetPendingRequestSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainRepository_GetPendingRequestSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetPendingRequestSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainRepository_GetPendingRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestOwner provides a mock function with given fields: ctx, requestType, requestID
+func (_m *ApprovalChainRepository) GetRequestOwner(ctx context.Context, requestType string, requestID int64) (int64, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestOwner")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (int64, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) int64); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetRequestOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestOwner'
+type ApprovalChainRepository_GetRequestOwner_Call struct {
+	*mock.Call
+}
+
+// GetRequestOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) GetRequestOwner(ctx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_GetRequestOwner_Call {
+	return &ApprovalChainRepository_GetRequestOwner_Call{Call: _e.mock.On("GetRequestOwner", ctx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_GetRequestOwner_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *ApprovalChainRepository_GetRequestOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetRequestOwner_Call) Return(_a0 int64, _a1 error) *ApprovalChainRepository_GetRequestOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetRequestOwner_Call) RunAndReturn(run func(context.Context, string, int64) (int64, error)) *ApprovalChainRepository_GetRequestOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestSteps provides a mock function with given fields: ctx, requestType, requestID
+func (_m *ApprovalChainRepository) GetRequestSteps(ctx context.Context, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestSteps'
+type ApprovalChainRepository_GetRequestSteps_Call struct {
+	*mock.Call
+}
+
+// GetRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) GetRequestSteps(ctx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_GetRequestSteps_Call {
+	return &ApprovalChainRepository_GetRequestSteps_Call{Call: _e.mock.On("GetRequestSteps", ctx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_GetRequestSteps_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *ApprovalChainRepository_GetRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetRequestSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainRepository_GetRequestSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetRequestSteps_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainRepository_GetRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStepApprovers provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) GetStepApprovers(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) ([]int64, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStepApprovers")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) ([]int64, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) []int64); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetStepApprovers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStepApprovers'
+type ApprovalChainRepository_GetStepApprovers_Call struct {
+	*mock.Call
+}
+
+// GetStepApprovers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) GetStepApprovers(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_GetStepApprovers_Call {
+	return &ApprovalChainRepository_GetStepApprovers_Call{Call: _e.mock.On("GetStepApprovers", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_GetStepApprovers_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_GetStepApprovers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetStepApprovers_Call) Return(_a0 []int64, _a1 error) *ApprovalChainRepository_GetStepApprovers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetStepApprovers_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) ([]int64, error)) *ApprovalChainRepository_GetStepApprovers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasRequestSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) HasRequestSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (bool, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasRequestSteps")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) (bool, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) bool); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_HasRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRequestSteps'
+type ApprovalChainRepository_HasRequestSteps_Call struct {
+	*mock.Call
+}
+
+// HasRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) HasRequestSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_HasRequestSteps_Call {
+	return &ApprovalChainRepository_HasRequestSteps_Call{Call: _e.mock.On("HasRequestSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_HasRequestSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_HasRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_HasRequestSteps_Call) Return(_a0 bool, _a1 error) *ApprovalChainRepository_HasRequestSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_HasRequestSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) (bool, error)) *ApprovalChainRepository_HasRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockActiveByType provides a mock function with given fields: ctx, tx, requestType
+func (_m *ApprovalChainRepository) LockActiveByType(ctx context.Context, tx interfaces.Tx, requestType string) ([]models.ApprovalChain, error) {
+	ret := _m.Called(ctx, tx, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockActiveByType")
+	}
+
+	var r0 []models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) ([]models.ApprovalChain, error)); ok {
+		return rf(ctx, tx, requestType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) []models.ApprovalChain); ok {
+		r0 = rf(ctx, tx, requestType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string) error); ok {
+		r1 = rf(ctx, tx, requestType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_LockActiveByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockActiveByType'
+type ApprovalChainRepository_LockActiveByType_Call struct {
+	*mock.Call
+}
+
+// LockActiveByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+func (_e *ApprovalChainRepository_Expecter) LockActiveByType(ctx interface{}, tx interface{}, requestType interface{}) *ApprovalChainRepository_LockActiveByType_Call {
+	return &ApprovalChainRepository_LockActiveByType_Call{Call: _e.mock.On("LockActiveByType", ctx, tx, requestType)}
+}
+
+func (_c *ApprovalChainRepository_LockActiveByType_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string)) *ApprovalChainRepository_LockActiveByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_LockActiveByType_Call) Return(_a0 []models.ApprovalChain, _a1 error) *ApprovalChainRepository_LockActiveByType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_LockActiveByType_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string) ([]models.ApprovalChain, error)) *ApprovalChainRepository_LockActiveByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RouteRequest provides a mock function with given fields: ctx, tx, requestType, requestID, role, userID
+func (_m *ApprovalChainRepository) RouteRequest(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, role string, userID *int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, role, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, string, *int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, role, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_RouteRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteRequest'
+type ApprovalChainRepository_RouteRequest_Call struct {
+	*mock.Call
+}
+
+// RouteRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - role string
+//   - userID *int64
+func (_e *ApprovalChainRepository_Expecter) RouteRequest(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, role interface{}, userID interface{}) *ApprovalChainRepository_RouteRequest_Call {
+	return &ApprovalChainRepository_RouteRequest_Call{Call: _e.mock.On("RouteRequest", ctx, tx, requestType, requestID, role, userID)}
+}
+
+func (_c *ApprovalChainRepository_RouteRequest_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, role string, userID *int64)) *ApprovalChainRepository_RouteRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(string), args[5].(*int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_RouteRequest_Call) Return(_a0 error) *ApprovalChainRepository_RouteRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_RouteRequest_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, string, *int64) error) *ApprovalChainRepository_RouteRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SkipPendingSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) SkipPendingSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipPendingSteps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_SkipPendingSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkipPendingSteps'
+type ApprovalChainRepository_SkipPendingSteps_Call struct {
+	*mock.Call
+}
+
+// SkipPendingSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) SkipPendingSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_SkipPendingSteps_Call {
+	return &ApprovalChainRepository_SkipPendingSteps_Call{Call: _e.mock.On("SkipPendingSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_SkipPendingSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_SkipPendingSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_SkipPendingSteps_Call) Return(_a0 error) *ApprovalChainRepository_SkipPendingSteps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_SkipPendingSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) error) *ApprovalChainRepository_SkipPendingSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SkipPendingStepsForRequests provides a mock function with given fields: ctx, tx, requestType, requestIDs
+func (_m *ApprovalChainRepository) SkipPendingStepsForRequests(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipPendingStepsForRequests")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, []int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_SkipPendingStepsForRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkipPendingStepsForRequests'
+type ApprovalChainRepository_SkipPendingStepsForRequests_Call struct {
+	*mock.Call
+}
+
+// SkipPendingStepsForRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestIDs []int64
+func (_e *ApprovalChainRepository_Expecter) SkipPendingStepsForRequests(ctx interface{}, tx interface{}, requestType interface{}, requestIDs interface{}) *ApprovalChainRepository_SkipPendingStepsForRequests_Call {
+	return &ApprovalChainRepository_SkipPendingStepsForRequests_Call{Call: _e.mock.On("SkipPendingStepsForRequests", ctx, tx, requestType, requestIDs)}
+}
+
+func (_c *ApprovalChainRepository_SkipPendingStepsForRequests_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64)) *ApprovalChainRepository_SkipPendingStepsForRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_SkipPendingStepsForRequests_Call) Return(_a0 error) *ApprovalChainRepository_SkipPendingStepsForRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_SkipPendingStepsForRequests_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, []int64) error) *ApprovalChainRepository_SkipPendingStepsForRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewApprovalChainRepository creates a new instance of ApprovalChainRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApprovalChainRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApprovalChainRepository {
+	mock := &ApprovalChainRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
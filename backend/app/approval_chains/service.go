@@ -0,0 +1,319 @@
+package approval_chains
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// ApprovalChainService manages approval chains and moves requests through their steps
+type ApprovalChainService struct {
+	chainRepo interfaces.ApprovalChainRepository
+	userRepo  interfaces.UserRepository
+	db        interfaces.DB
+}
+
+// NewApprovalChainService creates a new instance of ApprovalChainService
+func NewApprovalChainService(ctx context.Context, chainRepo interfaces.ApprovalChainRepository, userRepo interfaces.UserRepository, db interfaces.DB) interfaces.ApprovalChainService {
+	return &ApprovalChainService{
+		chainRepo: chainRepo,
+		userRepo:  userRepo,
+		db:        db,
+	}
+}
+
+// CreateChain validates and stores a chain. Bands of active chains for the same request
+// type may not overlap, so every request matches at most one chain (admin only).
+func (s *ApprovalChainService) CreateChain(ctx context.Context, role string, chain models.ApprovalChain) (*models.ApprovalChain, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	chain.Name = strings.TrimSpace(chain.Name)
+	if chain.Name == "" {
+		return nil, apperrors.ErrChainNameRequired
+	}
+	if !utils.IsValidRequestType(chain.RequestType) {
+		return nil, apperrors.ErrInvalidRequestType
+	}
+	if chain.MinAmount < 0 {
+		return nil, apperrors.ErrNegativeValue
+	}
+	if chain.MaxAmount != nil && *chain.MaxAmount <= chain.MinAmount {
+		return nil, apperrors.ErrInvalidAmountBand
+	}
+	if len(chain.Steps) == 0 {
+		return nil, apperrors.ErrChainStepsRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	// the lock keeps a concurrent chain of the same type from slipping into the band
+	// between the check and the insert
+	existing, err := s.chainRepo.LockActiveByType(ctx, tx, chain.RequestType)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range existing {
+		if bandsOverlap(chain, other) {
+			return nil, apperrors.ErrApprovalChainOverlap
+		}
+	}
+
+	for i := range chain.Steps {
+		step := &chain.Steps[i]
+		step.StepOrder = i + 1
+		if err := s.validateStep(ctx, tx, *step); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.chainRepo.Create(ctx, tx, &chain); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return &chain, nil
+}
+
+// GetChains lists the active chains (admin only)
+func (s *ApprovalChainService) GetChains(ctx context.Context, role string) ([]models.ApprovalChain, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+	return s.chainRepo.GetAll(ctx)
+}
+
+// DeleteChain deactivates a chain; requests already in flight finish their steps (admin only)
+func (s *ApprovalChainService) DeleteChain(ctx context.Context, role string, chainID int64) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrUnauthorized
+	}
+	return s.chainRepo.Deactivate(ctx, chainID)
+}
+
+// StartChain copies the chain matching the request amount onto the request and routes the
+// request to the first step. Requests without a matching chain keep single-step approval.
+func (s *ApprovalChainService) StartChain(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, requester *models.User, amount float64) error {
+	chain, err := s.chainRepo.GetActiveForAmount(ctx, tx, requestType, amount)
+	if err == apperrors.ErrApprovalChainNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	steps := make([]models.RequestApprovalStep, 0, len(chain.Steps))
+	for _, step := range chain.Steps {
+		approverRole, approverUserID, err := s.resolveApprover(ctx, requester, step)
+		if err != nil {
+			return err
+		}
+
+		steps = append(steps, models.RequestApprovalStep{
+			RequestType:    requestType,
+			RequestID:      requestID,
+			ChainID:        &chain.ID,
+			StepOrder:      step.StepOrder,
+			ApproverType:   step.ApproverType,
+			ApproverRole:   approverRole,
+			ApproverUserID: approverUserID,
+			Status:         constants.StatusPending,
+		})
+	}
+
+	if err := s.chainRepo.CreateRequestSteps(ctx, tx, steps); err != nil {
+		return err
+	}
+
+	return s.chainRepo.RouteRequest(ctx, tx, requestType, requestID, steps[0].ApproverRole, steps[0].ApproverUserID)
+}
+
+// ApproveStep approves the current step of the request and routes it to the next one.
+// It reports whether the request has no steps left and can be approved. Each step needs
+// another approver: neither the approver nor the delegator they act for may have
+// approved an earlier step of the request.
+func (s *ApprovalChainService) ApproveStep(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string) (bool, error) {
+	steps, err := s.chainRepo.GetPendingRequestSteps(ctx, tx, requestType, requestID)
+	if err != nil {
+		return false, err
+	}
+
+	if len(steps) == 0 {
+		return s.noPendingSteps(ctx, tx, requestType, requestID)
+	}
+
+	approvers, err := s.chainRepo.GetStepApprovers(ctx, tx, requestType, requestID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range approvers {
+		if id == approverID || (delegation != nil && id == delegation.DelegatorID) {
+			return false, apperrors.ErrStepApproverRepeated
+		}
+	}
+
+	if err := s.chainRepo.DecideStep(ctx, tx, steps[0].ID, constants.StatusApproved, approverID, delegation, comment); err != nil {
+		return false, err
+	}
+
+	if len(steps) == 1 {
+		return true, nil
+	}
+
+	next := steps[1]
+	if err := s.chainRepo.RouteRequest(ctx, tx, requestType, requestID, next.ApproverRole, next.ApproverUserID); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// RejectStep rejects the current step of the request and skips the remaining ones
+func (s *ApprovalChainService) RejectStep(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string) error {
+	steps, err := s.chainRepo.GetPendingRequestSteps(ctx, tx, requestType, requestID)
+	if err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		_, err := s.noPendingSteps(ctx, tx, requestType, requestID)
+		return err
+	}
+
+	if err := s.chainRepo.DecideStep(ctx, tx, steps[0].ID, constants.StatusRejected, approverID, delegation, comment); err != nil {
+		return err
+	}
+
+	return s.chainRepo.SkipPendingSteps(ctx, tx, requestType, requestID)
+}
+
+// noPendingSteps tells a request without a chain, which the caller decides on its own,
+// from one whose chain was already decided by a concurrent approver
+func (s *ApprovalChainService) noPendingSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (bool, error) {
+	hasSteps, err := s.chainRepo.HasRequestSteps(ctx, tx, requestType, requestID)
+	if err != nil {
+		return false, err
+	}
+
+	if hasSteps {
+		return false, apperrors.ErrRequestNotPending
+	}
+
+	return true, nil
+}
+
+// CancelSteps skips the steps a cancelled request will never reach
+func (s *ApprovalChainService) CancelSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	return s.chainRepo.SkipPendingSteps(ctx, tx, requestType, requestID)
+}
+
+// GetRequestSteps returns the approval steps of a request to its owner, its approvers and admins
+func (s *ApprovalChainService) GetRequestSteps(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ownerID, err := s.chainRepo.GetRequestOwner(ctx, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := s.chainRepo.GetRequestSteps(ctx, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == constants.RoleAdmin || ownerID == userID {
+		return steps, nil
+	}
+
+	for _, step := range steps {
+		if step.ApproverUserID != nil && *step.ApproverUserID == userID {
+			return steps, nil
+		}
+		if step.ApproverRole != "" && step.ApproverRole == role {
+			return steps, nil
+		}
+	}
+
+	return nil, apperrors.ErrUnauthorized
+}
+
+func (s *ApprovalChainService) validateStep(ctx context.Context, tx interfaces.Tx, step models.ApprovalChainStep) error {
+	hasRole := step.ApproverRole != ""
+	hasUser := step.ApproverUserID != nil
+
+	switch step.ApproverType {
+	case constants.ApproverReportingManager, constants.ApproverSkipLevelManager:
+		if hasRole || hasUser {
+			return apperrors.ErrApproverTargetRequired
+		}
+	case constants.ApproverRole:
+		if !hasRole || hasUser {
+			return apperrors.ErrApproverTargetRequired
+		}
+		if step.ApproverRole != constants.RoleManager && step.ApproverRole != constants.RoleAdmin {
+			return apperrors.ErrInvalidRouteTarget
+		}
+	case constants.ApproverUser:
+		if hasRole || !hasUser {
+			return apperrors.ErrApproverTargetRequired
+		}
+		targetRole, err := s.userRepo.GetRole(ctx, tx, *step.ApproverUserID)
+		if err != nil {
+			return err
+		}
+		if targetRole == constants.RoleEmployee {
+			return apperrors.ErrInvalidRouteTarget
+		}
+	default:
+		return apperrors.ErrInvalidApproverType
+	}
+
+	return nil
+}
+
+// resolveApprover turns a chain step into the approver of the requester's request. Steps
+// that resolve to nobody or to the requester themselves go to admins.
+func (s *ApprovalChainService) resolveApprover(ctx context.Context, requester *models.User, step models.ApprovalChainStep) (string, *int64, error) {
+	var approverID *int64
+
+	switch step.ApproverType {
+	case constants.ApproverRole:
+		return step.ApproverRole, nil, nil
+	case constants.ApproverUser:
+		approverID = step.ApproverUserID
+	case constants.ApproverReportingManager:
+		approverID = requester.ManagerID
+	case constants.ApproverSkipLevelManager:
+		if requester.ManagerID != nil {
+			manager, err := s.userRepo.GetByID(ctx, *requester.ManagerID)
+			if err != nil {
+				return "", nil, err
+			}
+			approverID = manager.ManagerID
+		}
+	}
+
+	if approverID == nil || *approverID == requester.ID {
+		return constants.RoleAdmin, nil, nil
+	}
+
+	return "", approverID, nil
+}
+
+// bandsOverlap reports whether two [min, max) amount bands share a value; a nil max is unbounded
+func bandsOverlap(a, b models.ApprovalChain) bool {
+	aBelowB := a.MaxAmount != nil && *a.MaxAmount <= b.MinAmount
+	bBelowA := b.MaxAmount != nil && *b.MaxAmount <= a.MinAmount
+	return !aBelowB && !bBelowA
+}
@@ -0,0 +1,435 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func int64Ptr(v int64) *int64       { return &v }
+func float64Ptr(v float64) *float64 { return &v }
+
+func TestApprovalChainService_CreateChain(t *testing.T) {
+	ctx := context.Background()
+
+	financeID := int64(40)
+	chain := models.ApprovalChain{
+		Name:        "Large expenses",
+		RequestType: "EXPENSE",
+		MinAmount:   10000,
+		Steps: []models.ApprovalChainStep{
+			{ApproverType: constants.ApproverReportingManager},
+			{ApproverType: constants.ApproverSkipLevelManager},
+			{ApproverType: constants.ApproverUser, ApproverUserID: &financeID},
+		},
+	}
+	withSteps := func(steps ...models.ApprovalChainStep) models.ApprovalChain {
+		c := chain
+		c.Steps = steps
+		return c
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		chain         models.ApprovalChain
+		mockSetup     func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:          "Non Admin",
+			role:          constants.RoleManager,
+			chain:         chain,
+			mockSetup:     func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+		{
+			name: "Empty Band",
+			role: constants.RoleAdmin,
+			chain: func() models.ApprovalChain {
+				c := chain
+				c.MaxAmount = float64Ptr(10000)
+				return c
+			}(),
+			mockSetup:     func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidAmountBand,
+		},
+		{
+			name:          "No Steps",
+			role:          constants.RoleAdmin,
+			chain:         withSteps(),
+			mockSetup:     func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrChainStepsRequired,
+		},
+		{
+			name:  "Overlapping Band",
+			role:  constants.RoleAdmin,
+			chain: chain,
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().LockActiveByType(ctx, tx, "EXPENSE").Return([]models.ApprovalChain{
+					{ID: 1, RequestType: "EXPENSE", MinAmount: 5000, MaxAmount: float64Ptr(20000)},
+				}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrApprovalChainOverlap,
+		},
+		{
+			name:  "Role Step Without Role",
+			role:  constants.RoleAdmin,
+			chain: withSteps(models.ApprovalChainStep{ApproverType: constants.ApproverRole}),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().LockActiveByType(ctx, tx, "EXPENSE").Return(nil, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrApproverTargetRequired,
+		},
+		{
+			name:  "Employee As Approver",
+			role:  constants.RoleAdmin,
+			chain: chain,
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().LockActiveByType(ctx, tx, "EXPENSE").Return(nil, nil)
+				u.EXPECT().GetRole(ctx, tx, financeID).Return(constants.RoleEmployee, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrInvalidRouteTarget,
+		},
+		{
+			name:  "Success Next To Lower Band",
+			role:  constants.RoleAdmin,
+			chain: chain,
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().LockActiveByType(ctx, tx, "EXPENSE").Return([]models.ApprovalChain{
+					{ID: 1, RequestType: "EXPENSE", MinAmount: 0, MaxAmount: float64Ptr(10000)},
+				}, nil)
+				u.EXPECT().GetRole(ctx, tx, financeID).Return(constants.RoleManager, nil)
+				r.EXPECT().Create(ctx, tx, mock.MatchedBy(func(c *models.ApprovalChain) bool {
+					return len(c.Steps) == 3 && c.Steps[0].StepOrder == 1 && c.Steps[2].StepOrder == 3
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChainRepo := mocks.NewApprovalChainRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockChainRepo, mockUserRepo, mockDB, mockTx)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockChainRepo, mockUserRepo, mockDB)
+			created, err := service.CreateChain(ctx, tt.role, tt.chain)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.chain.Name, created.Name)
+		})
+	}
+}
+
+func TestApprovalChainService_StartChain(t *testing.T) {
+	ctx := context.Background()
+
+	managerID := int64(20)
+	headID := int64(30)
+	financeID := int64(40)
+	chain := &models.ApprovalChain{
+		ID:          5,
+		RequestType: "EXPENSE",
+		MinAmount:   10000,
+		Steps: []models.ApprovalChainStep{
+			{StepOrder: 1, ApproverType: constants.ApproverReportingManager},
+			{StepOrder: 2, ApproverType: constants.ApproverSkipLevelManager},
+			{StepOrder: 3, ApproverType: constants.ApproverUser, ApproverUserID: &financeID},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		requester     *models.User
+		mockSetup     func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:      "No Chain For Amount",
+			requester: &models.User{ID: 10, ManagerID: &managerID},
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				r.EXPECT().GetActiveForAmount(ctx, tx, "EXPENSE", 15000.0).Return(nil, apperrors.ErrApprovalChainNotFound)
+			},
+		},
+		{
+			name:      "Resolves Managers And Routes To First Step",
+			requester: &models.User{ID: 10, ManagerID: &managerID},
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				r.EXPECT().GetActiveForAmount(ctx, tx, "EXPENSE", 15000.0).Return(chain, nil)
+				u.EXPECT().GetByID(ctx, managerID).Return(&models.User{ID: managerID, ManagerID: &headID}, nil)
+				r.EXPECT().CreateRequestSteps(ctx, tx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+					return len(steps) == 3 &&
+						*steps[0].ApproverUserID == managerID &&
+						*steps[1].ApproverUserID == headID &&
+						*steps[2].ApproverUserID == financeID &&
+						steps[0].RequestID == 99 && steps[2].Status == constants.StatusPending
+				})).Return(nil)
+				r.EXPECT().RouteRequest(ctx, tx, "EXPENSE", int64(99), "", &managerID).Return(nil)
+			},
+		},
+		{
+			name:      "Missing Managers Fall Back To Admin",
+			requester: &models.User{ID: 10},
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				r.EXPECT().GetActiveForAmount(ctx, tx, "EXPENSE", 15000.0).Return(chain, nil)
+				r.EXPECT().CreateRequestSteps(ctx, tx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+					return steps[0].ApproverRole == constants.RoleAdmin && steps[0].ApproverUserID == nil &&
+						steps[1].ApproverRole == constants.RoleAdmin && steps[1].ApproverUserID == nil
+				})).Return(nil)
+				r.EXPECT().RouteRequest(ctx, tx, "EXPENSE", int64(99), constants.RoleAdmin, (*int64)(nil)).Return(nil)
+			},
+		},
+		{
+			name:      "Requester Cannot Approve Own Step",
+			requester: &models.User{ID: financeID, ManagerID: &managerID},
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				r.EXPECT().GetActiveForAmount(ctx, tx, "EXPENSE", 15000.0).Return(chain, nil)
+				u.EXPECT().GetByID(ctx, managerID).Return(&models.User{ID: managerID}, nil)
+				r.EXPECT().CreateRequestSteps(ctx, tx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+					return steps[2].ApproverRole == constants.RoleAdmin && steps[2].ApproverUserID == nil
+				})).Return(nil)
+				r.EXPECT().RouteRequest(ctx, tx, "EXPENSE", int64(99), "", &managerID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChainRepo := mocks.NewApprovalChainRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockChainRepo, mockUserRepo, mockTx)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockChainRepo, mockUserRepo, mocks.NewDB(t))
+			err := service.StartChain(ctx, mockTx, "EXPENSE", 99, tt.requester, 15000)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestApprovalChainService_ApproveStep(t *testing.T) {
+	ctx := context.Background()
+
+	headID := int64(30)
+	current := models.RequestApprovalStep{ID: 1, StepOrder: 1, ApproverUserID: int64Ptr(20), Status: constants.StatusPending}
+	next := models.RequestApprovalStep{ID: 2, StepOrder: 2, ApproverUserID: &headID, Status: constants.StatusPending}
+	delegation := &models.ApprovalDelegation{ID: 5, DelegatorID: 20, DelegateID: 21}
+
+	tests := []struct {
+		name          string
+		delegation    *models.ApprovalDelegation
+		mockSetup     func(r *mocks.ApprovalChainRepository, tx *mocks.Tx)
+		expectedFinal bool
+		expectedError error
+	}{
+		{
+			name: "Request Without Chain",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{}, nil)
+				r.EXPECT().HasRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return(false, nil)
+			},
+			expectedFinal: true,
+		},
+		{
+			name: "Chain Already Decided",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{}, nil)
+				r.EXPECT().HasRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return(true, nil)
+			},
+			expectedError: apperrors.ErrRequestNotPending,
+		},
+		{
+			name: "Routes To Next Step",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{current, next}, nil)
+				r.EXPECT().GetStepApprovers(ctx, tx, "EXPENSE", int64(99)).Return(nil, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(1), constants.StatusApproved, int64(20), (*models.ApprovalDelegation)(nil), "ok").Return(nil)
+				r.EXPECT().RouteRequest(ctx, tx, "EXPENSE", int64(99), "", &headID).Return(nil)
+			},
+			expectedFinal: false,
+		},
+		{
+			name: "Last Step",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{current}, nil)
+				r.EXPECT().GetStepApprovers(ctx, tx, "EXPENSE", int64(99)).Return([]int64{15}, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(1), constants.StatusApproved, int64(20), (*models.ApprovalDelegation)(nil), "ok").Return(nil)
+			},
+			expectedFinal: true,
+		},
+		{
+			name:       "Decided By Delegate",
+			delegation: delegation,
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{current}, nil)
+				r.EXPECT().GetStepApprovers(ctx, tx, "EXPENSE", int64(99)).Return(nil, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(1), constants.StatusApproved, int64(21), delegation, "ok").Return(nil)
+			},
+			expectedFinal: true,
+		},
+		{
+			name: "Approver Approved Earlier Step",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{next}, nil)
+				r.EXPECT().GetStepApprovers(ctx, tx, "EXPENSE", int64(99)).Return([]int64{20}, nil)
+			},
+			expectedError: apperrors.ErrStepApproverRepeated,
+		},
+		{
+			name:       "Delegate For Earlier Step Approver",
+			delegation: delegation,
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "EXPENSE", int64(99)).Return([]models.RequestApprovalStep{next}, nil)
+				r.EXPECT().GetStepApprovers(ctx, tx, "EXPENSE", int64(99)).Return([]int64{20}, nil)
+			},
+			expectedError: apperrors.ErrStepApproverRepeated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChainRepo := mocks.NewApprovalChainRepository(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockChainRepo, mockTx)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockChainRepo, mocks.NewUserRepository(t), mocks.NewDB(t))
+			approverID := int64(20)
+			if tt.delegation != nil {
+				approverID = tt.delegation.DelegateID
+			}
+			final, err := service.ApproveStep(ctx, mockTx, "EXPENSE", 99, approverID, tt.delegation, "ok")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFinal, final)
+		})
+	}
+}
+
+func TestApprovalChainService_RejectStep(t *testing.T) {
+	ctx := context.Background()
+
+	current := models.RequestApprovalStep{ID: 1, StepOrder: 1, ApproverUserID: int64Ptr(20), Status: constants.StatusPending}
+
+	tests := []struct {
+		name          string
+		mockSetup     func(r *mocks.ApprovalChainRepository, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name: "Request Without Chain",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "LEAVE", int64(99)).Return([]models.RequestApprovalStep{}, nil)
+				r.EXPECT().HasRequestSteps(ctx, tx, "LEAVE", int64(99)).Return(false, nil)
+			},
+		},
+		{
+			name: "Chain Already Decided",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "LEAVE", int64(99)).Return([]models.RequestApprovalStep{}, nil)
+				r.EXPECT().HasRequestSteps(ctx, tx, "LEAVE", int64(99)).Return(true, nil)
+			},
+			expectedError: apperrors.ErrRequestNotPending,
+		},
+		{
+			name: "Skips Remaining Steps",
+			mockSetup: func(r *mocks.ApprovalChainRepository, tx *mocks.Tx) {
+				r.EXPECT().GetPendingRequestSteps(ctx, tx, "LEAVE", int64(99)).Return([]models.RequestApprovalStep{current}, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(1), constants.StatusRejected, int64(20), (*models.ApprovalDelegation)(nil), "no").Return(nil)
+				r.EXPECT().SkipPendingSteps(ctx, tx, "LEAVE", int64(99)).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChainRepo := mocks.NewApprovalChainRepository(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockChainRepo, mockTx)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockChainRepo, mocks.NewUserRepository(t), mocks.NewDB(t))
+			err := service.RejectStep(ctx, mockTx, "LEAVE", 99, 20, nil, "no")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestApprovalChainService_GetRequestSteps(t *testing.T) {
+	ctx := context.Background()
+
+	steps := []models.RequestApprovalStep{
+		{ID: 1, StepOrder: 1, ApproverUserID: int64Ptr(20), Status: constants.StatusApproved},
+		{ID: 2, StepOrder: 2, ApproverRole: constants.RoleAdmin, Status: constants.StatusPending},
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		userID        int64
+		expectedError error
+	}{
+		{name: "Owner", role: constants.RoleEmployee, userID: 10},
+		{name: "Step Approver", role: constants.RoleManager, userID: 20},
+		{name: "Admin", role: constants.RoleAdmin, userID: 1},
+		{name: "Unrelated Manager", role: constants.RoleManager, userID: 21, expectedError: apperrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChainRepo := mocks.NewApprovalChainRepository(t)
+			mockChainRepo.EXPECT().GetRequestOwner(ctx, "LEAVE", int64(99)).Return(int64(10), nil)
+			mockChainRepo.EXPECT().GetRequestSteps(ctx, "LEAVE", int64(99)).Return(steps, nil)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockChainRepo, mocks.NewUserRepository(t), mocks.NewDB(t))
+			result, err := service.GetRequestSteps(ctx, tt.role, tt.userID, "LEAVE", 99)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, steps, result)
+		})
+	}
+}
@@ -4,47 +4,73 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
 
 	"github.com/gin-gonic/gin"
 )
 
 type SystemHandler struct {
-	autoRejectService *AutoRejectService
+	autoRejectService interfaces.AutoRejectService
 }
 
-func NewSystemHandler(ctx context.Context, autoRejectService *AutoRejectService) *SystemHandler {
+func NewSystemHandler(ctx context.Context, autoRejectService interfaces.AutoRejectService) *SystemHandler {
 	return &SystemHandler{autoRejectService: autoRejectService}
 }
 
-func (h *SystemHandler) RunAutoReject(c *gin.Context) {
-	ctx := c.Request.Context()
-	err1 := h.autoRejectService.AutoRejectLeaveRequests(ctx)
-	err2 := h.autoRejectService.AutoRejectExpenseRequests(ctx)
-	err3 := h.autoRejectService.AutoRejectDiscountRequests(ctx)
+// PreviewAutoReject reports the requests the auto-reject job would remind, escalate or
+// reject now, without changing them
+func (h *SystemHandler) PreviewAutoReject(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleAutoRejectError(c, apperrors.ErrAdminOnly)
+		return
+	}
 
-	if err1 != nil {
-		handleAutoRejectError(c, err1)
+	ctx := c.Request.Context()
+	report, err := h.autoRejectService.PreviewAutoReject(ctx, role)
+	if err != nil {
+		handleAutoRejectError(c, err)
 		return
 	}
-	if err2 != nil {
-		handleAutoRejectError(c, err2)
+
+	response.Success(c, "auto reject preview generated successfully", report)
+}
+
+func (h *SystemHandler) RunAutoReject(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleAutoRejectError(c, apperrors.ErrAdminOnly)
 		return
 	}
-	if err3 != nil {
-		handleAutoRejectError(c, err3)
+
+	adminID := c.GetInt64("user_id")
+
+	ctx := c.Request.Context()
+	report, err := h.autoRejectService.RunAutoReject(ctx, role, adminID)
+	if err != nil {
+		handleAutoRejectError(c, err)
 		return
 	}
 
 	response.Success(
 		c,
 		"auto reject executed successfully",
-		nil,
+		report,
 	)
 }
 
 func handleAutoRejectError(c *gin.Context, err error) {
 	status := http.StatusInternalServerError
-	// More specific mappings can be added here if needed
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrJobAlreadyRunning:
+		status = http.StatusConflict
+	}
+
 	response.Error(c, status, err.Error(), nil)
 }
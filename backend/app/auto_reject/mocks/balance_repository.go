@@ -0,0 +1,1174 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BalanceRepository is an autogenerated mock type for the BalanceRepository type
+type BalanceRepository struct {
+	mock.Mock
+}
+
+type BalanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BalanceRepository) EXPECT() *BalanceRepository_Expecter {
+	return &BalanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// AdjustBalance provides a mock function with given fields: ctx, tx, userID, balanceType, leaveType, entryType, amount, change
+func (_m *BalanceRepository) AdjustBalance(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, entryType string, amount float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, balanceType, leaveType, entryType, amount, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, string, string, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, balanceType, leaveType, entryType, amount, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_AdjustBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustBalance'
+type BalanceRepository_AdjustBalance_Call struct {
+	*mock.Call
+}
+
+// AdjustBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - balanceType string
+//   - leaveType string
+//   - entryType string
+//   - amount float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) AdjustBalance(ctx interface{}, tx interface{}, userID interface{}, balanceType interface{}, leaveType interface{}, entryType interface{}, amount interface{}, change interface{}) *BalanceRepository_AdjustBalance_Call {
+	return &BalanceRepository_AdjustBalance_Call{Call: _e.mock.On("AdjustBalance", ctx, tx, userID, balanceType, leaveType, entryType, amount, change)}
+}
+
+func (_c *BalanceRepository_AdjustBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, entryType string, amount float64, change models.BalanceChange)) *BalanceRepository_AdjustBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(string), args[5].(string), args[6].(float64), args[7].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_AdjustBalance_Call) Return(_a0 error) *BalanceRepository_AdjustBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_AdjustBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, string, string, float64, models.BalanceChange) error) *BalanceRepository_AdjustBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeductDiscountBalance provides a mock function with given fields: ctx, tx, userID, percent, change
+func (_m *BalanceRepository) DeductDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64, percent float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, percent, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeductDiscountBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, percent, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_DeductDiscountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeductDiscountBalance'
+type BalanceRepository_DeductDiscountBalance_Call struct {
+	*mock.Call
+}
+
+// DeductDiscountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - percent float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) DeductDiscountBalance(ctx interface{}, tx interface{}, userID interface{}, percent interface{}, change interface{}) *BalanceRepository_DeductDiscountBalance_Call {
+	return &BalanceRepository_DeductDiscountBalance_Call{Call: _e.mock.On("DeductDiscountBalance", ctx, tx, userID, percent, change)}
+}
+
+func (_c *BalanceRepository_DeductDiscountBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, percent float64, change models.BalanceChange)) *BalanceRepository_DeductDiscountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(float64), args[4].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_DeductDiscountBalance_Call) Return(_a0 error) *BalanceRepository_DeductDiscountBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_DeductDiscountBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error) *BalanceRepository_DeductDiscountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeductExpenseBalance provides a mock function with given fields: ctx, tx, userID, amount, change
+func (_m *BalanceRepository) DeductExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, amount, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeductExpenseBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, amount, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_DeductExpenseBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeductExpenseBalance'
+type BalanceRepository_DeductExpenseBalance_Call struct {
+	*mock.Call
+}
+
+// DeductExpenseBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - amount float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) DeductExpenseBalance(ctx interface{}, tx interface{}, userID interface{}, amount interface{}, change interface{}) *BalanceRepository_DeductExpenseBalance_Call {
+	return &BalanceRepository_DeductExpenseBalance_Call{Call: _e.mock.On("DeductExpenseBalance", ctx, tx, userID, amount, change)}
+}
+
+func (_c *BalanceRepository_DeductExpenseBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, change models.BalanceChange)) *BalanceRepository_DeductExpenseBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(float64), args[4].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_DeductExpenseBalance_Call) Return(_a0 error) *BalanceRepository_DeductExpenseBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_DeductExpenseBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error) *BalanceRepository_DeductExpenseBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeductLeaveBalance provides a mock function with given fields: ctx, tx, userID, leaveType, days, change
+func (_m *BalanceRepository) DeductLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string, days float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, leaveType, days, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeductLeaveBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, leaveType, days, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_DeductLeaveBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeductLeaveBalance'
+type BalanceRepository_DeductLeaveBalance_Call struct {
+	*mock.Call
+}
+
+// DeductLeaveBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - leaveType string
+//   - days float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) DeductLeaveBalance(ctx interface{}, tx interface{}, userID interface{}, leaveType interface{}, days interface{}, change interface{}) *BalanceRepository_DeductLeaveBalance_Call {
+	return &BalanceRepository_DeductLeaveBalance_Call{Call: _e.mock.On("DeductLeaveBalance", ctx, tx, userID, leaveType, days, change)}
+}
+
+func (_c *BalanceRepository_DeductLeaveBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string, days float64, change models.BalanceChange)) *BalanceRepository_DeductLeaveBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(float64), args[5].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_DeductLeaveBalance_Call) Return(_a0 error) *BalanceRepository_DeductLeaveBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_DeductLeaveBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, float64, models.BalanceChange) error) *BalanceRepository_DeductLeaveBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiscountBalance provides a mock function with given fields: ctx, tx, userID
+func (_m *BalanceRepository) GetDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64) (float64, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiscountBalance")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (float64, error)); ok {
+		return rf(ctx, tx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) float64); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetDiscountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiscountBalance'
+type BalanceRepository_GetDiscountBalance_Call struct {
+	*mock.Call
+}
+
+// GetDiscountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+func (_e *BalanceRepository_Expecter) GetDiscountBalance(ctx interface{}, tx interface{}, userID interface{}) *BalanceRepository_GetDiscountBalance_Call {
+	return &BalanceRepository_GetDiscountBalance_Call{Call: _e.mock.On("GetDiscountBalance", ctx, tx, userID)}
+}
+
+func (_c *BalanceRepository_GetDiscountBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64)) *BalanceRepository_GetDiscountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetDiscountBalance_Call) Return(_a0 float64, _a1 error) *BalanceRepository_GetDiscountBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetDiscountBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (float64, error)) *BalanceRepository_GetDiscountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiscountFullBalance provides a mock function with given fields: ctx, tx, userID
+func (_m *BalanceRepository) GetDiscountFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (models.Balance, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiscountFullBalance")
+	}
+
+	var r0 models.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (models.Balance, error)); ok {
+		return rf(ctx, tx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) models.Balance); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Get(0).(models.Balance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetDiscountFullBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiscountFullBalance'
+type BalanceRepository_GetDiscountFullBalance_Call struct {
+	*mock.Call
+}
+
+// GetDiscountFullBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+func (_e *BalanceRepository_Expecter) GetDiscountFullBalance(ctx interface{}, tx interface{}, userID interface{}) *BalanceRepository_GetDiscountFullBalance_Call {
+	return &BalanceRepository_GetDiscountFullBalance_Call{Call: _e.mock.On("GetDiscountFullBalance", ctx, tx, userID)}
+}
+
+func (_c *BalanceRepository_GetDiscountFullBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64)) *BalanceRepository_GetDiscountFullBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetDiscountFullBalance_Call) Return(_a0 models.Balance, _a1 error) *BalanceRepository_GetDiscountFullBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetDiscountFullBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (models.Balance, error)) *BalanceRepository_GetDiscountFullBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpenseBalance provides a mock function with given fields: ctx, tx, userID
+func (_m *BalanceRepository) GetExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64) (float64, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseBalance")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (float64, error)); ok {
+		return rf(ctx, tx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) float64); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetExpenseBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseBalance'
+type BalanceRepository_GetExpenseBalance_Call struct {
+	*mock.Call
+}
+
+// GetExpenseBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+func (_e *BalanceRepository_Expecter) GetExpenseBalance(ctx interface{}, tx interface{}, userID interface{}) *BalanceRepository_GetExpenseBalance_Call {
+	return &BalanceRepository_GetExpenseBalance_Call{Call: _e.mock.On("GetExpenseBalance", ctx, tx, userID)}
+}
+
+func (_c *BalanceRepository_GetExpenseBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64)) *BalanceRepository_GetExpenseBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetExpenseBalance_Call) Return(_a0 float64, _a1 error) *BalanceRepository_GetExpenseBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetExpenseBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (float64, error)) *BalanceRepository_GetExpenseBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpenseFullBalance provides a mock function with given fields: ctx, tx, userID
+func (_m *BalanceRepository) GetExpenseFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (models.Balance, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseFullBalance")
+	}
+
+	var r0 models.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (models.Balance, error)); ok {
+		return rf(ctx, tx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) models.Balance); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Get(0).(models.Balance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetExpenseFullBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseFullBalance'
+type BalanceRepository_GetExpenseFullBalance_Call struct {
+	*mock.Call
+}
+
+// GetExpenseFullBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+func (_e *BalanceRepository_Expecter) GetExpenseFullBalance(ctx interface{}, tx interface{}, userID interface{}) *BalanceRepository_GetExpenseFullBalance_Call {
+	return &BalanceRepository_GetExpenseFullBalance_Call{Call: _e.mock.On("GetExpenseFullBalance", ctx, tx, userID)}
+}
+
+func (_c *BalanceRepository_GetExpenseFullBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64)) *BalanceRepository_GetExpenseFullBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetExpenseFullBalance_Call) Return(_a0 models.Balance, _a1 error) *BalanceRepository_GetExpenseFullBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetExpenseFullBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (models.Balance, error)) *BalanceRepository_GetExpenseFullBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaveBalance provides a mock function with given fields: ctx, tx, userID, leaveType
+func (_m *BalanceRepository) GetLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string) (float64, error) {
+	ret := _m.Called(ctx, tx, userID, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveBalance")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) (float64, error)); ok {
+		return rf(ctx, tx, userID, leaveType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) float64); ok {
+		r0 = rf(ctx, tx, userID, leaveType)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r1 = rf(ctx, tx, userID, leaveType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetLeaveBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveBalance'
+type BalanceRepository_GetLeaveBalance_Call struct {
+	*mock.Call
+}
+
+// GetLeaveBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - leaveType string
+func (_e *BalanceRepository_Expecter) GetLeaveBalance(ctx interface{}, tx interface{}, userID interface{}, leaveType interface{}) *BalanceRepository_GetLeaveBalance_Call {
+	return &BalanceRepository_GetLeaveBalance_Call{Call: _e.mock.On("GetLeaveBalance", ctx, tx, userID, leaveType)}
+}
+
+func (_c *BalanceRepository_GetLeaveBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string)) *BalanceRepository_GetLeaveBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetLeaveBalance_Call) Return(_a0 float64, _a1 error) *BalanceRepository_GetLeaveBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetLeaveBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) (float64, error)) *BalanceRepository_GetLeaveBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaveBalances provides a mock function with given fields: ctx, tx, userID
+func (_m *BalanceRepository) GetLeaveBalances(ctx context.Context, tx interfaces.Tx, userID int64) ([]models.Balance, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveBalances")
+	}
+
+	var r0 []models.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) ([]models.Balance, error)); ok {
+		return rf(ctx, tx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) []models.Balance); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Balance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetLeaveBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveBalances'
+type BalanceRepository_GetLeaveBalances_Call struct {
+	*mock.Call
+}
+
+// GetLeaveBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+func (_e *BalanceRepository_Expecter) GetLeaveBalances(ctx interface{}, tx interface{}, userID interface{}) *BalanceRepository_GetLeaveBalances_Call {
+	return &BalanceRepository_GetLeaveBalances_Call{Call: _e.mock.On("GetLeaveBalances", ctx, tx, userID)}
+}
+
+func (_c *BalanceRepository_GetLeaveBalances_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64)) *BalanceRepository_GetLeaveBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetLeaveBalances_Call) Return(_a0 []models.Balance, _a1 error) *BalanceRepository_GetLeaveBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetLeaveBalances_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) ([]models.Balance, error)) *BalanceRepository_GetLeaveBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaveFullBalance provides a mock function with given fields: ctx, tx, userID, leaveType
+func (_m *BalanceRepository) GetLeaveFullBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string) (models.Balance, error) {
+	ret := _m.Called(ctx, tx, userID, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveFullBalance")
+	}
+
+	var r0 models.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) (models.Balance, error)); ok {
+		return rf(ctx, tx, userID, leaveType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) models.Balance); ok {
+		r0 = rf(ctx, tx, userID, leaveType)
+	} else {
+		r0 = ret.Get(0).(models.Balance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r1 = rf(ctx, tx, userID, leaveType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetLeaveFullBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveFullBalance'
+type BalanceRepository_GetLeaveFullBalance_Call struct {
+	*mock.Call
+}
+
+// GetLeaveFullBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - leaveType string
+func (_e *BalanceRepository_Expecter) GetLeaveFullBalance(ctx interface{}, tx interface{}, userID interface{}, leaveType interface{}) *BalanceRepository_GetLeaveFullBalance_Call {
+	return &BalanceRepository_GetLeaveFullBalance_Call{Call: _e.mock.On("GetLeaveFullBalance", ctx, tx, userID, leaveType)}
+}
+
+func (_c *BalanceRepository_GetLeaveFullBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string)) *BalanceRepository_GetLeaveFullBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetLeaveFullBalance_Call) Return(_a0 models.Balance, _a1 error) *BalanceRepository_GetLeaveFullBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetLeaveFullBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) (models.Balance, error)) *BalanceRepository_GetLeaveFullBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLedger provides a mock function with given fields: ctx, userID, balanceType, limit, offset
+func (_m *BalanceRepository) GetLedger(ctx context.Context, userID int64, balanceType string, limit int, offset int) ([]models.BalanceLedgerEntry, error) {
+	ret := _m.Called(ctx, userID, balanceType, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLedger")
+	}
+
+	var r0 []models.BalanceLedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int, int) ([]models.BalanceLedgerEntry, error)); ok {
+		return rf(ctx, userID, balanceType, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int, int) []models.BalanceLedgerEntry); ok {
+		r0 = rf(ctx, userID, balanceType, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalanceLedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int, int) error); ok {
+		r1 = rf(ctx, userID, balanceType, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_GetLedger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLedger'
+type BalanceRepository_GetLedger_Call struct {
+	*mock.Call
+}
+
+// GetLedger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - balanceType string
+//   - limit int
+//   - offset int
+func (_e *BalanceRepository_Expecter) GetLedger(ctx interface{}, userID interface{}, balanceType interface{}, limit interface{}, offset interface{}) *BalanceRepository_GetLedger_Call {
+	return &BalanceRepository_GetLedger_Call{Call: _e.mock.On("GetLedger", ctx, userID, balanceType, limit, offset)}
+}
+
+func (_c *BalanceRepository_GetLedger_Call) Run(run func(ctx context.Context, userID int64, balanceType string, limit int, offset int)) *BalanceRepository_GetLedger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_GetLedger_Call) Return(_a0 []models.BalanceLedgerEntry, _a1 error) *BalanceRepository_GetLedger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_GetLedger_Call) RunAndReturn(run func(context.Context, int64, string, int, int) ([]models.BalanceLedgerEntry, error)) *BalanceRepository_GetLedger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitializeBalances provides a mock function with given fields: ctx, tx, userID, gradeID
+func (_m *BalanceRepository) InitializeBalances(ctx context.Context, tx interfaces.Tx, userID int64, gradeID int64) error {
+	ret := _m.Called(ctx, tx, userID, gradeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitializeBalances")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, userID, gradeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_InitializeBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitializeBalances'
+type BalanceRepository_InitializeBalances_Call struct {
+	*mock.Call
+}
+
+// InitializeBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - gradeID int64
+func (_e *BalanceRepository_Expecter) InitializeBalances(ctx interface{}, tx interface{}, userID interface{}, gradeID interface{}) *BalanceRepository_InitializeBalances_Call {
+	return &BalanceRepository_InitializeBalances_Call{Call: _e.mock.On("InitializeBalances", ctx, tx, userID, gradeID)}
+}
+
+func (_c *BalanceRepository_InitializeBalances_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, gradeID int64)) *BalanceRepository_InitializeBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_InitializeBalances_Call) Return(_a0 error) *BalanceRepository_InitializeBalances_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_InitializeBalances_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) error) *BalanceRepository_InitializeBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenLeaveBalances provides a mock function with given fields: ctx, tx, leaveType
+func (_m *BalanceRepository) OpenLeaveBalances(ctx context.Context, tx interfaces.Tx, leaveType string) (int64, error) {
+	ret := _m.Called(ctx, tx, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenLeaveBalances")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) (int64, error)); ok {
+		return rf(ctx, tx, leaveType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) int64); ok {
+		r0 = rf(ctx, tx, leaveType)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string) error); ok {
+		r1 = rf(ctx, tx, leaveType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceRepository_OpenLeaveBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenLeaveBalances'
+type BalanceRepository_OpenLeaveBalances_Call struct {
+	*mock.Call
+}
+
+// OpenLeaveBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - leaveType string
+func (_e *BalanceRepository_Expecter) OpenLeaveBalances(ctx interface{}, tx interface{}, leaveType interface{}) *BalanceRepository_OpenLeaveBalances_Call {
+	return &BalanceRepository_OpenLeaveBalances_Call{Call: _e.mock.On("OpenLeaveBalances", ctx, tx, leaveType)}
+}
+
+func (_c *BalanceRepository_OpenLeaveBalances_Call) Run(run func(ctx context.Context, tx interfaces.Tx, leaveType string)) *BalanceRepository_OpenLeaveBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_OpenLeaveBalances_Call) Return(_a0 int64, _a1 error) *BalanceRepository_OpenLeaveBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceRepository_OpenLeaveBalances_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string) (int64, error)) *BalanceRepository_OpenLeaveBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, tx, userID, balanceType, leaveType, amount
+func (_m *BalanceRepository) ReleaseReservation(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, amount float64) error {
+	ret := _m.Called(ctx, tx, userID, balanceType, leaveType, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, string, float64) error); ok {
+		r0 = rf(ctx, tx, userID, balanceType, leaveType, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type BalanceRepository_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - balanceType string
+//   - leaveType string
+//   - amount float64
+func (_e *BalanceRepository_Expecter) ReleaseReservation(ctx interface{}, tx interface{}, userID interface{}, balanceType interface{}, leaveType interface{}, amount interface{}) *BalanceRepository_ReleaseReservation_Call {
+	return &BalanceRepository_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, tx, userID, balanceType, leaveType, amount)}
+}
+
+func (_c *BalanceRepository_ReleaseReservation_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, amount float64)) *BalanceRepository_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(string), args[5].(float64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_ReleaseReservation_Call) Return(_a0 error) *BalanceRepository_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_ReleaseReservation_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, string, float64) error) *BalanceRepository_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservations provides a mock function with given fields: ctx, tx, requestType, requestIDs
+func (_m *BalanceRepository) ReleaseReservations(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, []int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_ReleaseReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservations'
+type BalanceRepository_ReleaseReservations_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestIDs []int64
+func (_e *BalanceRepository_Expecter) ReleaseReservations(ctx interface{}, tx interface{}, requestType interface{}, requestIDs interface{}) *BalanceRepository_ReleaseReservations_Call {
+	return &BalanceRepository_ReleaseReservations_Call{Call: _e.mock.On("ReleaseReservations", ctx, tx, requestType, requestIDs)}
+}
+
+func (_c *BalanceRepository_ReleaseReservations_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64)) *BalanceRepository_ReleaseReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_ReleaseReservations_Call) Return(_a0 error) *BalanceRepository_ReleaseReservations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_ReleaseReservations_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, []int64) error) *BalanceRepository_ReleaseReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveBalance provides a mock function with given fields: ctx, tx, userID, balanceType, leaveType, amount
+func (_m *BalanceRepository) ReserveBalance(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, amount float64) error {
+	ret := _m.Called(ctx, tx, userID, balanceType, leaveType, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, string, float64) error); ok {
+		r0 = rf(ctx, tx, userID, balanceType, leaveType, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_ReserveBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveBalance'
+type BalanceRepository_ReserveBalance_Call struct {
+	*mock.Call
+}
+
+// ReserveBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - balanceType string
+//   - leaveType string
+//   - amount float64
+func (_e *BalanceRepository_Expecter) ReserveBalance(ctx interface{}, tx interface{}, userID interface{}, balanceType interface{}, leaveType interface{}, amount interface{}) *BalanceRepository_ReserveBalance_Call {
+	return &BalanceRepository_ReserveBalance_Call{Call: _e.mock.On("ReserveBalance", ctx, tx, userID, balanceType, leaveType, amount)}
+}
+
+func (_c *BalanceRepository_ReserveBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, amount float64)) *BalanceRepository_ReserveBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(string), args[5].(float64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_ReserveBalance_Call) Return(_a0 error) *BalanceRepository_ReserveBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_ReserveBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, string, float64) error) *BalanceRepository_ReserveBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreDiscountBalance provides a mock function with given fields: ctx, tx, userID, percent, change
+func (_m *BalanceRepository) RestoreDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64, percent float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, percent, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreDiscountBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, percent, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_RestoreDiscountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDiscountBalance'
+type BalanceRepository_RestoreDiscountBalance_Call struct {
+	*mock.Call
+}
+
+// RestoreDiscountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - percent float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) RestoreDiscountBalance(ctx interface{}, tx interface{}, userID interface{}, percent interface{}, change interface{}) *BalanceRepository_RestoreDiscountBalance_Call {
+	return &BalanceRepository_RestoreDiscountBalance_Call{Call: _e.mock.On("RestoreDiscountBalance", ctx, tx, userID, percent, change)}
+}
+
+func (_c *BalanceRepository_RestoreDiscountBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, percent float64, change models.BalanceChange)) *BalanceRepository_RestoreDiscountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(float64), args[4].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_RestoreDiscountBalance_Call) Return(_a0 error) *BalanceRepository_RestoreDiscountBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_RestoreDiscountBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error) *BalanceRepository_RestoreDiscountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreExpenseBalance provides a mock function with given fields: ctx, tx, userID, amount, change
+func (_m *BalanceRepository) RestoreExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, amount, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreExpenseBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, amount, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_RestoreExpenseBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreExpenseBalance'
+type BalanceRepository_RestoreExpenseBalance_Call struct {
+	*mock.Call
+}
+
+// RestoreExpenseBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - amount float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) RestoreExpenseBalance(ctx interface{}, tx interface{}, userID interface{}, amount interface{}, change interface{}) *BalanceRepository_RestoreExpenseBalance_Call {
+	return &BalanceRepository_RestoreExpenseBalance_Call{Call: _e.mock.On("RestoreExpenseBalance", ctx, tx, userID, amount, change)}
+}
+
+func (_c *BalanceRepository_RestoreExpenseBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, change models.BalanceChange)) *BalanceRepository_RestoreExpenseBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(float64), args[4].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_RestoreExpenseBalance_Call) Return(_a0 error) *BalanceRepository_RestoreExpenseBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_RestoreExpenseBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, float64, models.BalanceChange) error) *BalanceRepository_RestoreExpenseBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreLeaveBalance provides a mock function with given fields: ctx, tx, userID, leaveType, days, change
+func (_m *BalanceRepository) RestoreLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string, days float64, change models.BalanceChange) error {
+	ret := _m.Called(ctx, tx, userID, leaveType, days, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLeaveBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, float64, models.BalanceChange) error); ok {
+		r0 = rf(ctx, tx, userID, leaveType, days, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_RestoreLeaveBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreLeaveBalance'
+type BalanceRepository_RestoreLeaveBalance_Call struct {
+	*mock.Call
+}
+
+// RestoreLeaveBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - leaveType string
+//   - days float64
+//   - change models.BalanceChange
+func (_e *BalanceRepository_Expecter) RestoreLeaveBalance(ctx interface{}, tx interface{}, userID interface{}, leaveType interface{}, days interface{}, change interface{}) *BalanceRepository_RestoreLeaveBalance_Call {
+	return &BalanceRepository_RestoreLeaveBalance_Call{Call: _e.mock.On("RestoreLeaveBalance", ctx, tx, userID, leaveType, days, change)}
+}
+
+func (_c *BalanceRepository_RestoreLeaveBalance_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string, days float64, change models.BalanceChange)) *BalanceRepository_RestoreLeaveBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(float64), args[5].(models.BalanceChange))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_RestoreLeaveBalance_Call) Return(_a0 error) *BalanceRepository_RestoreLeaveBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_RestoreLeaveBalance_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, float64, models.BalanceChange) error) *BalanceRepository_RestoreLeaveBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBalanceTotal provides a mock function with given fields: ctx, tx, userID, balanceType, leaveType, total
+func (_m *BalanceRepository) SetBalanceTotal(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, total float64) error {
+	ret := _m.Called(ctx, tx, userID, balanceType, leaveType, total)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBalanceTotal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, string, float64) error); ok {
+		r0 = rf(ctx, tx, userID, balanceType, leaveType, total)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceRepository_SetBalanceTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBalanceTotal'
+type BalanceRepository_SetBalanceTotal_Call struct {
+	*mock.Call
+}
+
+// SetBalanceTotal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - balanceType string
+//   - leaveType string
+//   - total float64
+func (_e *BalanceRepository_Expecter) SetBalanceTotal(ctx interface{}, tx interface{}, userID interface{}, balanceType interface{}, leaveType interface{}, total interface{}) *BalanceRepository_SetBalanceTotal_Call {
+	return &BalanceRepository_SetBalanceTotal_Call{Call: _e.mock.On("SetBalanceTotal", ctx, tx, userID, balanceType, leaveType, total)}
+}
+
+func (_c *BalanceRepository_SetBalanceTotal_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, balanceType string, leaveType string, total float64)) *BalanceRepository_SetBalanceTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(string), args[5].(float64))
+	})
+	return _c
+}
+
+func (_c *BalanceRepository_SetBalanceTotal_Call) Return(_a0 error) *BalanceRepository_SetBalanceTotal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceRepository_SetBalanceTotal_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, string, float64) error) *BalanceRepository_SetBalanceTotal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBalanceRepository creates a new instance of BalanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBalanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BalanceRepository {
+	mock := &BalanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,444 +0,0 @@
-// Code generated by mockery v2.53.5. DO NOT EDIT.
-
-package mocks
-
-import (
-	context "context"
-
-	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
-	mock "github.com/stretchr/testify/mock"
-
-	models "github.com/ankita-advitot/rule_based_approval_engine/models"
-
-	time "time"
-)
-
-// DiscountRequestRepository is an autogenerated mock type for the DiscountRequestRepository type
-type DiscountRequestRepository struct {
-	mock.Mock
-}
-
-type DiscountRequestRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *DiscountRequestRepository) EXPECT() *DiscountRequestRepository_Expecter {
-	return &DiscountRequestRepository_Expecter{mock: &_m.Mock}
-}
-
-// Cancel provides a mock function with given fields: ctx, tx, requestID
-func (_m *DiscountRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx, requestID int64) error {
-	ret := _m.Called(ctx, tx, requestID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Cancel")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
-		r0 = rf(ctx, tx, requestID)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DiscountRequestRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
-type DiscountRequestRepository_Cancel_Call struct {
-	*mock.Call
-}
-
-// Cancel is a helper method to define mock.On call
-//   - ctx context.Context
-//   - tx interfaces.Tx
-//   - requestID int64
-func (_e *DiscountRequestRepository_Expecter) Cancel(ctx interface{}, tx interface{}, requestID interface{}) *DiscountRequestRepository_Cancel_Call {
-	return &DiscountRequestRepository_Cancel_Call{Call: _e.mock.On("Cancel", ctx, tx, requestID)}
-}
-
-func (_c *DiscountRequestRepository_Cancel_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *DiscountRequestRepository_Cancel_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_Cancel_Call) Return(_a0 error) *DiscountRequestRepository_Cancel_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_Cancel_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *DiscountRequestRepository_Cancel_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// Create provides a mock function with given fields: ctx, tx, req
-func (_m *DiscountRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.DiscountRequest) error {
-	ret := _m.Called(ctx, tx, req)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Create")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.DiscountRequest) error); ok {
-		r0 = rf(ctx, tx, req)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DiscountRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type DiscountRequestRepository_Create_Call struct {
-	*mock.Call
-}
-
-// Create is a helper method to define mock.On call
-//   - ctx context.Context
-//   - tx interfaces.Tx
-//   - req *models.DiscountRequest
-func (_e *DiscountRequestRepository_Expecter) Create(ctx interface{}, tx interface{}, req interface{}) *DiscountRequestRepository_Create_Call {
-	return &DiscountRequestRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, req)}
-}
-
-func (_c *DiscountRequestRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, req *models.DiscountRequest)) *DiscountRequestRepository_Create_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.DiscountRequest))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_Create_Call) Return(_a0 error) *DiscountRequestRepository_Create_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.DiscountRequest) error) *DiscountRequestRepository_Create_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetByID provides a mock function with given fields: ctx, tx, requestID
-func (_m *DiscountRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.DiscountRequest, error) {
-	ret := _m.Called(ctx, tx, requestID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
-	}
-
-	var r0 *models.DiscountRequest
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (*models.DiscountRequest, error)); ok {
-		return rf(ctx, tx, requestID)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) *models.DiscountRequest); ok {
-		r0 = rf(ctx, tx, requestID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DiscountRequest)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
-		r1 = rf(ctx, tx, requestID)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DiscountRequestRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type DiscountRequestRepository_GetByID_Call struct {
-	*mock.Call
-}
-
-// GetByID is a helper method to define mock.On call
-//   - ctx context.Context
-//   - tx interfaces.Tx
-//   - requestID int64
-func (_e *DiscountRequestRepository_Expecter) GetByID(ctx interface{}, tx interface{}, requestID interface{}) *DiscountRequestRepository_GetByID_Call {
-	return &DiscountRequestRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, tx, requestID)}
-}
-
-func (_c *DiscountRequestRepository_GetByID_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *DiscountRequestRepository_GetByID_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetByID_Call) Return(_a0 *models.DiscountRequest, _a1 error) *DiscountRequestRepository_GetByID_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetByID_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (*models.DiscountRequest, error)) *DiscountRequestRepository_GetByID_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetPendingForAdmin provides a mock function with given fields: ctx
-func (_m *DiscountRequestRepository) GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error) {
-	ret := _m.Called(ctx)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetPendingForAdmin")
-	}
-
-	var r0 []map[string]interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]map[string]interface{}, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []map[string]interface{}); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]map[string]interface{})
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DiscountRequestRepository_GetPendingForAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForAdmin'
-type DiscountRequestRepository_GetPendingForAdmin_Call struct {
-	*mock.Call
-}
-
-// GetPendingForAdmin is a helper method to define mock.On call
-//   - ctx context.Context
-func (_e *DiscountRequestRepository_Expecter) GetPendingForAdmin(ctx interface{}) *DiscountRequestRepository_GetPendingForAdmin_Call {
-	return &DiscountRequestRepository_GetPendingForAdmin_Call{Call: _e.mock.On("GetPendingForAdmin", ctx)}
-}
-
-func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) Run(run func(ctx context.Context)) *DiscountRequestRepository_GetPendingForAdmin_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) Return(_a0 []map[string]interface{}, _a1 error) *DiscountRequestRepository_GetPendingForAdmin_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) RunAndReturn(run func(context.Context) ([]map[string]interface{}, error)) *DiscountRequestRepository_GetPendingForAdmin_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetPendingForManager provides a mock function with given fields: ctx, managerID
-func (_m *DiscountRequestRepository) GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error) {
-	ret := _m.Called(ctx, managerID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetPendingForManager")
-	}
-
-	var r0 []map[string]interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]map[string]interface{}, error)); ok {
-		return rf(ctx, managerID)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []map[string]interface{}); ok {
-		r0 = rf(ctx, managerID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]map[string]interface{})
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, managerID)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DiscountRequestRepository_GetPendingForManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForManager'
-type DiscountRequestRepository_GetPendingForManager_Call struct {
-	*mock.Call
-}
-
-// GetPendingForManager is a helper method to define mock.On call
-//   - ctx context.Context
-//   - managerID int64
-func (_e *DiscountRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerID interface{}) *DiscountRequestRepository_GetPendingForManager_Call {
-	return &DiscountRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerID)}
-}
-
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerID int64)) *DiscountRequestRepository_GetPendingForManager_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) Return(_a0 []map[string]interface{}, _a1 error) *DiscountRequestRepository_GetPendingForManager_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, int64) ([]map[string]interface{}, error)) *DiscountRequestRepository_GetPendingForManager_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetPendingRequests provides a mock function with given fields: ctx
-func (_m *DiscountRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
-	ret := _m.Called(ctx)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetPendingRequests")
-	}
-
-	var r0 []struct {
-		ID        int64
-		CreatedAt time.Time
-	}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []struct {
-		ID        int64
-		CreatedAt time.Time
-	}); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]struct {
-				ID        int64
-				CreatedAt time.Time
-			})
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DiscountRequestRepository_GetPendingRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingRequests'
-type DiscountRequestRepository_GetPendingRequests_Call struct {
-	*mock.Call
-}
-
-// GetPendingRequests is a helper method to define mock.On call
-//   - ctx context.Context
-func (_e *DiscountRequestRepository_Expecter) GetPendingRequests(ctx interface{}) *DiscountRequestRepository_GetPendingRequests_Call {
-	return &DiscountRequestRepository_GetPendingRequests_Call{Call: _e.mock.On("GetPendingRequests", ctx)}
-}
-
-func (_c *DiscountRequestRepository_GetPendingRequests_Call) Run(run func(ctx context.Context)) *DiscountRequestRepository_GetPendingRequests_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetPendingRequests_Call) Return(_a0 []struct {
-	ID        int64
-	CreatedAt time.Time
-}, _a1 error) *DiscountRequestRepository_GetPendingRequests_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error)) *DiscountRequestRepository_GetPendingRequests_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// UpdateStatus provides a mock function with given fields: ctx, tx, requestID, status, approverID, comment
-func (_m *DiscountRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
-	ret := _m.Called(ctx, tx, requestID, status, approverID, comment)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateStatus")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64, string) error); ok {
-		r0 = rf(ctx, tx, requestID, status, approverID, comment)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DiscountRequestRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
-type DiscountRequestRepository_UpdateStatus_Call struct {
-	*mock.Call
-}
-
-// UpdateStatus is a helper method to define mock.On call
-//   - ctx context.Context
-//   - tx interfaces.Tx
-//   - requestID int64
-//   - status string
-//   - approverID int64
-//   - comment string
-func (_e *DiscountRequestRepository_Expecter) UpdateStatus(ctx interface{}, tx interface{}, requestID interface{}, status interface{}, approverID interface{}, comment interface{}) *DiscountRequestRepository_UpdateStatus_Call {
-	return &DiscountRequestRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, tx, requestID, status, approverID, comment)}
-}
-
-func (_c *DiscountRequestRepository_UpdateStatus_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string)) *DiscountRequestRepository_UpdateStatus_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(int64), args[5].(string))
-	})
-	return _c
-}
-
-func (_c *DiscountRequestRepository_UpdateStatus_Call) Return(_a0 error) *DiscountRequestRepository_UpdateStatus_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *DiscountRequestRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, int64, string) error) *DiscountRequestRepository_UpdateStatus_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// NewDiscountRequestRepository creates a new instance of DiscountRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewDiscountRequestRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *DiscountRequestRepository {
-	mock := &DiscountRequestRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
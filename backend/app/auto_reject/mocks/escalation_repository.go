@@ -0,0 +1,585 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EscalationRepository is an autogenerated mock type for the EscalationRepository type
+type EscalationRepository struct {
+	mock.Mock
+}
+
+type EscalationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EscalationRepository) EXPECT() *EscalationRepository_Expecter {
+	return &EscalationRepository_Expecter{mock: &_m.Mock}
+}
+
+// AutoRejectRequests provides a mock function with given fields: ctx, tx, requestType, requestIDs, policyID, comment
+func (_m *EscalationRepository) AutoRejectRequests(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64, policyID int64, comment string) ([]int64, error) {
+	ret := _m.Called(ctx, tx, requestType, requestIDs, policyID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoRejectRequests")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, []int64, int64, string) ([]int64, error)); ok {
+		return rf(ctx, tx, requestType, requestIDs, policyID, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, []int64, int64, string) []int64); ok {
+		r0 = rf(ctx, tx, requestType, requestIDs, policyID, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, []int64, int64, string) error); ok {
+		r1 = rf(ctx, tx, requestType, requestIDs, policyID, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationRepository_AutoRejectRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoRejectRequests'
+type EscalationRepository_AutoRejectRequests_Call struct {
+	*mock.Call
+}
+
+// AutoRejectRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestIDs []int64
+//   - policyID int64
+//   - comment string
+func (_e *EscalationRepository_Expecter) AutoRejectRequests(ctx interface{}, tx interface{}, requestType interface{}, requestIDs interface{}, policyID interface{}, comment interface{}) *EscalationRepository_AutoRejectRequests_Call {
+	return &EscalationRepository_AutoRejectRequests_Call{Call: _e.mock.On("AutoRejectRequests", ctx, tx, requestType, requestIDs, policyID, comment)}
+}
+
+func (_c *EscalationRepository_AutoRejectRequests_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64, policyID int64, comment string)) *EscalationRepository_AutoRejectRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].([]int64), args[4].(int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_AutoRejectRequests_Call) Return(_a0 []int64, _a1 error) *EscalationRepository_AutoRejectRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationRepository_AutoRejectRequests_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, []int64, int64, string) ([]int64, error)) *EscalationRepository_AutoRejectRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePolicy provides a mock function with given fields: ctx, policy
+func (_m *EscalationRepository) CreatePolicy(ctx context.Context, policy *models.SLAPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SLAPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EscalationRepository_CreatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePolicy'
+type EscalationRepository_CreatePolicy_Call struct {
+	*mock.Call
+}
+
+// CreatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *models.SLAPolicy
+func (_e *EscalationRepository_Expecter) CreatePolicy(ctx interface{}, policy interface{}) *EscalationRepository_CreatePolicy_Call {
+	return &EscalationRepository_CreatePolicy_Call{Call: _e.mock.On("CreatePolicy", ctx, policy)}
+}
+
+func (_c *EscalationRepository_CreatePolicy_Call) Run(run func(ctx context.Context, policy *models.SLAPolicy)) *EscalationRepository_CreatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SLAPolicy))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_CreatePolicy_Call) Return(_a0 error) *EscalationRepository_CreatePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EscalationRepository_CreatePolicy_Call) RunAndReturn(run func(context.Context, *models.SLAPolicy) error) *EscalationRepository_CreatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivatePolicy provides a mock function with given fields: ctx, policyID
+func (_m *EscalationRepository) DeactivatePolicy(ctx context.Context, policyID int64) error {
+	ret := _m.Called(ctx, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivatePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, policyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EscalationRepository_DeactivatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivatePolicy'
+type EscalationRepository_DeactivatePolicy_Call struct {
+	*mock.Call
+}
+
+// DeactivatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyID int64
+func (_e *EscalationRepository_Expecter) DeactivatePolicy(ctx interface{}, policyID interface{}) *EscalationRepository_DeactivatePolicy_Call {
+	return &EscalationRepository_DeactivatePolicy_Call{Call: _e.mock.On("DeactivatePolicy", ctx, policyID)}
+}
+
+func (_c *EscalationRepository_DeactivatePolicy_Call) Run(run func(ctx context.Context, policyID int64)) *EscalationRepository_DeactivatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_DeactivatePolicy_Call) Return(_a0 error) *EscalationRepository_DeactivatePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EscalationRepository_DeactivatePolicy_Call) RunAndReturn(run func(context.Context, int64) error) *EscalationRepository_DeactivatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCandidates provides a mock function with given fields: ctx, requestType
+func (_m *EscalationRepository) GetCandidates(ctx context.Context, requestType string) ([]models.EscalationCandidate, error) {
+	ret := _m.Called(ctx, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCandidates")
+	}
+
+	var r0 []models.EscalationCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.EscalationCandidate, error)); ok {
+		return rf(ctx, requestType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.EscalationCandidate); ok {
+		r0 = rf(ctx, requestType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EscalationCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationRepository_GetCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCandidates'
+type EscalationRepository_GetCandidates_Call struct {
+	*mock.Call
+}
+
+// GetCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+func (_e *EscalationRepository_Expecter) GetCandidates(ctx interface{}, requestType interface{}) *EscalationRepository_GetCandidates_Call {
+	return &EscalationRepository_GetCandidates_Call{Call: _e.mock.On("GetCandidates", ctx, requestType)}
+}
+
+func (_c *EscalationRepository_GetCandidates_Call) Run(run func(ctx context.Context, requestType string)) *EscalationRepository_GetCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_GetCandidates_Call) Return(_a0 []models.EscalationCandidate, _a1 error) *EscalationRepository_GetCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationRepository_GetCandidates_Call) RunAndReturn(run func(context.Context, string) ([]models.EscalationCandidate, error)) *EscalationRepository_GetCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx
+func (_m *EscalationRepository) GetPolicies(ctx context.Context) ([]models.SLAPolicy, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 []models.SLAPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.SLAPolicy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.SLAPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SLAPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationRepository_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type EscalationRepository_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *EscalationRepository_Expecter) GetPolicies(ctx interface{}) *EscalationRepository_GetPolicies_Call {
+	return &EscalationRepository_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx)}
+}
+
+func (_c *EscalationRepository_GetPolicies_Call) Run(run func(ctx context.Context)) *EscalationRepository_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_GetPolicies_Call) Return(_a0 []models.SLAPolicy, _a1 error) *EscalationRepository_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationRepository_GetPolicies_Call) RunAndReturn(run func(context.Context) ([]models.SLAPolicy, error)) *EscalationRepository_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicyByID provides a mock function with given fields: ctx, policyID
+func (_m *EscalationRepository) GetPolicyByID(ctx context.Context, policyID int64) (*models.SLAPolicy, error) {
+	ret := _m.Called(ctx, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyByID")
+	}
+
+	var r0 *models.SLAPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.SLAPolicy, error)); ok {
+		return rf(ctx, policyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.SLAPolicy); ok {
+		r0 = rf(ctx, policyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SLAPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, policyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationRepository_GetPolicyByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyByID'
+type EscalationRepository_GetPolicyByID_Call struct {
+	*mock.Call
+}
+
+// GetPolicyByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyID int64
+func (_e *EscalationRepository_Expecter) GetPolicyByID(ctx interface{}, policyID interface{}) *EscalationRepository_GetPolicyByID_Call {
+	return &EscalationRepository_GetPolicyByID_Call{Call: _e.mock.On("GetPolicyByID", ctx, policyID)}
+}
+
+func (_c *EscalationRepository_GetPolicyByID_Call) Run(run func(ctx context.Context, policyID int64)) *EscalationRepository_GetPolicyByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_GetPolicyByID_Call) Return(_a0 *models.SLAPolicy, _a1 error) *EscalationRepository_GetPolicyByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationRepository_GetPolicyByID_Call) RunAndReturn(run func(context.Context, int64) (*models.SLAPolicy, error)) *EscalationRepository_GetPolicyByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestEscalations provides a mock function with given fields: ctx, requestType, requestID
+func (_m *EscalationRepository) GetRequestEscalations(ctx context.Context, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestEscalations")
+	}
+
+	var r0 []models.RequestEscalation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestEscalation, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestEscalation); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestEscalation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationRepository_GetRequestEscalations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestEscalations'
+type EscalationRepository_GetRequestEscalations_Call struct {
+	*mock.Call
+}
+
+// GetRequestEscalations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *EscalationRepository_Expecter) GetRequestEscalations(ctx interface{}, requestType interface{}, requestID interface{}) *EscalationRepository_GetRequestEscalations_Call {
+	return &EscalationRepository_GetRequestEscalations_Call{Call: _e.mock.On("GetRequestEscalations", ctx, requestType, requestID)}
+}
+
+func (_c *EscalationRepository_GetRequestEscalations_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *EscalationRepository_GetRequestEscalations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_GetRequestEscalations_Call) Return(_a0 []models.RequestEscalation, _a1 error) *EscalationRepository_GetRequestEscalations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationRepository_GetRequestEscalations_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestEscalation, error)) *EscalationRepository_GetRequestEscalations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAutoRejections provides a mock function with given fields: ctx, tx, requestType, items
+func (_m *EscalationRepository) RecordAutoRejections(ctx context.Context, tx interfaces.Tx, requestType string, items []models.AutoRejectItem) error {
+	ret := _m.Called(ctx, tx, requestType, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAutoRejections")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, []models.AutoRejectItem) error); ok {
+		r0 = rf(ctx, tx, requestType, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EscalationRepository_RecordAutoRejections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAutoRejections'
+type EscalationRepository_RecordAutoRejections_Call struct {
+	*mock.Call
+}
+
+// RecordAutoRejections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - items []models.AutoRejectItem
+func (_e *EscalationRepository_Expecter) RecordAutoRejections(ctx interface{}, tx interface{}, requestType interface{}, items interface{}) *EscalationRepository_RecordAutoRejections_Call {
+	return &EscalationRepository_RecordAutoRejections_Call{Call: _e.mock.On("RecordAutoRejections", ctx, tx, requestType, items)}
+}
+
+func (_c *EscalationRepository_RecordAutoRejections_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, items []models.AutoRejectItem)) *EscalationRepository_RecordAutoRejections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].([]models.AutoRejectItem))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_RecordAutoRejections_Call) Return(_a0 error) *EscalationRepository_RecordAutoRejections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EscalationRepository_RecordAutoRejections_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, []models.AutoRejectItem) error) *EscalationRepository_RecordAutoRejections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordStep provides a mock function with given fields: ctx, tx, escalation
+func (_m *EscalationRepository) RecordStep(ctx context.Context, tx interfaces.Tx, escalation *models.RequestEscalation) (bool, error) {
+	ret := _m.Called(ctx, tx, escalation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestEscalation) (bool, error)); ok {
+		return rf(ctx, tx, escalation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestEscalation) bool); ok {
+		r0 = rf(ctx, tx, escalation)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, *models.RequestEscalation) error); ok {
+		r1 = rf(ctx, tx, escalation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationRepository_RecordStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordStep'
+type EscalationRepository_RecordStep_Call struct {
+	*mock.Call
+}
+
+// RecordStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - escalation *models.RequestEscalation
+func (_e *EscalationRepository_Expecter) RecordStep(ctx interface{}, tx interface{}, escalation interface{}) *EscalationRepository_RecordStep_Call {
+	return &EscalationRepository_RecordStep_Call{Call: _e.mock.On("RecordStep", ctx, tx, escalation)}
+}
+
+func (_c *EscalationRepository_RecordStep_Call) Run(run func(ctx context.Context, tx interfaces.Tx, escalation *models.RequestEscalation)) *EscalationRepository_RecordStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RequestEscalation))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_RecordStep_Call) Return(_a0 bool, _a1 error) *EscalationRepository_RecordStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationRepository_RecordStep_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RequestEscalation) (bool, error)) *EscalationRepository_RecordStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePolicy provides a mock function with given fields: ctx, policy
+func (_m *EscalationRepository) UpdatePolicy(ctx context.Context, policy *models.SLAPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SLAPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EscalationRepository_UpdatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePolicy'
+type EscalationRepository_UpdatePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *models.SLAPolicy
+func (_e *EscalationRepository_Expecter) UpdatePolicy(ctx interface{}, policy interface{}) *EscalationRepository_UpdatePolicy_Call {
+	return &EscalationRepository_UpdatePolicy_Call{Call: _e.mock.On("UpdatePolicy", ctx, policy)}
+}
+
+func (_c *EscalationRepository_UpdatePolicy_Call) Run(run func(ctx context.Context, policy *models.SLAPolicy)) *EscalationRepository_UpdatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SLAPolicy))
+	})
+	return _c
+}
+
+func (_c *EscalationRepository_UpdatePolicy_Call) Return(_a0 error) *EscalationRepository_UpdatePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EscalationRepository_UpdatePolicy_Call) RunAndReturn(run func(context.Context, *models.SLAPolicy) error) *EscalationRepository_UpdatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEscalationRepository creates a new instance of EscalationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEscalationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EscalationRepository {
+	mock := &EscalationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,148 +2,353 @@ package auto_reject
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 type AutoRejectService struct {
-	leaveRepo    interfaces.LeaveRequestRepository
-	expenseRepo  interfaces.ExpenseRequestRepository
-	discountRepo interfaces.DiscountRequestRepository
-	holidayRepo  interfaces.HolidayRepository
-	db           interfaces.DB
+	holidayRepo    interfaces.HolidayRepository
+	escalationRepo interfaces.EscalationRepository
+	chainRepo      interfaces.ApprovalChainRepository
+	balanceRepo    interfaces.BalanceRepository
+	jobRunService  interfaces.JobRunService
+	db             interfaces.DB
 }
 
 func NewAutoRejectService(
 	ctx context.Context,
-	leaveRepo interfaces.LeaveRequestRepository,
-	expenseRepo interfaces.ExpenseRequestRepository,
-	discountRepo interfaces.DiscountRequestRepository,
 	holidayRepo interfaces.HolidayRepository,
+	escalationRepo interfaces.EscalationRepository,
+	chainRepo interfaces.ApprovalChainRepository,
+	balanceRepo interfaces.BalanceRepository,
+	jobRunService interfaces.JobRunService,
 	db interfaces.DB,
 ) interfaces.AutoRejectService {
 	return &AutoRejectService{
-		leaveRepo:    leaveRepo,
-		expenseRepo:  expenseRepo,
-		discountRepo: discountRepo,
-		holidayRepo:  holidayRepo,
-		db:           db,
+		holidayRepo:    holidayRepo,
+		escalationRepo: escalationRepo,
+		chainRepo:      chainRepo,
+		balanceRepo:    balanceRepo,
+		jobRunService:  jobRunService,
+		db:             db,
 	}
 }
 
-func (s *AutoRejectService) AutoRejectExpiredRequests(ctx context.Context) error {
-	if err := s.AutoRejectLeaveRequests(ctx); err != nil {
-		return err
+func (s *AutoRejectService) AutoRejectExpiredRequests(ctx context.Context) (*models.AutoRejectReport, error) {
+	return s.run(ctx, false)
+}
+
+// PreviewAutoReject reports what the auto-reject job would do now without changing any
+// request (admin only)
+func (s *AutoRejectService) PreviewAutoReject(ctx context.Context, role string) (*models.AutoRejectReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
 	}
-	if err := s.AutoRejectExpenseRequests(ctx); err != nil {
-		return err
+	return s.run(ctx, true)
+}
+
+// RunAutoReject runs the auto-reject job now and reports what it did (admin only). The
+// run is recorded in the run history like a scheduled one and claims the same slot, so it
+// never runs twice alongside the scheduler.
+func (s *AutoRejectService) RunAutoReject(ctx context.Context, role string, adminID int64) (*models.AutoRejectReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
 	}
-	if err := s.AutoRejectDiscountRequests(ctx); err != nil {
-		return err
+
+	var report *models.AutoRejectReport
+	var runErr error
+	_, err := s.jobRunService.Execute(ctx, constants.JobAutoReject, &adminID, func(ctx context.Context) (map[string]int, error) {
+		report, runErr = s.run(ctx, false)
+		return utils.AutoRejectCounts(report), runErr
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if runErr != nil {
+		return nil, runErr
+	}
+
+	return report, nil
 }
 
-func (s *AutoRejectService) AutoRejectLeaveRequests(ctx context.Context) error {
-	requests, err := s.leaveRepo.GetPendingRequests(ctx)
+// run escalates the pending requests of every type, stopping at the first failing type.
+// Work calendars are read once for the whole run.
+func (s *AutoRejectService) run(ctx context.Context, dryRun bool) (*models.AutoRejectReport, error) {
+	report := &models.AutoRejectReport{DryRun: dryRun, Summaries: []models.AutoRejectSummary{}}
+
+	calendars, err := s.holidayRepo.GetWorkCalendars(ctx)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	for _, requestType := range []string{"LEAVE", "EXPENSE", "DISCOUNT"} {
+		summary, err := s.escalateRequests(ctx, requestType, calendars, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		report.Summaries = append(report.Summaries, *summary)
+	}
+
+	return report, nil
+}
+
+// escalateRequests walks the pending requests of a type through the SLA policy of their
+// requester's grade, falling back to the type-wide policy: a reminder, then escalation to
+// the approver's manager, then auto-rejection. Working days are counted on the work
+// calendar of the requester. Requests without a policy are left alone.
+// Reminders and escalations are taken one request at a time; expired requests are
+// rejected together at the end. A dry run only reports the step each request is due for.
+func (s *AutoRejectService) escalateRequests(ctx context.Context, requestType string, calendars map[int64]models.WorkCalendar, dryRun bool) (*models.AutoRejectSummary, error) {
+	summary := &models.AutoRejectSummary{
+		RequestType:  requestType,
+		Reminded:     []models.AutoRejectItem{},
+		Escalated:    []models.AutoRejectItem{},
+		AutoRejected: []models.AutoRejectItem{},
+	}
+
+	policies, err := s.getPolicies(ctx, requestType)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return summary, nil
+	}
+
+	candidates, err := s.escalationRepo.GetCandidates(ctx, requestType)
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
 
-	for _, req := range requests {
-		id := req.ID
-		createdAt := req.CreatedAt
+	var expired []models.AutoRejectItem
+	for _, candidate := range candidates {
+		policy := selectPolicy(policies, candidate.GradeID)
+		if policy == nil {
+			continue
+		}
 
-		workingDays := utils.CountWorkingDays(createdAt, now, func(d time.Time) bool {
-			isHoliday, _ := s.holidayRepo.IsHoliday(ctx, d)
-			return isHoliday
-		})
+		workingDays := utils.CountWorkingDays(candidate.CreatedAt, now, calendars[candidate.CalendarID])
 
-		if workingDays >= 7 {
-			tx, err := s.db.Begin(ctx)
-			if err != nil {
-				return err
+		// step is the summary list the request goes to; acted is false when a real run
+		// finds the step already taken
+		var step *[]models.AutoRejectItem
+		acted := true
+		switch {
+		case workingDays >= policy.RejectAfterDays:
+			expired = append(expired, models.AutoRejectItem{
+				RequestID:   candidate.RequestID,
+				WorkingDays: workingDays,
+				SLAPolicyID: policy.ID,
+			})
+		case reached(policy.EscalateAfterDays, workingDays) && !candidate.Escalated:
+			step = &summary.Escalated
+			if !dryRun {
+				acted, err = s.escalate(ctx, requestType, candidate, workingDays, policy)
 			}
-			err = s.leaveRepo.UpdateStatus(ctx, tx, id, "AUTO_REJECTED", 0, "Auto rejected after 7 working days")
-			if err != nil {
-				tx.Rollback(ctx)
-				return err
+		case reached(policy.ReminderAfterDays, workingDays) && !candidate.Reminded && !candidate.Escalated:
+			step = &summary.Reminded
+			if !dryRun {
+				acted, err = s.remind(ctx, requestType, candidate, workingDays, policy)
 			}
-			tx.Commit(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if step != nil && acted {
+			*step = append(*step, models.AutoRejectItem{
+				RequestID:   candidate.RequestID,
+				WorkingDays: workingDays,
+				SLAPolicyID: policy.ID,
+			})
 		}
 	}
 
-	return nil
+	if dryRun || len(expired) == 0 {
+		summary.AutoRejected = append(summary.AutoRejected, expired...)
+		return summary, nil
+	}
+
+	rejected, err := s.autoReject(ctx, requestType, expired, policies)
+	if err != nil {
+		return nil, err
+	}
+	summary.AutoRejected = rejected
+
+	return summary, nil
 }
 
-func (s *AutoRejectService) AutoRejectExpenseRequests(ctx context.Context) error {
-	requests, err := s.expenseRepo.GetPendingRequests(ctx)
+// getPolicies returns the active SLA policies of a request type
+func (s *AutoRejectService) getPolicies(ctx context.Context, requestType string) ([]models.SLAPolicy, error) {
+	all, err := s.escalationRepo.GetPolicies(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	now := time.Now()
+	var policies []models.SLAPolicy
+	for _, policy := range all {
+		if policy.RequestType == requestType {
+			policies = append(policies, policy)
+		}
+	}
 
-	for _, req := range requests {
-		id := req.ID
-		createdAt := req.CreatedAt
+	return policies, nil
+}
 
-		workingDays := utils.CountWorkingDays(createdAt, now, func(d time.Time) bool {
-			isHoliday, _ := s.holidayRepo.IsHoliday(ctx, d)
-			return isHoliday
-		})
+// remind records that the approver was reminded of the request. It reports false when
+// the request had already been reminded.
+func (s *AutoRejectService) remind(ctx context.Context, requestType string, candidate models.EscalationCandidate, workingDays int, policy *models.SLAPolicy) (bool, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
 
-		if workingDays >= 7 {
-			tx, err := s.db.Begin(ctx)
-			if err != nil {
-				return err
-			}
-			err = s.expenseRepo.UpdateStatus(ctx, tx, id, "AUTO_REJECTED", 0, "Auto rejected after 7 working days")
-			if err != nil {
-				tx.Rollback(ctx)
-				return err
-			}
-			tx.Commit(ctx)
-		}
+	recorded, err := s.escalationRepo.RecordStep(ctx, tx, &models.RequestEscalation{
+		RequestType: requestType,
+		RequestID:   candidate.RequestID,
+		Step:        constants.EscalationReminder,
+		WorkingDays: workingDays,
+		SLAPolicyID: &policy.ID,
+	})
+	if err != nil || !recorded {
+		return false, err
 	}
 
-	return nil
+	log.Printf("Reminder: %s request %d pending for %d working days", requestType, candidate.RequestID, workingDays)
+
+	return true, tx.Commit(ctx)
 }
 
-func (s *AutoRejectService) AutoRejectDiscountRequests(ctx context.Context) error {
-	requests, err := s.discountRepo.GetPendingRequests(ctx)
+// escalate routes the request to the manager of its current approver, or to admins when
+// there is no such manager. It reports false when the request had already been escalated.
+func (s *AutoRejectService) escalate(ctx context.Context, requestType string, candidate models.EscalationCandidate, workingDays int, policy *models.SLAPolicy) (bool, error) {
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return err
+		return false, err
 	}
+	defer tx.Rollback(ctx)
 
-	now := time.Now()
+	escalation := &models.RequestEscalation{
+		RequestType: requestType,
+		RequestID:   candidate.RequestID,
+		Step:        constants.EscalationEscalated,
+		WorkingDays: workingDays,
+		SLAPolicyID: &policy.ID,
+	}
+	if candidate.ApproverManagerID != nil && *candidate.ApproverManagerID != candidate.EmployeeID {
+		escalation.EscalatedToUserID = candidate.ApproverManagerID
+	} else {
+		escalation.EscalatedToRole = constants.RoleAdmin
+	}
 
-	for _, req := range requests {
-		id := req.ID
-		createdAt := req.CreatedAt
+	recorded, err := s.escalationRepo.RecordStep(ctx, tx, escalation)
+	if err != nil || !recorded {
+		return false, err
+	}
 
-		workingDays := utils.CountWorkingDays(createdAt, now, func(d time.Time) bool {
-			isHoliday, _ := s.holidayRepo.IsHoliday(ctx, d)
-			return isHoliday
-		})
+	err = s.chainRepo.RouteRequest(ctx, tx, requestType, candidate.RequestID, escalation.EscalatedToRole, escalation.EscalatedToUserID)
+	if err != nil {
+		return false, err
+	}
 
-		if workingDays >= 7 {
-			tx, err := s.db.Begin(ctx)
-			if err != nil {
-				return err
-			}
-			err = s.discountRepo.UpdateStatus(ctx, tx, id, "AUTO_REJECTED", 0, "Auto rejected after 7 working days")
-			if err != nil {
-				tx.Rollback(ctx)
-				return err
-			}
-			tx.Commit(ctx)
+	return true, tx.Commit(ctx)
+}
+
+// autoReject rejects, in one transaction, the requests that passed the final deadline of
+// their SLA policy with one statement per policy, records the policy on them and returns
+// the ones it rejected. Requests decided since they were read are left out.
+func (s *AutoRejectService) autoReject(ctx context.Context, requestType string, expired []models.AutoRejectItem, policies []models.SLAPolicy) ([]models.AutoRejectItem, error) {
+	// the rejection comment names the policy deadline, so requests are rejected per policy
+	var policyIDs []int64
+	requestIDs := map[int64][]int64{}
+	for _, item := range expired {
+		if _, ok := requestIDs[item.SLAPolicyID]; !ok {
+			policyIDs = append(policyIDs, item.SLAPolicyID)
+		}
+		requestIDs[item.SLAPolicyID] = append(requestIDs[item.SLAPolicyID], item.RequestID)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	isRejected := map[int64]bool{}
+	for _, policyID := range policyIDs {
+		comment := fmt.Sprintf("Auto rejected after %d working days", findPolicy(policies, policyID).RejectAfterDays)
+
+		ids, err := s.escalationRepo.AutoRejectRequests(ctx, tx, requestType, requestIDs[policyID], policyID, comment)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			isRejected[id] = true
 		}
 	}
 
+	rejected := []models.AutoRejectItem{}
+	var rejectedIDs []int64
+	for _, item := range expired {
+		if isRejected[item.RequestID] {
+			rejected = append(rejected, item)
+			rejectedIDs = append(rejectedIDs, item.RequestID)
+		}
+	}
+	if len(rejected) == 0 {
+		return rejected, nil
+	}
+
+	if err := s.escalationRepo.RecordAutoRejections(ctx, tx, requestType, rejected); err != nil {
+		return nil, err
+	}
+
+	// steps of their approval chains will never be reached
+	if err := s.chainRepo.SkipPendingStepsForRequests(ctx, tx, requestType, rejectedIDs); err != nil {
+		return nil, err
+	}
+
+	// nor will the balance they held be consumed
+	if err := s.balanceRepo.ReleaseReservations(ctx, tx, requestType, rejectedIDs); err != nil {
+		return nil, err
+	}
+
+	return rejected, tx.Commit(ctx)
+}
+
+// findPolicy returns the policy with the given id
+func findPolicy(policies []models.SLAPolicy, policyID int64) *models.SLAPolicy {
+	for i := range policies {
+		if policies[i].ID == policyID {
+			return &policies[i]
+		}
+	}
 	return nil
 }
+
+// selectPolicy picks the policy of the grade, or the type-wide policy when the grade has none
+func selectPolicy(policies []models.SLAPolicy, gradeID int64) *models.SLAPolicy {
+	var typeWide *models.SLAPolicy
+	for i := range policies {
+		switch {
+		case policies[i].GradeID == nil:
+			typeWide = &policies[i]
+		case *policies[i].GradeID == gradeID:
+			return &policies[i]
+		}
+	}
+	return typeWide
+}
+
+// reached reports whether an optional working-day threshold has been reached
+func reached(threshold *int, workingDays int) bool {
+	return threshold != nil && workingDays >= *threshold
+}
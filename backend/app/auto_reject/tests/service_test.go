@@ -0,0 +1,509 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func intPtr(v int) *int       { return &v }
+func int64Ptr(v int64) *int64 { return &v }
+
+// officeCalendar is the Monday to Friday calendar the requesters follow
+var officeCalendar = models.WorkCalendar{
+	CalendarID:  1,
+	WorkingDays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	Holidays:    map[string]string{},
+}
+
+// workCalendars returns the office calendar with the given holidays
+func workCalendars(holidays ...time.Time) map[int64]models.WorkCalendar {
+	calendar := officeCalendar
+	calendar.Holidays = map[string]string{}
+	for _, h := range holidays {
+		calendar.Holidays[h.Format("2006-01-02")] = "Holiday"
+	}
+	return map[int64]models.WorkCalendar{calendar.CalendarID: calendar}
+}
+
+// workingDaysAgo returns a working day whose working-day age is n today
+func workingDaysAgo(n int) time.Time {
+	now := time.Now()
+	d := now
+	for utils.CountWorkingDays(d, now, officeCalendar) < n {
+		d = d.AddDate(0, 0, -1)
+	}
+	return d
+}
+
+func stepIs(step string) interface{} {
+	return mock.MatchedBy(func(e *models.RequestEscalation) bool {
+		return e.Step == step && e.RequestType == "LEAVE" && e.RequestID == 1 &&
+			e.SLAPolicyID != nil && *e.SLAPolicyID == 1
+	})
+}
+
+// runsJob returns a job run service that claims the run of the auto-reject job for
+// admin 1 and runs it
+func runsJob(t *testing.T, ctx context.Context) *mocks.JobRunService {
+	mockRunService := mocks.NewJobRunService(t)
+	mockRunService.EXPECT().Execute(ctx, constants.JobAutoReject, int64Ptr(1), mock.Anything).RunAndReturn(
+		func(ctx context.Context, name string, triggeredBy *int64, job func(ctx context.Context) (map[string]int, error)) (*models.JobRun, error) {
+			counts, err := job(ctx)
+			run := &models.JobRun{JobName: name, Counts: counts, Status: constants.JobStatusSucceeded, TriggeredBy: triggeredBy}
+			if err != nil {
+				run.Status, run.Error = constants.JobStatusFailed, err.Error()
+			}
+			return run, nil
+		})
+	return mockRunService
+}
+
+func TestAutoRejectService_EscalatesLeaveRequests(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.SLAPolicy{
+		ID:                1,
+		RequestType:       "LEAVE",
+		ReminderAfterDays: intPtr(3),
+		EscalateAfterDays: intPtr(5),
+		RejectAfterDays:   7,
+	}
+	gradePolicy := models.SLAPolicy{
+		ID:              2,
+		RequestType:     "LEAVE",
+		GradeID:         int64Ptr(2),
+		RejectAfterDays: 4,
+	}
+
+	tests := []struct {
+		name      string
+		policies  []models.SLAPolicy
+		candidate models.EscalationCandidate
+		mockSetup func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx)
+	}{
+		{
+			name:      "Not Due Yet",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(2)},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+		},
+		{
+			name:      "Reminder",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(3)},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().RecordStep(ctx, tx, stepIs(constants.EscalationReminder)).Return(true, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Already Reminded",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(4), Reminded: true},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+		},
+		{
+			name:      "Escalates To Approver's Manager",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(5), ApproverManagerID: int64Ptr(40), Reminded: true},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().RecordStep(ctx, tx, stepIs(constants.EscalationEscalated)).Return(true, nil)
+				c.EXPECT().RouteRequest(ctx, tx, "LEAVE", int64(1), "", int64Ptr(40)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Escalates To Admin Without Manager",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(6)},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().RecordStep(ctx, tx, stepIs(constants.EscalationEscalated)).Return(true, nil)
+				c.EXPECT().RouteRequest(ctx, tx, "LEAVE", int64(1), constants.RoleAdmin, (*int64)(nil)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Already Escalated",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(6), Escalated: true},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+		},
+		{
+			name:      "Auto Rejects At Deadline",
+			policies:  []models.SLAPolicy{policy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(7), Reminded: true, Escalated: true},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().AutoRejectRequests(ctx, tx, "LEAVE", []int64{1}, int64(1), "Auto rejected after 7 working days").Return([]int64{1}, nil)
+				e.EXPECT().RecordAutoRejections(ctx, tx, "LEAVE", []models.AutoRejectItem{{RequestID: 1, WorkingDays: 7, SLAPolicyID: 1}}).Return(nil)
+				c.EXPECT().SkipPendingStepsForRequests(ctx, tx, "LEAVE", []int64{1}).Return(nil)
+				b.EXPECT().ReleaseReservations(ctx, tx, "LEAVE", []int64{1}).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Grade Policy Overrides Type Policy",
+			policies:  []models.SLAPolicy{policy, gradePolicy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, GradeID: 2, CreatedAt: workingDaysAgo(4), Reminded: true, Escalated: true},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().AutoRejectRequests(ctx, tx, "LEAVE", []int64{1}, int64(2), "Auto rejected after 4 working days").Return([]int64{1}, nil)
+				e.EXPECT().RecordAutoRejections(ctx, tx, "LEAVE", []models.AutoRejectItem{{RequestID: 1, WorkingDays: 4, SLAPolicyID: 2}}).Return(nil)
+				c.EXPECT().SkipPendingStepsForRequests(ctx, tx, "LEAVE", []int64{1}).Return(nil)
+				b.EXPECT().ReleaseReservations(ctx, tx, "LEAVE", []int64{1}).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Other Grade Uses Type Policy",
+			policies:  []models.SLAPolicy{policy, gradePolicy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, GradeID: 3, CreatedAt: workingDaysAgo(4), Reminded: true},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+		},
+		{
+			name:      "No Policy For Grade",
+			policies:  []models.SLAPolicy{gradePolicy},
+			candidate: models.EscalationCandidate{RequestID: 1, EmployeeID: 10, CalendarID: 1, GradeID: 3, CreatedAt: workingDaysAgo(9)},
+			mockSetup: func(e *mocks.EscalationRepository, c *mocks.ApprovalChainRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHolidayRepo := mocks.NewHolidayRepository(t)
+			mockEscalationRepo := mocks.NewEscalationRepository(t)
+			mockChainRepo := mocks.NewApprovalChainRepository(t)
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			mockEscalationRepo.EXPECT().GetPolicies(ctx).Return(tt.policies, nil)
+			mockEscalationRepo.EXPECT().GetCandidates(ctx, "LEAVE").Return([]models.EscalationCandidate{tt.candidate}, nil)
+			mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(workCalendars(), nil)
+			tt.mockSetup(mockEscalationRepo, mockChainRepo, mockBalanceRepo, mockDB, mockTx)
+
+			service := auto_reject.NewAutoRejectService(
+				ctx,
+				mockHolidayRepo,
+				mockEscalationRepo,
+				mockChainRepo,
+				mockBalanceRepo,
+				mocks.NewJobRunService(t),
+				mockDB,
+			)
+
+			_, err := service.AutoRejectExpiredRequests(ctx)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAutoRejectService_RejectsExpiredRequestsTogether(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.SLAPolicy{ID: 1, RequestType: "LEAVE", RejectAfterDays: 7}
+	gradePolicy := models.SLAPolicy{ID: 2, RequestType: "LEAVE", GradeID: int64Ptr(2), RejectAfterDays: 4}
+
+	// the day request 4 was raised is a holiday, which keeps it one day short of its
+	// deadline; the older requests lose that day too
+	holiday := workingDaysAgo(4)
+
+	mockEscalationRepo := mocks.NewEscalationRepository(t)
+	mockHolidayRepo := mocks.NewHolidayRepository(t)
+	mockChainRepo := mocks.NewApprovalChainRepository(t)
+	mockBalanceRepo := mocks.NewBalanceRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(workCalendars(holiday), nil).Once()
+	mockEscalationRepo.EXPECT().GetPolicies(ctx).Return([]models.SLAPolicy{policy, gradePolicy}, nil)
+	mockEscalationRepo.EXPECT().GetCandidates(ctx, "LEAVE").Return([]models.EscalationCandidate{
+		{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(8)},
+		{RequestID: 2, EmployeeID: 11, CalendarID: 1, GradeID: 2, CreatedAt: workingDaysAgo(6)},
+		{RequestID: 3, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(9)},
+		{RequestID: 4, EmployeeID: 12, CalendarID: 1, GradeID: 2, CreatedAt: holiday},
+	}, nil)
+
+	// request 3 was decided by its approver after the candidates were read
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockEscalationRepo.EXPECT().AutoRejectRequests(ctx, mockTx, "LEAVE", []int64{1, 3}, int64(1), "Auto rejected after 7 working days").Return([]int64{1}, nil)
+	mockEscalationRepo.EXPECT().AutoRejectRequests(ctx, mockTx, "LEAVE", []int64{2}, int64(2), "Auto rejected after 4 working days").Return([]int64{2}, nil)
+	rejected := []models.AutoRejectItem{
+		{RequestID: 1, WorkingDays: 7, SLAPolicyID: 1},
+		{RequestID: 2, WorkingDays: 5, SLAPolicyID: 2},
+	}
+	mockEscalationRepo.EXPECT().RecordAutoRejections(ctx, mockTx, "LEAVE", rejected).Return(nil)
+	mockChainRepo.EXPECT().SkipPendingStepsForRequests(ctx, mockTx, "LEAVE", []int64{1, 2}).Return(nil)
+	mockBalanceRepo.EXPECT().ReleaseReservations(ctx, mockTx, "LEAVE", []int64{1, 2}).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := auto_reject.NewAutoRejectService(ctx, mockHolidayRepo, mockEscalationRepo, mockChainRepo, mockBalanceRepo, runsJob(t, ctx), mockDB)
+
+	report, err := service.RunAutoReject(ctx, constants.RoleAdmin, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rejected, report.Summaries[0].AutoRejected)
+	assert.Empty(t, report.Summaries[0].Escalated)
+}
+
+func TestAutoRejectService_CountsOnRequesterCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.SLAPolicy{ID: 1, RequestType: "LEAVE", RejectAfterDays: 5}
+	created := workingDaysAgo(5)
+
+	// the day both requests were raised is a holiday on the second requester's calendar only
+	calendars := workCalendars()
+	regional := officeCalendar
+	regional.CalendarID = 2
+	regional.Holidays = map[string]string{created.Format("2006-01-02"): "Regional Holiday"}
+	calendars[2] = regional
+
+	mockEscalationRepo := mocks.NewEscalationRepository(t)
+	mockHolidayRepo := mocks.NewHolidayRepository(t)
+	mockChainRepo := mocks.NewApprovalChainRepository(t)
+	mockBalanceRepo := mocks.NewBalanceRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(calendars, nil)
+	mockEscalationRepo.EXPECT().GetPolicies(ctx).Return([]models.SLAPolicy{policy}, nil)
+	mockEscalationRepo.EXPECT().GetCandidates(ctx, "LEAVE").Return([]models.EscalationCandidate{
+		{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: created},
+		{RequestID: 2, EmployeeID: 11, CalendarID: 2, CreatedAt: created},
+	}, nil)
+
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockEscalationRepo.EXPECT().AutoRejectRequests(ctx, mockTx, "LEAVE", []int64{1}, int64(1), "Auto rejected after 5 working days").Return([]int64{1}, nil)
+	mockEscalationRepo.EXPECT().RecordAutoRejections(ctx, mockTx, "LEAVE", []models.AutoRejectItem{{RequestID: 1, WorkingDays: 5, SLAPolicyID: 1}}).Return(nil)
+	mockChainRepo.EXPECT().SkipPendingStepsForRequests(ctx, mockTx, "LEAVE", []int64{1}).Return(nil)
+	mockBalanceRepo.EXPECT().ReleaseReservations(ctx, mockTx, "LEAVE", []int64{1}).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := auto_reject.NewAutoRejectService(
+		ctx,
+		mockHolidayRepo,
+		mockEscalationRepo,
+		mockChainRepo,
+		mockBalanceRepo,
+		mocks.NewJobRunService(t),
+		mockDB,
+	)
+
+	report, err := service.AutoRejectExpiredRequests(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.AutoRejectItem{{RequestID: 1, WorkingDays: 5, SLAPolicyID: 1}}, report.Summaries[0].AutoRejected)
+}
+
+func TestAutoRejectService_RollsBackWhenReservationNotHeld(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.SLAPolicy{ID: 1, RequestType: "LEAVE", RejectAfterDays: 5}
+
+	mockEscalationRepo := mocks.NewEscalationRepository(t)
+	mockHolidayRepo := mocks.NewHolidayRepository(t)
+	mockChainRepo := mocks.NewApprovalChainRepository(t)
+	mockBalanceRepo := mocks.NewBalanceRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(workCalendars(), nil)
+	mockEscalationRepo.EXPECT().GetPolicies(ctx).Return([]models.SLAPolicy{policy}, nil)
+	mockEscalationRepo.EXPECT().GetCandidates(ctx, "LEAVE").Return([]models.EscalationCandidate{
+		{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(5)},
+	}, nil)
+
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockEscalationRepo.EXPECT().AutoRejectRequests(ctx, mockTx, "LEAVE", []int64{1}, int64(1), "Auto rejected after 5 working days").Return([]int64{1}, nil)
+	mockEscalationRepo.EXPECT().RecordAutoRejections(ctx, mockTx, "LEAVE", []models.AutoRejectItem{{RequestID: 1, WorkingDays: 5, SLAPolicyID: 1}}).Return(nil)
+	mockChainRepo.EXPECT().SkipPendingStepsForRequests(ctx, mockTx, "LEAVE", []int64{1}).Return(nil)
+	mockBalanceRepo.EXPECT().ReleaseReservations(ctx, mockTx, "LEAVE", []int64{1}).Return(apperrors.ErrReservationNotHeld)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := auto_reject.NewAutoRejectService(ctx, mockHolidayRepo, mockEscalationRepo, mockChainRepo, mockBalanceRepo, mocks.NewJobRunService(t), mockDB)
+
+	_, err := service.AutoRejectExpiredRequests(ctx)
+	assert.ErrorIs(t, err, apperrors.ErrReservationNotHeld)
+}
+
+func TestAutoRejectService_SkipsTypesWithoutPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	mockEscalationRepo := mocks.NewEscalationRepository(t)
+	mockHolidayRepo := mocks.NewHolidayRepository(t)
+	mockEscalationRepo.EXPECT().GetPolicies(ctx).Return([]models.SLAPolicy{}, nil)
+	mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(workCalendars(), nil)
+
+	service := auto_reject.NewAutoRejectService(
+		ctx,
+		mockHolidayRepo,
+		mockEscalationRepo,
+		mocks.NewApprovalChainRepository(t),
+		mocks.NewBalanceRepository(t),
+		mocks.NewJobRunService(t),
+		mocks.NewDB(t),
+	)
+
+	report, err := service.AutoRejectExpiredRequests(ctx)
+
+	assert.NoError(t, err)
+	for _, summary := range report.Summaries {
+		assert.Empty(t, summary.AutoRejected)
+	}
+}
+
+func TestAutoRejectService_PreviewAutoReject(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.SLAPolicy{
+		ID:                1,
+		RequestType:       "LEAVE",
+		ReminderAfterDays: intPtr(3),
+		EscalateAfterDays: intPtr(5),
+		RejectAfterDays:   7,
+	}
+
+	mockEscalationRepo := mocks.NewEscalationRepository(t)
+	mockHolidayRepo := mocks.NewHolidayRepository(t)
+
+	mockEscalationRepo.EXPECT().GetPolicies(ctx).Return([]models.SLAPolicy{policy}, nil)
+	mockEscalationRepo.EXPECT().GetCandidates(ctx, "LEAVE").Return([]models.EscalationCandidate{
+		{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(2)},
+		{RequestID: 2, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(3)},
+		{RequestID: 3, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(5), Reminded: true},
+		{RequestID: 4, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(8), Reminded: true, Escalated: true},
+	}, nil)
+	mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(workCalendars(), nil)
+
+	// no transaction is opened and no request is touched on a dry run
+	service := auto_reject.NewAutoRejectService(
+		ctx,
+		mockHolidayRepo,
+		mockEscalationRepo,
+		mocks.NewApprovalChainRepository(t),
+		mocks.NewBalanceRepository(t),
+		mocks.NewJobRunService(t),
+		mocks.NewDB(t),
+	)
+
+	report, err := service.PreviewAutoReject(ctx, constants.RoleAdmin)
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Summaries, 3)
+
+	leave := report.Summaries[0]
+	assert.Equal(t, "LEAVE", leave.RequestType)
+	assert.Equal(t, []models.AutoRejectItem{{RequestID: 2, WorkingDays: 3, SLAPolicyID: 1}}, leave.Reminded)
+	assert.Equal(t, []models.AutoRejectItem{{RequestID: 3, WorkingDays: 5, SLAPolicyID: 1}}, leave.Escalated)
+	assert.Equal(t, []models.AutoRejectItem{{RequestID: 4, WorkingDays: 8, SLAPolicyID: 1}}, leave.AutoRejected)
+	assert.Empty(t, report.Summaries[1].AutoRejected)
+	assert.Empty(t, report.Summaries[2].AutoRejected)
+}
+
+func TestAutoRejectService_RunAutoRejectReportsTakenSteps(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.SLAPolicy{ID: 1, RequestType: "LEAVE", ReminderAfterDays: intPtr(3), RejectAfterDays: 7}
+
+	mockEscalationRepo := mocks.NewEscalationRepository(t)
+	mockHolidayRepo := mocks.NewHolidayRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockEscalationRepo.EXPECT().GetPolicies(ctx).Return([]models.SLAPolicy{policy}, nil)
+	mockEscalationRepo.EXPECT().GetCandidates(ctx, "LEAVE").Return([]models.EscalationCandidate{
+		{RequestID: 1, EmployeeID: 10, CalendarID: 1, CreatedAt: workingDaysAgo(3)},
+	}, nil)
+	mockHolidayRepo.EXPECT().GetWorkCalendars(ctx).Return(workCalendars(), nil)
+
+	// another run reminded the request first, so this run reports nothing
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockEscalationRepo.EXPECT().RecordStep(ctx, mockTx, stepIs(constants.EscalationReminder)).Return(false, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := auto_reject.NewAutoRejectService(
+		ctx,
+		mockHolidayRepo,
+		mockEscalationRepo,
+		mocks.NewApprovalChainRepository(t),
+		mocks.NewBalanceRepository(t),
+		runsJob(t, ctx),
+		mockDB,
+	)
+
+	report, err := service.RunAutoReject(ctx, constants.RoleAdmin, 1)
+
+	assert.NoError(t, err)
+	assert.False(t, report.DryRun)
+	assert.Empty(t, report.Summaries[0].Reminded)
+}
+
+func TestAutoRejectService_RunAutoRejectSkipsClaimedRun(t *testing.T) {
+	ctx := context.Background()
+
+	// the scheduler already runs the job this minute on another instance
+	mockRunService := mocks.NewJobRunService(t)
+	mockRunService.EXPECT().Execute(ctx, constants.JobAutoReject, int64Ptr(1), mock.Anything).Return(nil, apperrors.ErrJobAlreadyRunning)
+
+	service := auto_reject.NewAutoRejectService(
+		ctx,
+		mocks.NewHolidayRepository(t),
+		mocks.NewEscalationRepository(t),
+		mocks.NewApprovalChainRepository(t),
+		mocks.NewBalanceRepository(t),
+		mockRunService,
+		mocks.NewDB(t),
+	)
+
+	report, err := service.RunAutoReject(ctx, constants.RoleAdmin, 1)
+
+	assert.ErrorIs(t, err, apperrors.ErrJobAlreadyRunning)
+	assert.Nil(t, report)
+}
+
+func TestAutoRejectService_AdminOnly(t *testing.T) {
+	ctx := context.Background()
+
+	service := auto_reject.NewAutoRejectService(
+		ctx,
+		mocks.NewHolidayRepository(t),
+		mocks.NewEscalationRepository(t),
+		mocks.NewApprovalChainRepository(t),
+		mocks.NewBalanceRepository(t),
+		mocks.NewJobRunService(t),
+		mocks.NewDB(t),
+	)
+
+	_, err := service.PreviewAutoReject(ctx, constants.RoleManager)
+	assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+
+	_, err = service.RunAutoReject(ctx, constants.RoleEmployee, 1)
+	assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+}
@@ -0,0 +1,103 @@
+package balance_policies
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles balance policy HTTP requests
+type BalancePolicyHandler struct {
+	policyService interfaces.BalancePolicyService
+}
+
+// creates a new BalancePolicyHandler instance
+func NewBalancePolicyHandler(ctx context.Context, policyService interfaces.BalancePolicyService) *BalancePolicyHandler {
+	return &BalancePolicyHandler{policyService: policyService}
+}
+
+func (h *BalancePolicyHandler) GetPolicies(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleBalancePolicyError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	policies, err := h.policyService.GetPolicies(ctx, role)
+	if err != nil {
+		handleBalancePolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "balance policies fetched successfully", policies)
+}
+
+func (h *BalancePolicyHandler) SetPolicy(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+	if role != constants.RoleAdmin {
+		handleBalancePolicyError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	var policy models.BalancePolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		handleBalancePolicyError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+	policy.BalanceType = strings.ToUpper(c.Param("type"))
+	policy.ResetCycle = strings.ToUpper(policy.ResetCycle)
+	policy.Accrual = strings.ToUpper(policy.Accrual)
+
+	ctx := c.Request.Context()
+	saved, err := h.policyService.SetPolicy(ctx, role, adminID, policy)
+	if err != nil {
+		handleBalancePolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "balance policy saved successfully", saved)
+}
+
+func (h *BalancePolicyHandler) DeletePolicy(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleBalancePolicyError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.policyService.DeletePolicy(ctx, role, strings.ToUpper(c.Param("type"))); err != nil {
+		handleBalancePolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "balance policy deleted successfully", nil)
+}
+
+func handleBalancePolicyError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrBalancePolicyNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidRequestType,
+		apperrors.ErrInvalidResetCycle, apperrors.ErrInvalidFiscalStart,
+		apperrors.ErrInvalidAccrual, apperrors.ErrInvalidCarryForward,
+		apperrors.ErrCheckConstraintFailed:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
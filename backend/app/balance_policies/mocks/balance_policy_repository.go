@@ -0,0 +1,360 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BalancePolicyRepository is an autogenerated mock type for the BalancePolicyRepository type
+type BalancePolicyRepository struct {
+	mock.Mock
+}
+
+type BalancePolicyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BalancePolicyRepository) EXPECT() *BalancePolicyRepository_Expecter {
+	return &BalancePolicyRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeactivatePolicy provides a mock function with given fields: ctx, balanceType
+func (_m *BalancePolicyRepository) DeactivatePolicy(ctx context.Context, balanceType string) error {
+	ret := _m.Called(ctx, balanceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivatePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, balanceType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalancePolicyRepository_DeactivatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivatePolicy'
+type BalancePolicyRepository_DeactivatePolicy_Call struct {
+	*mock.Call
+}
+
+// DeactivatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - balanceType string
+func (_e *BalancePolicyRepository_Expecter) DeactivatePolicy(ctx interface{}, balanceType interface{}) *BalancePolicyRepository_DeactivatePolicy_Call {
+	return &BalancePolicyRepository_DeactivatePolicy_Call{Call: _e.mock.On("DeactivatePolicy", ctx, balanceType)}
+}
+
+func (_c *BalancePolicyRepository_DeactivatePolicy_Call) Run(run func(ctx context.Context, balanceType string)) *BalancePolicyRepository_DeactivatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyRepository_DeactivatePolicy_Call) Return(_a0 error) *BalancePolicyRepository_DeactivatePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalancePolicyRepository_DeactivatePolicy_Call) RunAndReturn(run func(context.Context, string) error) *BalancePolicyRepository_DeactivatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccounts provides a mock function with given fields: ctx, balanceType
+func (_m *BalancePolicyRepository) GetAccounts(ctx context.Context, balanceType string) ([]models.BalanceAccount, error) {
+	ret := _m.Called(ctx, balanceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccounts")
+	}
+
+	var r0 []models.BalanceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.BalanceAccount, error)); ok {
+		return rf(ctx, balanceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.BalanceAccount); ok {
+		r0 = rf(ctx, balanceType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalanceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, balanceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalancePolicyRepository_GetAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccounts'
+type BalancePolicyRepository_GetAccounts_Call struct {
+	*mock.Call
+}
+
+// GetAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - balanceType string
+func (_e *BalancePolicyRepository_Expecter) GetAccounts(ctx interface{}, balanceType interface{}) *BalancePolicyRepository_GetAccounts_Call {
+	return &BalancePolicyRepository_GetAccounts_Call{Call: _e.mock.On("GetAccounts", ctx, balanceType)}
+}
+
+func (_c *BalancePolicyRepository_GetAccounts_Call) Run(run func(ctx context.Context, balanceType string)) *BalancePolicyRepository_GetAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyRepository_GetAccounts_Call) Return(_a0 []models.BalanceAccount, _a1 error) *BalancePolicyRepository_GetAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalancePolicyRepository_GetAccounts_Call) RunAndReturn(run func(context.Context, string) ([]models.BalanceAccount, error)) *BalancePolicyRepository_GetAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx
+func (_m *BalancePolicyRepository) GetPolicies(ctx context.Context) ([]models.BalancePolicy, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 []models.BalancePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.BalancePolicy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.BalancePolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalancePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalancePolicyRepository_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type BalancePolicyRepository_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BalancePolicyRepository_Expecter) GetPolicies(ctx interface{}) *BalancePolicyRepository_GetPolicies_Call {
+	return &BalancePolicyRepository_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx)}
+}
+
+func (_c *BalancePolicyRepository_GetPolicies_Call) Run(run func(ctx context.Context)) *BalancePolicyRepository_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyRepository_GetPolicies_Call) Return(_a0 []models.BalancePolicy, _a1 error) *BalancePolicyRepository_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalancePolicyRepository_GetPolicies_Call) RunAndReturn(run func(context.Context) ([]models.BalancePolicy, error)) *BalancePolicyRepository_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockAccount provides a mock function with given fields: ctx, tx, balanceType, userID, leaveType
+func (_m *BalancePolicyRepository) LockAccount(ctx context.Context, tx interfaces.Tx, balanceType string, userID int64, leaveType string) (*models.BalanceAccount, error) {
+	ret := _m.Called(ctx, tx, balanceType, userID, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockAccount")
+	}
+
+	var r0 *models.BalanceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, string) (*models.BalanceAccount, error)); ok {
+		return rf(ctx, tx, balanceType, userID, leaveType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, string) *models.BalanceAccount); ok {
+		r0 = rf(ctx, tx, balanceType, userID, leaveType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BalanceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64, string) error); ok {
+		r1 = rf(ctx, tx, balanceType, userID, leaveType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalancePolicyRepository_LockAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockAccount'
+type BalancePolicyRepository_LockAccount_Call struct {
+	*mock.Call
+}
+
+// LockAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - balanceType string
+//   - userID int64
+//   - leaveType string
+func (_e *BalancePolicyRepository_Expecter) LockAccount(ctx interface{}, tx interface{}, balanceType interface{}, userID interface{}, leaveType interface{}) *BalancePolicyRepository_LockAccount_Call {
+	return &BalancePolicyRepository_LockAccount_Call{Call: _e.mock.On("LockAccount", ctx, tx, balanceType, userID, leaveType)}
+}
+
+func (_c *BalancePolicyRepository_LockAccount_Call) Run(run func(ctx context.Context, tx interfaces.Tx, balanceType string, userID int64, leaveType string)) *BalancePolicyRepository_LockAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyRepository_LockAccount_Call) Return(_a0 *models.BalanceAccount, _a1 error) *BalancePolicyRepository_LockAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalancePolicyRepository_LockAccount_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, string) (*models.BalanceAccount, error)) *BalancePolicyRepository_LockAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePolicy provides a mock function with given fields: ctx, tx, policy
+func (_m *BalancePolicyRepository) ReplacePolicy(ctx context.Context, tx interfaces.Tx, policy *models.BalancePolicy) error {
+	ret := _m.Called(ctx, tx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.BalancePolicy) error); ok {
+		r0 = rf(ctx, tx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalancePolicyRepository_ReplacePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePolicy'
+type BalancePolicyRepository_ReplacePolicy_Call struct {
+	*mock.Call
+}
+
+// ReplacePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - policy *models.BalancePolicy
+func (_e *BalancePolicyRepository_Expecter) ReplacePolicy(ctx interface{}, tx interface{}, policy interface{}) *BalancePolicyRepository_ReplacePolicy_Call {
+	return &BalancePolicyRepository_ReplacePolicy_Call{Call: _e.mock.On("ReplacePolicy", ctx, tx, policy)}
+}
+
+func (_c *BalancePolicyRepository_ReplacePolicy_Call) Run(run func(ctx context.Context, tx interfaces.Tx, policy *models.BalancePolicy)) *BalancePolicyRepository_ReplacePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.BalancePolicy))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyRepository_ReplacePolicy_Call) Return(_a0 error) *BalancePolicyRepository_ReplacePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalancePolicyRepository_ReplacePolicy_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.BalancePolicy) error) *BalancePolicyRepository_ReplacePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCycle provides a mock function with given fields: ctx, tx, cycle
+func (_m *BalancePolicyRepository) SaveCycle(ctx context.Context, tx interfaces.Tx, cycle models.BalanceCycle) error {
+	ret := _m.Called(ctx, tx, cycle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCycle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, models.BalanceCycle) error); ok {
+		r0 = rf(ctx, tx, cycle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalancePolicyRepository_SaveCycle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCycle'
+type BalancePolicyRepository_SaveCycle_Call struct {
+	*mock.Call
+}
+
+// SaveCycle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - cycle models.BalanceCycle
+func (_e *BalancePolicyRepository_Expecter) SaveCycle(ctx interface{}, tx interface{}, cycle interface{}) *BalancePolicyRepository_SaveCycle_Call {
+	return &BalancePolicyRepository_SaveCycle_Call{Call: _e.mock.On("SaveCycle", ctx, tx, cycle)}
+}
+
+func (_c *BalancePolicyRepository_SaveCycle_Call) Run(run func(ctx context.Context, tx interfaces.Tx, cycle models.BalanceCycle)) *BalancePolicyRepository_SaveCycle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(models.BalanceCycle))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyRepository_SaveCycle_Call) Return(_a0 error) *BalancePolicyRepository_SaveCycle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalancePolicyRepository_SaveCycle_Call) RunAndReturn(run func(context.Context, interfaces.Tx, models.BalanceCycle) error) *BalancePolicyRepository_SaveCycle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBalancePolicyRepository creates a new instance of BalancePolicyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBalancePolicyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BalancePolicyRepository {
+	mock := &BalancePolicyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BalancePolicyService is an autogenerated mock type for the BalancePolicyService type
+type BalancePolicyService struct {
+	mock.Mock
+}
+
+type BalancePolicyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BalancePolicyService) EXPECT() *BalancePolicyService_Expecter {
+	return &BalancePolicyService_Expecter{mock: &_m.Mock}
+}
+
+// ApplyPolicies provides a mock function with given fields: ctx
+func (_m *BalancePolicyService) ApplyPolicies(ctx context.Context) (map[string]int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPolicies")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalancePolicyService_ApplyPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyPolicies'
+type BalancePolicyService_ApplyPolicies_Call struct {
+	*mock.Call
+}
+
+// ApplyPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BalancePolicyService_Expecter) ApplyPolicies(ctx interface{}) *BalancePolicyService_ApplyPolicies_Call {
+	return &BalancePolicyService_ApplyPolicies_Call{Call: _e.mock.On("ApplyPolicies", ctx)}
+}
+
+func (_c *BalancePolicyService_ApplyPolicies_Call) Run(run func(ctx context.Context)) *BalancePolicyService_ApplyPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyService_ApplyPolicies_Call) Return(_a0 map[string]int, _a1 error) *BalancePolicyService_ApplyPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalancePolicyService_ApplyPolicies_Call) RunAndReturn(run func(context.Context) (map[string]int, error)) *BalancePolicyService_ApplyPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePolicy provides a mock function with given fields: ctx, role, balanceType
+func (_m *BalancePolicyService) DeletePolicy(ctx context.Context, role string, balanceType string) error {
+	ret := _m.Called(ctx, role, balanceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, role, balanceType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalancePolicyService_DeletePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePolicy'
+type BalancePolicyService_DeletePolicy_Call struct {
+	*mock.Call
+}
+
+// DeletePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - balanceType string
+func (_e *BalancePolicyService_Expecter) DeletePolicy(ctx interface{}, role interface{}, balanceType interface{}) *BalancePolicyService_DeletePolicy_Call {
+	return &BalancePolicyService_DeletePolicy_Call{Call: _e.mock.On("DeletePolicy", ctx, role, balanceType)}
+}
+
+func (_c *BalancePolicyService_DeletePolicy_Call) Run(run func(ctx context.Context, role string, balanceType string)) *BalancePolicyService_DeletePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyService_DeletePolicy_Call) Return(_a0 error) *BalancePolicyService_DeletePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalancePolicyService_DeletePolicy_Call) RunAndReturn(run func(context.Context, string, string) error) *BalancePolicyService_DeletePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx, role
+func (_m *BalancePolicyService) GetPolicies(ctx context.Context, role string) ([]models.BalancePolicy, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 []models.BalancePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.BalancePolicy, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.BalancePolicy); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalancePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalancePolicyService_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type BalancePolicyService_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *BalancePolicyService_Expecter) GetPolicies(ctx interface{}, role interface{}) *BalancePolicyService_GetPolicies_Call {
+	return &BalancePolicyService_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx, role)}
+}
+
+func (_c *BalancePolicyService_GetPolicies_Call) Run(run func(ctx context.Context, role string)) *BalancePolicyService_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyService_GetPolicies_Call) Return(_a0 []models.BalancePolicy, _a1 error) *BalancePolicyService_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalancePolicyService_GetPolicies_Call) RunAndReturn(run func(context.Context, string) ([]models.BalancePolicy, error)) *BalancePolicyService_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPolicy provides a mock function with given fields: ctx, role, adminID, policy
+func (_m *BalancePolicyService) SetPolicy(ctx context.Context, role string, adminID int64, policy models.BalancePolicy) (*models.BalancePolicy, error) {
+	ret := _m.Called(ctx, role, adminID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPolicy")
+	}
+
+	var r0 *models.BalancePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.BalancePolicy) (*models.BalancePolicy, error)); ok {
+		return rf(ctx, role, adminID, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.BalancePolicy) *models.BalancePolicy); ok {
+		r0 = rf(ctx, role, adminID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BalancePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.BalancePolicy) error); ok {
+		r1 = rf(ctx, role, adminID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalancePolicyService_SetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPolicy'
+type BalancePolicyService_SetPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - policy models.BalancePolicy
+func (_e *BalancePolicyService_Expecter) SetPolicy(ctx interface{}, role interface{}, adminID interface{}, policy interface{}) *BalancePolicyService_SetPolicy_Call {
+	return &BalancePolicyService_SetPolicy_Call{Call: _e.mock.On("SetPolicy", ctx, role, adminID, policy)}
+}
+
+func (_c *BalancePolicyService_SetPolicy_Call) Run(run func(ctx context.Context, role string, adminID int64, policy models.BalancePolicy)) *BalancePolicyService_SetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.BalancePolicy))
+	})
+	return _c
+}
+
+func (_c *BalancePolicyService_SetPolicy_Call) Return(_a0 *models.BalancePolicy, _a1 error) *BalancePolicyService_SetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalancePolicyService_SetPolicy_Call) RunAndReturn(run func(context.Context, string, int64, models.BalancePolicy) (*models.BalancePolicy, error)) *BalancePolicyService_SetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBalancePolicyService creates a new instance of BalancePolicyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBalancePolicyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BalancePolicyService {
+	mock := &BalancePolicyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,198 @@
+package balance_policies
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// BalancePolicyService manages balance policies and applies them to every wallet
+type BalancePolicyService struct {
+	policyRepo  interfaces.BalancePolicyRepository
+	balanceRepo interfaces.BalanceRepository
+	db          interfaces.DB
+}
+
+// NewBalancePolicyService creates a new instance of BalancePolicyService
+func NewBalancePolicyService(
+	ctx context.Context,
+	policyRepo interfaces.BalancePolicyRepository,
+	balanceRepo interfaces.BalanceRepository,
+	db interfaces.DB,
+) interfaces.BalancePolicyService {
+	return &BalancePolicyService{
+		policyRepo:  policyRepo,
+		balanceRepo: balanceRepo,
+		db:          db,
+	}
+}
+
+// GetPolicies lists the active balance policies (admin only)
+func (s *BalancePolicyService) GetPolicies(ctx context.Context, role string) ([]models.BalancePolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+	return s.policyRepo.GetPolicies(ctx)
+}
+
+// SetPolicy replaces the policy of a balance type (admin only). Wallets move to the new
+// policy the next time the policy job runs.
+func (s *BalancePolicyService) SetPolicy(ctx context.Context, role string, adminID int64, policy models.BalancePolicy) (*models.BalancePolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if !utils.IsValidRequestType(policy.BalanceType) {
+		return nil, apperrors.ErrInvalidRequestType
+	}
+	if err := validatePolicy(&policy); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	policy.UpdatedBy = &adminID
+	if err := s.policyRepo.ReplacePolicy(ctx, tx, &policy); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeletePolicy deactivates the policy of a balance type (admin only). Its wallets keep
+// their balance and are no longer reset or replenished.
+func (s *BalancePolicyService) DeletePolicy(ctx context.Context, role string, balanceType string) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrUnauthorized
+	}
+	return s.policyRepo.DeactivatePolicy(ctx, balanceType)
+}
+
+// ApplyPolicies brings every wallet of a balance type with a policy up to date: the
+// reset at the start of a cycle, allocation or monthly accrual, and expiry of carried
+// over balance. Each wallet changes in its own transaction; the run stops at the first
+// failing wallet and the next run picks up from where the wallets stand. It returns the
+// number of ledger entries written by entry type and the number of wallets updated.
+func (s *BalancePolicyService) ApplyPolicies(ctx context.Context) (map[string]int, error) {
+	counts := map[string]int{}
+
+	policies, err := s.policyRepo.GetPolicies(ctx)
+	if err != nil {
+		return counts, err
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	for _, policy := range policies {
+		accounts, err := s.policyRepo.GetAccounts(ctx, policy.BalanceType)
+		if err != nil {
+			return counts, err
+		}
+
+		for _, account := range accounts {
+			if utils.PlanBalancePolicy(policy, account, today) == nil {
+				continue
+			}
+
+			plan, err := s.applyPlan(ctx, policy, account, today)
+			if err != nil {
+				return counts, err
+			}
+			if plan == nil {
+				continue
+			}
+
+			counts["updated"]++
+			for _, entry := range plan.Entries {
+				counts[strings.ToLower(entry.EntryType)]++
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// applyPlan locks the wallet, plans it again from what it holds now and writes the
+// ledger entries of the plan with the new total and cycle state in one transaction. A
+// wallet another run brought up to date in the meantime gets no plan.
+func (s *BalancePolicyService) applyPlan(ctx context.Context, policy models.BalancePolicy, account models.BalanceAccount, today time.Time) (*models.BalancePlan, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	locked, err := s.policyRepo.LockAccount(ctx, tx, policy.BalanceType, account.UserID, account.LeaveType)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := utils.PlanBalancePolicy(policy, *locked, today)
+	if plan == nil {
+		return nil, nil
+	}
+
+	for _, entry := range plan.Entries {
+		change := models.BalanceChange{Reason: entry.Reason}
+		if err := s.balanceRepo.AdjustBalance(ctx, tx, account.UserID, policy.BalanceType, account.LeaveType, entry.EntryType, entry.Amount, change); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.balanceRepo.SetBalanceTotal(ctx, tx, account.UserID, policy.BalanceType, account.LeaveType, plan.Total); err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.SaveCycle(ctx, tx, plan.Cycle); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// validatePolicy checks the settings of a policy. Calendar-year cycles always start in
+// January.
+func validatePolicy(policy *models.BalancePolicy) error {
+	switch policy.ResetCycle {
+	case constants.ResetCalendar:
+		policy.FiscalStartMonth = 1
+	case constants.ResetFiscal:
+		if policy.FiscalStartMonth < 1 || policy.FiscalStartMonth > 12 {
+			return apperrors.ErrInvalidFiscalStart
+		}
+	default:
+		return apperrors.ErrInvalidResetCycle
+	}
+
+	if policy.Accrual != constants.AccrualAnnual && policy.Accrual != constants.AccrualMonthly {
+		return apperrors.ErrInvalidAccrual
+	}
+
+	if policy.CarryForwardCap != nil && *policy.CarryForwardCap < 0 {
+		return apperrors.ErrInvalidCarryForward
+	}
+	if months := policy.CarryForwardExpiryMonths; months != nil && (*months < 1 || *months > 12) {
+		return apperrors.ErrInvalidCarryForward
+	}
+
+	return nil
+}
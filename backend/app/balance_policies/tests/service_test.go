@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/balance_policies"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/balance_policies/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func float64Ptr(v float64) *float64 { return &v }
+func intPtr(v int) *int             { return &v }
+
+func TestBalancePolicyService_SetPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	valid := models.BalancePolicy{
+		BalanceType:              "LEAVE",
+		ResetCycle:               constants.ResetFiscal,
+		FiscalStartMonth:         4,
+		Accrual:                  constants.AccrualMonthly,
+		CarryForwardCap:          float64Ptr(10),
+		CarryForwardExpiryMonths: intPtr(3),
+		ProrateJoiners:           true,
+	}
+
+	tests := []struct {
+		name        string
+		role        string
+		policy      func(p models.BalancePolicy) models.BalancePolicy
+		mockSetup   func(r *mocks.BalancePolicyRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			role:   constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy { return p },
+			mockSetup: func(r *mocks.BalancePolicyRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().ReplacePolicy(ctx, tx, mock.MatchedBy(func(p *models.BalancePolicy) bool {
+					return p.BalanceType == "LEAVE" && p.UpdatedBy != nil && *p.UpdatedBy == 1
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:        "Not Admin",
+			role:        constants.RoleManager,
+			policy:      func(p models.BalancePolicy) models.BalancePolicy { return p },
+			expectedErr: apperrors.ErrUnauthorized,
+		},
+		{
+			name: "Invalid Balance Type",
+			role: constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy {
+				p.BalanceType = "TRAVEL"
+				return p
+			},
+			expectedErr: apperrors.ErrInvalidRequestType,
+		},
+		{
+			name: "Invalid Reset Cycle",
+			role: constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy {
+				p.ResetCycle = "WEEKLY"
+				return p
+			},
+			expectedErr: apperrors.ErrInvalidResetCycle,
+		},
+		{
+			name: "Invalid Fiscal Start",
+			role: constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy {
+				p.FiscalStartMonth = 13
+				return p
+			},
+			expectedErr: apperrors.ErrInvalidFiscalStart,
+		},
+		{
+			name: "Invalid Accrual",
+			role: constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy {
+				p.Accrual = "DAILY"
+				return p
+			},
+			expectedErr: apperrors.ErrInvalidAccrual,
+		},
+		{
+			name: "Negative Carry Forward Cap",
+			role: constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy {
+				p.CarryForwardCap = float64Ptr(-1)
+				return p
+			},
+			expectedErr: apperrors.ErrInvalidCarryForward,
+		},
+		{
+			name: "Carry Forward Expiry Out Of Range",
+			role: constants.RoleAdmin,
+			policy: func(p models.BalancePolicy) models.BalancePolicy {
+				p.CarryForwardExpiryMonths = intPtr(0)
+				return p
+			},
+			expectedErr: apperrors.ErrInvalidCarryForward,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPolicyRepo := mocks.NewBalancePolicyRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockPolicyRepo, mockDB, mockTx)
+			}
+
+			service := balance_policies.NewBalancePolicyService(ctx, mockPolicyRepo, mocks.NewBalanceRepository(t), mockDB)
+			saved, err := service.SetPolicy(ctx, tt.role, 1, tt.policy(valid))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 4, saved.FiscalStartMonth)
+		})
+	}
+
+	t.Run("Calendar Cycle Starts In January", func(t *testing.T) {
+		mockPolicyRepo := mocks.NewBalancePolicyRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockPolicyRepo.EXPECT().ReplacePolicy(ctx, mockTx, mock.Anything).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		policy := valid
+		policy.ResetCycle = constants.ResetCalendar
+		policy.FiscalStartMonth = 7
+
+		service := balance_policies.NewBalancePolicyService(ctx, mockPolicyRepo, mocks.NewBalanceRepository(t), mockDB)
+		saved, err := service.SetPolicy(ctx, constants.RoleAdmin, 1, policy)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, saved.FiscalStartMonth)
+	})
+}
+
+func TestBalancePolicyService_DeletePolicy(t *testing.T) {
+	ctx := context.Background()
+
+	mockPolicyRepo := mocks.NewBalancePolicyRepository(t)
+	service := balance_policies.NewBalancePolicyService(ctx, mockPolicyRepo, mocks.NewBalanceRepository(t), mocks.NewDB(t))
+
+	assert.ErrorIs(t, service.DeletePolicy(ctx, constants.RoleEmployee, "LEAVE"), apperrors.ErrUnauthorized)
+
+	mockPolicyRepo.EXPECT().DeactivatePolicy(ctx, "EXPENSE").Return(apperrors.ErrBalancePolicyNotFound)
+	assert.ErrorIs(t, service.DeletePolicy(ctx, constants.RoleAdmin, "EXPENSE"), apperrors.ErrBalancePolicyNotFound)
+}
+
+func TestBalancePolicyService_ApplyPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	policy := models.BalancePolicy{
+		BalanceType:     "LEAVE",
+		ResetCycle:      constants.ResetCalendar,
+		Accrual:         constants.AccrualAnnual,
+		CarryForwardCap: float64Ptr(5),
+	}
+	// a wallet still in a cycle that ended long ago, and one the job already brought up
+	// to date this cycle
+	stale := models.BalanceAccount{
+		UserID: 10, LeaveType: "EARN", JoinedAt: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), AnnualLimit: 20, Total: 20, Remaining: 8,
+		Cycle: &models.BalanceCycle{UserID: 10, BalanceType: "LEAVE", LeaveType: "EARN", CycleStart: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), Allocated: 20},
+	}
+	current := models.BalanceAccount{
+		UserID: 11, LeaveType: "EARN", JoinedAt: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), AnnualLimit: 20, Total: 20, Remaining: 20,
+		Cycle: &models.BalanceCycle{UserID: 11, BalanceType: "LEAVE", LeaveType: "EARN", CycleStart: time.Date(time.Now().Year(), 1, 1, 0, 0, 0, 0, time.UTC), Allocated: 20},
+	}
+
+	t.Run("Resets Stale Wallets", func(t *testing.T) {
+		mockPolicyRepo := mocks.NewBalancePolicyRepository(t)
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockPolicyRepo.EXPECT().GetPolicies(ctx).Return([]models.BalancePolicy{policy}, nil)
+		mockPolicyRepo.EXPECT().GetAccounts(ctx, "LEAVE").Return([]models.BalanceAccount{stale, current}, nil)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil).Once()
+		mockPolicyRepo.EXPECT().LockAccount(ctx, mockTx, "LEAVE", int64(10), "EARN").Return(&stale, nil)
+		mockBalanceRepo.EXPECT().AdjustBalance(ctx, mockTx, int64(10), "LEAVE", "EARN", constants.LedgerReset, float64(-3), mock.Anything).Return(nil)
+		mockBalanceRepo.EXPECT().AdjustBalance(ctx, mockTx, int64(10), "LEAVE", "EARN", constants.LedgerAllocation, float64(20), mock.Anything).Return(nil)
+		mockBalanceRepo.EXPECT().SetBalanceTotal(ctx, mockTx, int64(10), "LEAVE", "EARN", float64(25)).Return(nil)
+		mockPolicyRepo.EXPECT().SaveCycle(ctx, mockTx, mock.MatchedBy(func(c models.BalanceCycle) bool {
+			return c.UserID == 10 && c.LeaveType == "EARN" && c.CarriedOver == 5 && c.Allocated == 20 && c.CycleStart.Year() == time.Now().Year()
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := balance_policies.NewBalancePolicyService(ctx, mockPolicyRepo, mockBalanceRepo, mockDB)
+		counts, err := service.ApplyPolicies(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"updated": 1, "reset": 1, "allocation": 1}, counts)
+	})
+
+	t.Run("Stops At Failing Wallet", func(t *testing.T) {
+		mockPolicyRepo := mocks.NewBalancePolicyRepository(t)
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		dbErr := errors.New("db down")
+		mockPolicyRepo.EXPECT().GetPolicies(ctx).Return([]models.BalancePolicy{policy}, nil)
+		mockPolicyRepo.EXPECT().GetAccounts(ctx, "LEAVE").Return([]models.BalanceAccount{stale}, nil)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockPolicyRepo.EXPECT().LockAccount(ctx, mockTx, "LEAVE", int64(10), "EARN").Return(&stale, nil)
+		mockBalanceRepo.EXPECT().AdjustBalance(ctx, mockTx, int64(10), "LEAVE", "EARN", constants.LedgerReset, float64(-3), mock.Anything).Return(dbErr)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := balance_policies.NewBalancePolicyService(ctx, mockPolicyRepo, mockBalanceRepo, mockDB)
+		counts, err := service.ApplyPolicies(ctx)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Empty(t, counts)
+	})
+
+	t.Run("Skips Wallet Another Run Applied", func(t *testing.T) {
+		mockPolicyRepo := mocks.NewBalancePolicyRepository(t)
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		// the wallet read before the lock was stale, but a concurrent run reset it first
+		applied := current
+		applied.UserID = 10
+
+		mockPolicyRepo.EXPECT().GetPolicies(ctx).Return([]models.BalancePolicy{policy}, nil)
+		mockPolicyRepo.EXPECT().GetAccounts(ctx, "LEAVE").Return([]models.BalanceAccount{stale}, nil)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockPolicyRepo.EXPECT().LockAccount(ctx, mockTx, "LEAVE", int64(10), "EARN").Return(&applied, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := balance_policies.NewBalancePolicyService(ctx, mockPolicyRepo, mockBalanceRepo, mockDB)
+		counts, err := service.ApplyPolicies(ctx)
+
+		assert.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+}
@@ -0,0 +1,9 @@
+package delegations
+
+type DelegationRequest struct {
+	DelegatorID int64  `json:"delegator_id"`
+	DelegateID  int64  `json:"delegate_id"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	OnLeaveOnly bool   `json:"on_leave_only"`
+}
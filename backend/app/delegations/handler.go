@@ -0,0 +1,121 @@
+package delegations
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles approval delegation HTTP requests
+type DelegationHandler struct {
+	delegationService interfaces.DelegationService
+}
+
+// creates a new DelegationHandler instance
+func NewDelegationHandler(ctx context.Context, delegationService interfaces.DelegationService) *DelegationHandler {
+	return &DelegationHandler{delegationService: delegationService}
+}
+
+func (h *DelegationHandler) CreateDelegation(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	var req DelegationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleDelegationError(c, apperrors.ErrInvalidInput)
+		return
+	}
+
+	// delegations start today unless a start date is given
+	delegation := models.ApprovalDelegation{
+		DelegatorID: req.DelegatorID,
+		DelegateID:  req.DelegateID,
+		StartDate:   time.Now().Truncate(24 * time.Hour),
+		OnLeaveOnly: req.OnLeaveOnly,
+	}
+
+	if req.StartDate != "" {
+		start, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			handleDelegationError(c, apperrors.ErrInvalidDateFormat)
+			return
+		}
+		delegation.StartDate = start
+	}
+
+	if req.EndDate != "" {
+		end, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			handleDelegationError(c, apperrors.ErrInvalidDateFormat)
+			return
+		}
+		delegation.EndDate = &end
+	}
+
+	ctx := c.Request.Context()
+	created, err := h.delegationService.CreateDelegation(ctx, role, userID, delegation)
+	if err != nil {
+		handleDelegationError(c, err)
+		return
+	}
+
+	response.Created(c, "Delegation created successfully", created)
+}
+
+func (h *DelegationHandler) GetDelegations(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	ctx := c.Request.Context()
+	delegations, err := h.delegationService.GetDelegations(ctx, role, userID)
+	if err != nil {
+		handleDelegationError(c, err)
+		return
+	}
+
+	response.Success(c, "Delegations fetched successfully", delegations)
+}
+
+func (h *DelegationHandler) RevokeDelegation(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	delegationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleDelegationError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.delegationService.RevokeDelegation(ctx, role, userID, delegationID); err != nil {
+		handleDelegationError(c, err)
+		return
+	}
+
+	response.Success(c, "Delegation revoked successfully", nil)
+}
+
+func handleDelegationError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrDelegatorNotApprover:
+		status = http.StatusForbidden
+	case apperrors.ErrDelegationNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidInput, apperrors.ErrInvalidID, apperrors.ErrInvalidDateFormat,
+		apperrors.ErrInvalidDateRange, apperrors.ErrDelegationEnded, apperrors.ErrSelfDelegation,
+		apperrors.ErrInvalidDelegate, apperrors.ErrUserNotFound:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
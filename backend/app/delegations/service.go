@@ -0,0 +1,168 @@
+package delegations
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// DelegationService manages approval delegations and resolves who an approver decides for
+type DelegationService struct {
+	delegationRepo interfaces.DelegationRepository
+	userRepo       interfaces.UserRepository
+}
+
+// NewDelegationService creates a new instance of DelegationService
+func NewDelegationService(ctx context.Context, delegationRepo interfaces.DelegationRepository, userRepo interfaces.UserRepository) interfaces.DelegationService {
+	return &DelegationService{
+		delegationRepo: delegationRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// CreateDelegation lets an approver hand their approval authority to another approver for a
+// date range. Admins may create delegations on behalf of any approver.
+func (s *DelegationService) CreateDelegation(ctx context.Context, role string, userID int64, delegation models.ApprovalDelegation) (*models.ApprovalDelegation, error) {
+	if role == constants.RoleEmployee {
+		return nil, apperrors.ErrDelegatorNotApprover
+	}
+
+	if role != constants.RoleAdmin || delegation.DelegatorID == 0 {
+		delegation.DelegatorID = userID
+	}
+
+	if delegation.DelegatorID == delegation.DelegateID {
+		return nil, apperrors.ErrSelfDelegation
+	}
+
+	if delegation.EndDate != nil {
+		if delegation.EndDate.Before(delegation.StartDate) {
+			return nil, apperrors.ErrInvalidDateRange
+		}
+		today := time.Now().Truncate(24 * time.Hour)
+		if delegation.EndDate.Before(today) {
+			return nil, apperrors.ErrDelegationEnded
+		}
+	}
+
+	delegator, err := s.userRepo.GetByID(ctx, delegation.DelegatorID)
+	if err != nil {
+		return nil, err
+	}
+	if delegator.Role == constants.RoleEmployee {
+		return nil, apperrors.ErrDelegatorNotApprover
+	}
+
+	delegate, err := s.userRepo.GetByID(ctx, delegation.DelegateID)
+	if err != nil {
+		return nil, err
+	}
+	if delegate.Role == constants.RoleEmployee {
+		return nil, apperrors.ErrInvalidDelegate
+	}
+
+	delegation.CreatedBy = userID
+	if err := s.delegationRepo.Create(ctx, &delegation); err != nil {
+		return nil, err
+	}
+
+	return &delegation, nil
+}
+
+// GetDelegations lists every active delegation for admins, and the caller's own otherwise
+func (s *DelegationService) GetDelegations(ctx context.Context, role string, userID int64) ([]models.ApprovalDelegation, error) {
+	if role == constants.RoleAdmin {
+		return s.delegationRepo.GetAll(ctx)
+	}
+	return s.delegationRepo.GetForUser(ctx, userID)
+}
+
+// RevokeDelegation ends a delegation; only its delegator or an admin may revoke it
+func (s *DelegationService) RevokeDelegation(ctx context.Context, role string, userID, delegationID int64) error {
+	delegation, err := s.delegationRepo.GetByID(ctx, delegationID)
+	if err != nil {
+		return err
+	}
+
+	if role != constants.RoleAdmin && delegation.DelegatorID != userID {
+		return apperrors.ErrUnauthorized
+	}
+
+	return s.delegationRepo.Revoke(ctx, delegationID)
+}
+
+// GetApproverIDs returns the approver together with everyone whose queue they cover today
+func (s *DelegationService) GetApproverIDs(ctx context.Context, approverID int64) ([]int64, error) {
+	delegators, err := s.delegationRepo.GetDelegators(ctx, approverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]int64{approverID}, delegators...), nil
+}
+
+// ResolveActor works out who the approver decides a request as. Approvers who may decide
+// the request on their own authority act as themselves. Otherwise, when they stand in for
+// the request's expected approver under a delegation in effect, they act as that user;
+// failing that they act as themselves and are turned away by the caller. Requests routed
+// to a role have no single approver to stand in for.
+func (s *DelegationService) ResolveActor(ctx context.Context, tx interfaces.Tx, role string, approverID, requesterID int64, requesterRole, routedToRole string, routedToUserID *int64) (*models.ApprovalActor, error) {
+	self := &models.ApprovalActor{Role: role, UserID: approverID}
+
+	if utils.ValidateRequestApprover(role, approverID, requesterRole, routedToRole, routedToUserID) == nil {
+		return self, nil
+	}
+
+	var expectedID int64
+	switch {
+	case routedToUserID != nil:
+		expectedID = *routedToUserID
+	case routedToRole != "":
+		return self, nil
+	default:
+		requester, err := s.userRepo.GetByID(ctx, requesterID)
+		if err != nil {
+			return nil, err
+		}
+		if requester.ManagerID == nil {
+			return self, nil
+		}
+		expectedID = *requester.ManagerID
+	}
+
+	if expectedID == approverID || expectedID == requesterID {
+		return self, nil
+	}
+
+	delegation, err := s.delegationRepo.GetInEffect(ctx, tx, expectedID, approverID)
+	if err == apperrors.ErrDelegationNotFound {
+		return self, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorRole, err := s.userRepo.GetRole(ctx, tx, expectedID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ApprovalActor{
+		Role:       delegatorRole,
+		UserID:     expectedID,
+		Delegation: delegation,
+	}, nil
+}
+
+// RecordDecision stores the delegation a decision was made under on the request
+func (s *DelegationService) RecordDecision(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, actor *models.ApprovalActor) error {
+	if actor == nil || actor.Delegation == nil {
+		return nil
+	}
+	return s.delegationRepo.RecordDecision(ctx, tx, requestType, requestID, actor.Delegation)
+}
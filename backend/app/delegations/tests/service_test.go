@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestDelegationService_CreateDelegation(t *testing.T) {
+	ctx := context.Background()
+
+	today := time.Now().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+	nextWeek := today.AddDate(0, 0, 7)
+
+	manager := &models.User{ID: 10, Role: constants.RoleManager}
+	deputy := &models.User{ID: 11, Role: constants.RoleManager}
+	employee := &models.User{ID: 12, Role: constants.RoleEmployee}
+
+	tests := []struct {
+		name          string
+		role          string
+		userID        int64
+		delegation    models.ApprovalDelegation
+		mockSetup     func(r *mocks.DelegationRepository, u *mocks.UserRepository)
+		expectedError error
+	}{
+		{
+			name:          "Employee Cannot Delegate",
+			role:          constants.RoleEmployee,
+			userID:        12,
+			delegation:    models.ApprovalDelegation{DelegateID: 11, StartDate: today},
+			mockSetup:     func(r *mocks.DelegationRepository, u *mocks.UserRepository) {},
+			expectedError: apperrors.ErrDelegatorNotApprover,
+		},
+		{
+			name:          "Delegate To Self",
+			role:          constants.RoleManager,
+			userID:        10,
+			delegation:    models.ApprovalDelegation{DelegateID: 10, StartDate: today},
+			mockSetup:     func(r *mocks.DelegationRepository, u *mocks.UserRepository) {},
+			expectedError: apperrors.ErrSelfDelegation,
+		},
+		{
+			name:          "End Before Start",
+			role:          constants.RoleManager,
+			userID:        10,
+			delegation:    models.ApprovalDelegation{DelegateID: 11, StartDate: nextWeek, EndDate: &today},
+			mockSetup:     func(r *mocks.DelegationRepository, u *mocks.UserRepository) {},
+			expectedError: apperrors.ErrInvalidDateRange,
+		},
+		{
+			name:          "Already Ended",
+			role:          constants.RoleManager,
+			userID:        10,
+			delegation:    models.ApprovalDelegation{DelegateID: 11, StartDate: yesterday.AddDate(0, 0, -1), EndDate: &yesterday},
+			mockSetup:     func(r *mocks.DelegationRepository, u *mocks.UserRepository) {},
+			expectedError: apperrors.ErrDelegationEnded,
+		},
+		{
+			name:       "Delegate Is Employee",
+			role:       constants.RoleManager,
+			userID:     10,
+			delegation: models.ApprovalDelegation{DelegateID: 12, StartDate: today},
+			mockSetup: func(r *mocks.DelegationRepository, u *mocks.UserRepository) {
+				u.EXPECT().GetByID(ctx, int64(10)).Return(manager, nil)
+				u.EXPECT().GetByID(ctx, int64(12)).Return(employee, nil)
+			},
+			expectedError: apperrors.ErrInvalidDelegate,
+		},
+		{
+			name:       "Manager Delegates Own Authority",
+			role:       constants.RoleManager,
+			userID:     10,
+			delegation: models.ApprovalDelegation{DelegatorID: 99, DelegateID: 11, StartDate: today, EndDate: &nextWeek},
+			mockSetup: func(r *mocks.DelegationRepository, u *mocks.UserRepository) {
+				u.EXPECT().GetByID(ctx, int64(10)).Return(manager, nil)
+				u.EXPECT().GetByID(ctx, int64(11)).Return(deputy, nil)
+				r.EXPECT().Create(ctx, mock.MatchedBy(func(d *models.ApprovalDelegation) bool {
+					return d.DelegatorID == 10 && d.DelegateID == 11 && d.CreatedBy == 10
+				})).Return(nil)
+			},
+		},
+		{
+			name:       "Admin Delegates For Manager",
+			role:       constants.RoleAdmin,
+			userID:     1,
+			delegation: models.ApprovalDelegation{DelegatorID: 10, DelegateID: 11, StartDate: today, OnLeaveOnly: true},
+			mockSetup: func(r *mocks.DelegationRepository, u *mocks.UserRepository) {
+				u.EXPECT().GetByID(ctx, int64(10)).Return(manager, nil)
+				u.EXPECT().GetByID(ctx, int64(11)).Return(deputy, nil)
+				r.EXPECT().Create(ctx, mock.MatchedBy(func(d *models.ApprovalDelegation) bool {
+					return d.DelegatorID == 10 && d.OnLeaveOnly && d.CreatedBy == 1
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelegationRepo := mocks.NewDelegationRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+
+			tt.mockSetup(mockDelegationRepo, mockUserRepo)
+
+			service := delegations.NewDelegationService(ctx, mockDelegationRepo, mockUserRepo)
+			_, err := service.CreateDelegation(ctx, tt.role, tt.userID, tt.delegation)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDelegationService_ResolveActor(t *testing.T) {
+	ctx := context.Background()
+
+	// the requester is a manager reporting to admin 10, which manager 11 cannot approve
+	// for on their own
+	delegation := &models.ApprovalDelegation{ID: 5, DelegatorID: 10, DelegateID: 11}
+	requester := &models.User{ID: 20, Role: constants.RoleManager, ManagerID: int64Ptr(10)}
+
+	tests := []struct {
+		name           string
+		requesterRole  string
+		routedToRole   string
+		routedToUserID *int64
+		mockSetup      func(r *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx)
+		expected       *models.ApprovalActor
+	}{
+		{
+			name:          "Stands In For Reporting Manager",
+			requesterRole: constants.RoleManager,
+			mockSetup: func(r *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				u.EXPECT().GetByID(ctx, int64(20)).Return(requester, nil)
+				r.EXPECT().GetInEffect(ctx, tx, int64(10), int64(11)).Return(delegation, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(10)).Return(constants.RoleAdmin, nil)
+			},
+			expected: &models.ApprovalActor{Role: constants.RoleAdmin, UserID: 10, Delegation: delegation},
+		},
+		{
+			// a manager may decide for an employee on their own, delegation or not
+			name:          "Own Authority First",
+			requesterRole: constants.RoleEmployee,
+			mockSetup:     func(r *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {},
+			expected:      &models.ApprovalActor{Role: constants.RoleManager, UserID: 11},
+		},
+		{
+			name:           "Stands In For Routed User",
+			requesterRole:  constants.RoleEmployee,
+			routedToUserID: int64Ptr(30),
+			mockSetup: func(r *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				r.EXPECT().GetInEffect(ctx, tx, int64(30), int64(11)).Return(delegation, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(30)).Return(constants.RoleAdmin, nil)
+			},
+			expected: &models.ApprovalActor{Role: constants.RoleAdmin, UserID: 30, Delegation: delegation},
+		},
+		{
+			name:          "No Delegation In Effect",
+			requesterRole: constants.RoleManager,
+			mockSetup: func(r *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				u.EXPECT().GetByID(ctx, int64(20)).Return(requester, nil)
+				r.EXPECT().GetInEffect(ctx, tx, int64(10), int64(11)).Return(nil, apperrors.ErrDelegationNotFound)
+			},
+			expected: &models.ApprovalActor{Role: constants.RoleManager, UserID: 11},
+		},
+		{
+			name:          "Routed To Role",
+			requesterRole: constants.RoleManager,
+			routedToRole:  constants.RoleManager,
+			mockSetup:     func(r *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {},
+			expected:      &models.ApprovalActor{Role: constants.RoleManager, UserID: 11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelegationRepo := mocks.NewDelegationRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockDelegationRepo, mockUserRepo, mockTx)
+
+			service := delegations.NewDelegationService(ctx, mockDelegationRepo, mockUserRepo)
+			actor, err := service.ResolveActor(ctx, mockTx, constants.RoleManager, 11, 20, tt.requesterRole, tt.routedToRole, tt.routedToUserID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actor)
+		})
+	}
+}
@@ -2,9 +2,14 @@ package domain_service
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
 
@@ -25,7 +30,7 @@ func (h *BalanceHandler) GetBalances(c *gin.Context) {
 
 	balances, err := h.balanceService.GetBalances(ctx, userID)
 	if err != nil {
-		handleBalanceError(c, err)
+		handleBalanceError(c, err, nil)
 		return
 	}
 
@@ -36,13 +41,136 @@ func (h *BalanceHandler) GetBalances(c *gin.Context) {
 	)
 }
 
-func handleBalanceError(c *gin.Context, err error) {
+// GetBalanceHistory lists the ledger entries behind the caller's balances
+func (h *BalanceHandler) GetBalanceHistory(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(constants.DefaultLedgerLimit)))
+	if err != nil || limit <= 0 {
+		limit = constants.DefaultLedgerLimit
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	balanceType := strings.ToUpper(c.Query("type"))
+
+	entries, err := h.balanceService.GetBalanceHistory(ctx, userID, balanceType, limit, offset)
+	if err != nil {
+		handleBalanceError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "balance history fetched successfully", entries)
+}
+
+// AdjustBalance credits or debits one wallet of a user (admin only)
+func (h *BalanceHandler) AdjustBalance(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleBalanceError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBalanceError(c, apperrors.ErrInvalidID, nil)
+		return
+	}
+
+	var req struct {
+		BalanceType string  `json:"balance_type" binding:"required"`
+		LeaveType   string  `json:"leave_type"`
+		Amount      float64 `json:"amount"`
+		Reason      string  `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBalanceError(c, apperrors.ErrInvalidRequestPayload, nil)
+		return
+	}
+
+	adminID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+	adjustment, err := h.balanceService.AdjustBalance(ctx, role, adminID, models.BalanceAdjustment{
+		UserID:      userID,
+		BalanceType: strings.ToUpper(req.BalanceType),
+		LeaveType:   strings.ToUpper(req.LeaveType),
+		Amount:      req.Amount,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		handleBalanceError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "balance adjusted successfully", adjustment)
+}
+
+// BulkAdjustBalances applies the adjustments of a CSV file, uploaded as the "file" form
+// field or sent as the request body (admin only)
+func (h *BalanceHandler) BulkAdjustBalances(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleBalanceError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var file io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			handleBalanceError(c, apperrors.ErrInvalidRequestPayload, nil)
+			return
+		}
+		upload, err := header.Open()
+		if err != nil {
+			handleBalanceError(c, apperrors.ErrInvalidRequestPayload, nil)
+			return
+		}
+		defer upload.Close()
+		file = upload
+	}
+
+	preview := c.Query("preview") == "true"
+
+	adminID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+	report, err := h.balanceService.BulkAdjustBalances(ctx, role, adminID, file, preview)
+	if err != nil {
+		var detail interface{}
+		if report != nil {
+			detail = report.Errors
+		}
+		handleBalanceError(c, err, detail)
+		return
+	}
+
+	if preview {
+		response.Success(c, "balance adjustment preview generated", report)
+		return
+	}
+	response.Success(c, "balances adjusted successfully", report)
+}
+
+func handleBalanceError(c *gin.Context, err error, detail interface{}) {
 	status := http.StatusInternalServerError
 
 	switch err {
-	case apperrors.ErrUserNotFound:
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrUserNotFound, apperrors.ErrLeaveBalanceMissing,
+		apperrors.ErrExpenseBalanceMissing, apperrors.ErrDiscountBalanceMissing:
 		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestType, apperrors.ErrInvalidID,
+		apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidUser,
+		apperrors.ErrAdjustmentReasonRequired, apperrors.ErrInvalidAdjustmentAmount,
+		apperrors.ErrAdjustmentExceedsBalance, apperrors.ErrInvalidAdjustmentFile,
+		apperrors.ErrInvalidAdjustmentHeader, apperrors.ErrLeaveTypeRequired:
+		status = http.StatusBadRequest
 	}
 
-	response.Error(c, status, err.Error(), nil)
+	response.Error(c, status, err.Error(), detail)
 }
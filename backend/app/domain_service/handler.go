@@ -212,7 +212,8 @@ func handleApproveRejectDiscountError(c *gin.Context, err error) {
 
 	switch err {
 	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
-		apperrors.ErrSelfApprovalNotAllowed:
+		apperrors.ErrSelfApprovalNotAllowed, apperrors.ErrNotRoutedApprover,
+		apperrors.ErrStepApproverRepeated:
 		status = http.StatusForbidden
 	case apperrors.ErrDiscountRequestNotFound:
 		status = http.StatusNotFound
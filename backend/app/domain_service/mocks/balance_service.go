@@ -5,6 +5,9 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +24,191 @@ func (_m *BalanceService) EXPECT() *BalanceService_Expecter {
 	return &BalanceService_Expecter{mock: &_m.Mock}
 }
 
+// AdjustBalance provides a mock function with given fields: ctx, role, adminID, adjustment
+func (_m *BalanceService) AdjustBalance(ctx context.Context, role string, adminID int64, adjustment models.BalanceAdjustment) (*models.BalanceAdjustment, error) {
+	ret := _m.Called(ctx, role, adminID, adjustment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustBalance")
+	}
+
+	var r0 *models.BalanceAdjustment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.BalanceAdjustment) (*models.BalanceAdjustment, error)); ok {
+		return rf(ctx, role, adminID, adjustment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.BalanceAdjustment) *models.BalanceAdjustment); ok {
+		r0 = rf(ctx, role, adminID, adjustment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BalanceAdjustment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.BalanceAdjustment) error); ok {
+		r1 = rf(ctx, role, adminID, adjustment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceService_AdjustBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustBalance'
+type BalanceService_AdjustBalance_Call struct {
+	*mock.Call
+}
+
+// AdjustBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - adjustment models.BalanceAdjustment
+func (_e *BalanceService_Expecter) AdjustBalance(ctx interface{}, role interface{}, adminID interface{}, adjustment interface{}) *BalanceService_AdjustBalance_Call {
+	return &BalanceService_AdjustBalance_Call{Call: _e.mock.On("AdjustBalance", ctx, role, adminID, adjustment)}
+}
+
+func (_c *BalanceService_AdjustBalance_Call) Run(run func(ctx context.Context, role string, adminID int64, adjustment models.BalanceAdjustment)) *BalanceService_AdjustBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.BalanceAdjustment))
+	})
+	return _c
+}
+
+func (_c *BalanceService_AdjustBalance_Call) Return(_a0 *models.BalanceAdjustment, _a1 error) *BalanceService_AdjustBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceService_AdjustBalance_Call) RunAndReturn(run func(context.Context, string, int64, models.BalanceAdjustment) (*models.BalanceAdjustment, error)) *BalanceService_AdjustBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkAdjustBalances provides a mock function with given fields: ctx, role, adminID, file, preview
+func (_m *BalanceService) BulkAdjustBalances(ctx context.Context, role string, adminID int64, file io.Reader, preview bool) (*models.BulkBalanceAdjustment, error) {
+	ret := _m.Called(ctx, role, adminID, file, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkAdjustBalances")
+	}
+
+	var r0 *models.BulkBalanceAdjustment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, io.Reader, bool) (*models.BulkBalanceAdjustment, error)); ok {
+		return rf(ctx, role, adminID, file, preview)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, io.Reader, bool) *models.BulkBalanceAdjustment); ok {
+		r0 = rf(ctx, role, adminID, file, preview)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkBalanceAdjustment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, io.Reader, bool) error); ok {
+		r1 = rf(ctx, role, adminID, file, preview)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceService_BulkAdjustBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkAdjustBalances'
+type BalanceService_BulkAdjustBalances_Call struct {
+	*mock.Call
+}
+
+// BulkAdjustBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - file io.Reader
+//   - preview bool
+func (_e *BalanceService_Expecter) BulkAdjustBalances(ctx interface{}, role interface{}, adminID interface{}, file interface{}, preview interface{}) *BalanceService_BulkAdjustBalances_Call {
+	return &BalanceService_BulkAdjustBalances_Call{Call: _e.mock.On("BulkAdjustBalances", ctx, role, adminID, file, preview)}
+}
+
+func (_c *BalanceService_BulkAdjustBalances_Call) Run(run func(ctx context.Context, role string, adminID int64, file io.Reader, preview bool)) *BalanceService_BulkAdjustBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(io.Reader), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *BalanceService_BulkAdjustBalances_Call) Return(_a0 *models.BulkBalanceAdjustment, _a1 error) *BalanceService_BulkAdjustBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceService_BulkAdjustBalances_Call) RunAndReturn(run func(context.Context, string, int64, io.Reader, bool) (*models.BulkBalanceAdjustment, error)) *BalanceService_BulkAdjustBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalanceHistory provides a mock function with given fields: ctx, userID, balanceType, limit, offset
+func (_m *BalanceService) GetBalanceHistory(ctx context.Context, userID int64, balanceType string, limit int, offset int) ([]models.BalanceLedgerEntry, error) {
+	ret := _m.Called(ctx, userID, balanceType, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceHistory")
+	}
+
+	var r0 []models.BalanceLedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int, int) ([]models.BalanceLedgerEntry, error)); ok {
+		return rf(ctx, userID, balanceType, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int, int) []models.BalanceLedgerEntry); ok {
+		r0 = rf(ctx, userID, balanceType, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalanceLedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int, int) error); ok {
+		r1 = rf(ctx, userID, balanceType, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceService_GetBalanceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceHistory'
+type BalanceService_GetBalanceHistory_Call struct {
+	*mock.Call
+}
+
+// GetBalanceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - balanceType string
+//   - limit int
+//   - offset int
+func (_e *BalanceService_Expecter) GetBalanceHistory(ctx interface{}, userID interface{}, balanceType interface{}, limit interface{}, offset interface{}) *BalanceService_GetBalanceHistory_Call {
+	return &BalanceService_GetBalanceHistory_Call{Call: _e.mock.On("GetBalanceHistory", ctx, userID, balanceType, limit, offset)}
+}
+
+func (_c *BalanceService_GetBalanceHistory_Call) Run(run func(ctx context.Context, userID int64, balanceType string, limit int, offset int)) *BalanceService_GetBalanceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *BalanceService_GetBalanceHistory_Call) Return(_a0 []models.BalanceLedgerEntry, _a1 error) *BalanceService_GetBalanceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceService_GetBalanceHistory_Call) RunAndReturn(run func(context.Context, int64, string, int, int) ([]models.BalanceLedgerEntry, error)) *BalanceService_GetBalanceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBalances provides a mock function with given fields: ctx, userID
 func (_m *BalanceService) GetBalances(ctx context.Context, userID int64) (map[string]interface{}, error) {
 	ret := _m.Called(ctx, userID)
@@ -0,0 +1,382 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DelegationService is an autogenerated mock type for the DelegationService type
+type DelegationService struct {
+	mock.Mock
+}
+
+type DelegationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DelegationService) EXPECT() *DelegationService_Expecter {
+	return &DelegationService_Expecter{mock: &_m.Mock}
+}
+
+// CreateDelegation provides a mock function with given fields: ctx, role, userID, delegation
+func (_m *DelegationService) CreateDelegation(ctx context.Context, role string, userID int64, delegation models.ApprovalDelegation) (*models.ApprovalDelegation, error) {
+	ret := _m.Called(ctx, role, userID, delegation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelegation")
+	}
+
+	var r0 *models.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.ApprovalDelegation) (*models.ApprovalDelegation, error)); ok {
+		return rf(ctx, role, userID, delegation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.ApprovalDelegation) *models.ApprovalDelegation); ok {
+		r0 = rf(ctx, role, userID, delegation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.ApprovalDelegation) error); ok {
+		r1 = rf(ctx, role, userID, delegation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_CreateDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelegation'
+type DelegationService_CreateDelegation_Call struct {
+	*mock.Call
+}
+
+// CreateDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - delegation models.ApprovalDelegation
+func (_e *DelegationService_Expecter) CreateDelegation(ctx interface{}, role interface{}, userID interface{}, delegation interface{}) *DelegationService_CreateDelegation_Call {
+	return &DelegationService_CreateDelegation_Call{Call: _e.mock.On("CreateDelegation", ctx, role, userID, delegation)}
+}
+
+func (_c *DelegationService_CreateDelegation_Call) Run(run func(ctx context.Context, role string, userID int64, delegation models.ApprovalDelegation)) *DelegationService_CreateDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.ApprovalDelegation))
+	})
+	return _c
+}
+
+func (_c *DelegationService_CreateDelegation_Call) Return(_a0 *models.ApprovalDelegation, _a1 error) *DelegationService_CreateDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_CreateDelegation_Call) RunAndReturn(run func(context.Context, string, int64, models.ApprovalDelegation) (*models.ApprovalDelegation, error)) *DelegationService_CreateDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApproverIDs provides a mock function with given fields: ctx, approverID
+func (_m *DelegationService) GetApproverIDs(ctx context.Context, approverID int64) ([]int64, error) {
+	ret := _m.Called(ctx, approverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApproverIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, approverID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, approverID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, approverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_GetApproverIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApproverIDs'
+type DelegationService_GetApproverIDs_Call struct {
+	*mock.Call
+}
+
+// GetApproverIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - approverID int64
+func (_e *DelegationService_Expecter) GetApproverIDs(ctx interface{}, approverID interface{}) *DelegationService_GetApproverIDs_Call {
+	return &DelegationService_GetApproverIDs_Call{Call: _e.mock.On("GetApproverIDs", ctx, approverID)}
+}
+
+func (_c *DelegationService_GetApproverIDs_Call) Run(run func(ctx context.Context, approverID int64)) *DelegationService_GetApproverIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_GetApproverIDs_Call) Return(_a0 []int64, _a1 error) *DelegationService_GetApproverIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_GetApproverIDs_Call) RunAndReturn(run func(context.Context, int64) ([]int64, error)) *DelegationService_GetApproverIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelegations provides a mock function with given fields: ctx, role, userID
+func (_m *DelegationService) GetDelegations(ctx context.Context, role string, userID int64) ([]models.ApprovalDelegation, error) {
+	ret := _m.Called(ctx, role, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegations")
+	}
+
+	var r0 []models.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.ApprovalDelegation, error)); ok {
+		return rf(ctx, role, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.ApprovalDelegation); ok {
+		r0 = rf(ctx, role, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, role, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_GetDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelegations'
+type DelegationService_GetDelegations_Call struct {
+	*mock.Call
+}
+
+// GetDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+func (_e *DelegationService_Expecter) GetDelegations(ctx interface{}, role interface{}, userID interface{}) *DelegationService_GetDelegations_Call {
+	return &DelegationService_GetDelegations_Call{Call: _e.mock.On("GetDelegations", ctx, role, userID)}
+}
+
+func (_c *DelegationService_GetDelegations_Call) Run(run func(ctx context.Context, role string, userID int64)) *DelegationService_GetDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_GetDelegations_Call) Return(_a0 []models.ApprovalDelegation, _a1 error) *DelegationService_GetDelegations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_GetDelegations_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.ApprovalDelegation, error)) *DelegationService_GetDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDecision provides a mock function with given fields: ctx, tx, requestType, requestID, actor
+func (_m *DelegationService) RecordDecision(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, actor *models.ApprovalActor) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, *models.ApprovalActor) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationService_RecordDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDecision'
+type DelegationService_RecordDecision_Call struct {
+	*mock.Call
+}
+
+// RecordDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - actor *models.ApprovalActor
+func (_e *DelegationService_Expecter) RecordDecision(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, actor interface{}) *DelegationService_RecordDecision_Call {
+	return &DelegationService_RecordDecision_Call{Call: _e.mock.On("RecordDecision", ctx, tx, requestType, requestID, actor)}
+}
+
+func (_c *DelegationService_RecordDecision_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, actor *models.ApprovalActor)) *DelegationService_RecordDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(*models.ApprovalActor))
+	})
+	return _c
+}
+
+func (_c *DelegationService_RecordDecision_Call) Return(_a0 error) *DelegationService_RecordDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationService_RecordDecision_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, *models.ApprovalActor) error) *DelegationService_RecordDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveActor provides a mock function with given fields: ctx, tx, role, approverID, requesterID, requesterRole, routedToRole, routedToUserID
+func (_m *DelegationService) ResolveActor(ctx context.Context, tx interfaces.Tx, role string, approverID int64, requesterID int64, requesterRole string, routedToRole string, routedToUserID *int64) (*models.ApprovalActor, error) {
+	ret := _m.Called(ctx, tx, role, approverID, requesterID, requesterRole, routedToRole, routedToUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveActor")
+	}
+
+	var r0 *models.ApprovalActor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, string, string, *int64) (*models.ApprovalActor, error)); ok {
+		return rf(ctx, tx, role, approverID, requesterID, requesterRole, routedToRole, routedToUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, string, string, *int64) *models.ApprovalActor); ok {
+		r0 = rf(ctx, tx, role, approverID, requesterID, requesterRole, routedToRole, routedToUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalActor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64, int64, string, string, *int64) error); ok {
+		r1 = rf(ctx, tx, role, approverID, requesterID, requesterRole, routedToRole, routedToUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_ResolveActor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveActor'
+type DelegationService_ResolveActor_Call struct {
+	*mock.Call
+}
+
+// ResolveActor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - role string
+//   - approverID int64
+//   - requesterID int64
+//   - requesterRole string
+//   - routedToRole string
+//   - routedToUserID *int64
+func (_e *DelegationService_Expecter) ResolveActor(ctx interface{}, tx interface{}, role interface{}, approverID interface{}, requesterID interface{}, requesterRole interface{}, routedToRole interface{}, routedToUserID interface{}) *DelegationService_ResolveActor_Call {
+	return &DelegationService_ResolveActor_Call{Call: _e.mock.On("ResolveActor", ctx, tx, role, approverID, requesterID, requesterRole, routedToRole, routedToUserID)}
+}
+
+func (_c *DelegationService_ResolveActor_Call) Run(run func(ctx context.Context, tx interfaces.Tx, role string, approverID int64, requesterID int64, requesterRole string, routedToRole string, routedToUserID *int64)) *DelegationService_ResolveActor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(string), args[6].(string), args[7].(*int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_ResolveActor_Call) Return(_a0 *models.ApprovalActor, _a1 error) *DelegationService_ResolveActor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_ResolveActor_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, string, string, *int64) (*models.ApprovalActor, error)) *DelegationService_ResolveActor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeDelegation provides a mock function with given fields: ctx, role, userID, delegationID
+func (_m *DelegationService) RevokeDelegation(ctx context.Context, role string, userID int64, delegationID int64) error {
+	ret := _m.Called(ctx, role, userID, delegationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeDelegation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) error); ok {
+		r0 = rf(ctx, role, userID, delegationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationService_RevokeDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeDelegation'
+type DelegationService_RevokeDelegation_Call struct {
+	*mock.Call
+}
+
+// RevokeDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - delegationID int64
+func (_e *DelegationService_Expecter) RevokeDelegation(ctx interface{}, role interface{}, userID interface{}, delegationID interface{}) *DelegationService_RevokeDelegation_Call {
+	return &DelegationService_RevokeDelegation_Call{Call: _e.mock.On("RevokeDelegation", ctx, role, userID, delegationID)}
+}
+
+func (_c *DelegationService_RevokeDelegation_Call) Run(run func(ctx context.Context, role string, userID int64, delegationID int64)) *DelegationService_RevokeDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_RevokeDelegation_Call) Return(_a0 error) *DelegationService_RevokeDelegation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationService_RevokeDelegation_Call) RunAndReturn(run func(context.Context, string, int64, int64) error) *DelegationService_RevokeDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDelegationService creates a new instance of DelegationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDelegationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DelegationService {
+	mock := &DelegationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,6 +2,10 @@ package domain_service
 
 import (
 	"context"
+	"io"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -14,6 +18,7 @@ type DiscountService struct {
 	discountReqRepo interfaces.DiscountRequestRepository
 	balanceRepo     interfaces.BalanceRepository
 	ruleService     interfaces.RuleService
+	chainService    interfaces.ApprovalChainService
 	userRepo        interfaces.UserRepository
 	db              interfaces.DB
 }
@@ -23,6 +28,7 @@ func NewDiscountService(
 	discountReqRepo interfaces.DiscountRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
 	ruleService interfaces.RuleService,
+	chainService interfaces.ApprovalChainService,
 	userRepo interfaces.UserRepository,
 	db interfaces.DB,
 ) interfaces.DiscountService {
@@ -30,6 +36,7 @@ func NewDiscountService(
 		discountReqRepo: discountReqRepo,
 		balanceRepo:     balanceRepo,
 		ruleService:     ruleService,
+		chainService:    chainService,
 		userRepo:        userRepo,
 		db:              db,
 	}
@@ -57,30 +64,33 @@ func (s *DiscountService) ApplyDiscount(
 	}
 	defer tx.Rollback(ctx)
 
-	// fetch remaining
-	remaining, err := s.balanceRepo.GetDiscountBalance(ctx, tx, userID)
+	// fetch available balance
+	available, err := s.balanceRepo.GetDiscountBalance(ctx, tx, userID)
 	if err != nil {
 		return "", "", err
 	}
 
-	if percent > remaining {
+	if percent > available {
 		return "", "", apperrors.ErrDiscountLimitExceeded
 	}
 
-	// user grade
-	gradeID, err := s.userRepo.GetGrade(ctx, tx, userID)
+	// requester details (grade, role, tenure)
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return "", "", err
 	}
 
-	// fetch rule
-	rule, err := s.ruleService.GetRule(ctx, "DISCOUNT", gradeID)
+	// fetch prioritized rules
+	ruleSet, err := s.ruleService.GetRuleSet(ctx, "DISCOUNT", user.GradeID)
 	if err != nil {
 		return "", "", apperrors.ErrRuleNotFound
 	}
 
 	// apply rule
-	result := utils.MakeDecision("DISCOUNT", rule.Condition, percent)
+	facts := utils.NewRequestFacts("DISCOUNT", user, time.Now())
+	facts.Percent = percent
+
+	result := utils.MakeRuleSetDecision(*ruleSet, facts)
 	status := result.Status
 	message := result.Message
 
@@ -90,7 +100,16 @@ func (s *DiscountService) ApplyDiscount(
 		DiscountPercentage: percent,
 		Reason:             reason,
 		Status:             status,
-		RuleID:             &rule.ID,
+		RuleID:             result.RuleID,
+		DecisionAction:     result.Action,
+		RoutedToRole:       result.RouteToRole,
+		RoutedToUserID:     result.RouteToUserID,
+		DecisionTrace:      result.Trace,
+	}
+
+	// keep the policy message on auto-rejected requests
+	if status == constants.StatusAutoRejected {
+		discountReq.ApprovalComment = message
 	}
 
 	err = s.discountReqRepo.Create(ctx, tx, discountReq)
@@ -98,14 +117,28 @@ func (s *DiscountService) ApplyDiscount(
 		return "", "", apperrors.ErrInsertFailed
 	}
 
-	// deduct if auto-approved
-	if status == constants.StatusAutoApproved {
-		err = s.balanceRepo.DeductDiscountBalance(ctx, tx, userID, percent)
+	// requests left for manual review go through the approval chain of their band
+	if status == constants.StatusPending && result.Action == constants.ActionManual {
+		err = s.chainService.StartChain(ctx, tx, "DISCOUNT", discountReq.ID, user, percent)
 		if err != nil {
 			return "", "", err
 		}
 	}
 
+	// deduct if auto-approved, hold the percent while the request is pending
+	switch status {
+	case constants.StatusAutoApproved:
+		err = s.balanceRepo.DeductDiscountBalance(ctx, tx, userID, percent, models.BalanceChange{
+			RequestID: &discountReq.ID,
+			Reason:    "Discount auto-approved",
+		})
+	case constants.StatusPending:
+		err = s.balanceRepo.ReserveBalance(ctx, tx, userID, "DISCOUNT", "", percent)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return "", "", apperrors.ErrTransactionCommit
 	}
@@ -139,41 +172,64 @@ func (s *DiscountService) CancelDiscount(ctx context.Context, userID, requestID
 		return apperrors.ErrUpdateFailed
 	}
 
-	if discountReq.Status == constants.StatusAutoApproved {
-		err = s.balanceRepo.RestoreDiscountBalance(ctx, tx, userID, discountReq.DiscountPercentage)
-		if err != nil {
-			return err
-		}
+	err = s.chainService.CancelSteps(ctx, tx, "DISCOUNT", requestID)
+	if err != nil {
+		return err
+	}
+
+	switch discountReq.Status {
+	case constants.StatusAutoApproved:
+		err = s.balanceRepo.RestoreDiscountBalance(ctx, tx, userID, discountReq.DiscountPercentage, models.BalanceChange{
+			RequestID: &requestID,
+			ActorID:   &userID,
+			Reason:    "Discount cancelled",
+		})
+	case constants.StatusPending:
+		err = s.balanceRepo.ReleaseReservation(ctx, tx, userID, "DISCOUNT", "", discountReq.DiscountPercentage)
+	}
+	if err != nil {
+		return err
 	}
 
 	return tx.Commit(ctx)
 }
 
 type DiscountApprovalService struct {
-	discountReqRepo interfaces.DiscountRequestRepository
-	balanceRepo     interfaces.BalanceRepository
-	userRepo        interfaces.UserRepository
-	db              interfaces.DB
+	discountReqRepo   interfaces.DiscountRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	userRepo          interfaces.UserRepository
+	db                interfaces.DB
 }
 
 func NewDiscountApprovalService(
 	ctx context.Context,
 	discountReqRepo interfaces.DiscountRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
 	userRepo interfaces.UserRepository,
 	db interfaces.DB,
 ) interfaces.DiscountApprovalService {
 	return &DiscountApprovalService{
-		discountReqRepo: discountReqRepo,
-		balanceRepo:     balanceRepo,
-		userRepo:        userRepo,
-		db:              db,
+		discountReqRepo:   discountReqRepo,
+		balanceRepo:       balanceRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		userRepo:          userRepo,
+		db:                db,
 	}
 }
 
 func (s *DiscountApprovalService) GetPendingRequests(ctx context.Context, role string, approverID int64, limit, offset int) ([]map[string]interface{}, int, error) {
 	if role == constants.RoleManager {
-		return s.discountReqRepo.GetPendingForManager(ctx, approverID, limit, offset)
+		// delegates also see the queues of the managers they stand in for
+		approverIDs, err := s.delegationService.GetApproverIDs(ctx, approverID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return s.discountReqRepo.GetPendingForManager(ctx, approverIDs, limit, offset)
 	} else if role == constants.RoleAdmin {
 		return s.discountReqRepo.GetPendingForAdmin(ctx, limit, offset)
 	} else {
@@ -214,7 +270,37 @@ func (s *DiscountApprovalService) ApproveDiscount(ctx context.Context, role stri
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
+	// approvers standing in for a delegator decide as the delegator
+	actor, err := s.delegationService.ResolveActor(ctx, tx, role, approverID, discountReq.EmployeeID, requesterRole, discountReq.RoutedToRole, discountReq.RoutedToUserID)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.ValidateRequestApprover(actor.Role, actor.UserID, requesterRole, discountReq.RoutedToRole, discountReq.RoutedToUserID); err != nil {
+		return err
+	}
+
+	// the request stays pending until the last step of its approval chain approves
+	final, err := s.chainService.ApproveStep(ctx, tx, "DISCOUNT", requestID, approverID, actor.Delegation, comment)
+	if err != nil {
+		return err
+	}
+	if !final {
+		return tx.Commit(ctx)
+	}
+
+	// the percent held while the request was pending is consumed
+	err = s.balanceRepo.ReleaseReservation(ctx, tx, discountReq.EmployeeID, "DISCOUNT", "", discountReq.DiscountPercentage)
+	if err != nil {
+		return err
+	}
+
+	err = s.balanceRepo.DeductDiscountBalance(ctx, tx, discountReq.EmployeeID, discountReq.DiscountPercentage, models.BalanceChange{
+		RequestID: &requestID,
+		ActorID:   &approverID,
+		Reason:    "Discount approved",
+	})
+	if err != nil {
 		return err
 	}
 
@@ -224,6 +310,11 @@ func (s *DiscountApprovalService) ApproveDiscount(ctx context.Context, role stri
 		return err
 	}
 
+	err = s.delegationService.RecordDecision(ctx, tx, "DISCOUNT", requestID, actor)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -260,7 +351,18 @@ func (s *DiscountApprovalService) RejectDiscount(ctx context.Context, role strin
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
+	// approvers standing in for a delegator decide as the delegator
+	actor, err := s.delegationService.ResolveActor(ctx, tx, role, approverID, discountReq.EmployeeID, requesterRole, discountReq.RoutedToRole, discountReq.RoutedToUserID)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.ValidateRequestApprover(actor.Role, actor.UserID, requesterRole, discountReq.RoutedToRole, discountReq.RoutedToUserID); err != nil {
+		return err
+	}
+
+	err = s.chainService.RejectStep(ctx, tx, "DISCOUNT", requestID, approverID, actor.Delegation, comment)
+	if err != nil {
 		return err
 	}
 
@@ -270,21 +372,36 @@ func (s *DiscountApprovalService) RejectDiscount(ctx context.Context, role strin
 		return err
 	}
 
+	err = s.balanceRepo.ReleaseReservation(ctx, tx, discountReq.EmployeeID, "DISCOUNT", "", discountReq.DiscountPercentage)
+	if err != nil {
+		return err
+	}
+
+	err = s.delegationService.RecordDecision(ctx, tx, "DISCOUNT", requestID, actor)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
 type BalanceService struct {
 	balanceRepo interfaces.BalanceRepository
+	userRepo    interfaces.UserRepository
 	db          interfaces.DB
 }
 
-func NewBalanceService(ctx context.Context, balanceRepo interfaces.BalanceRepository, db interfaces.DB) interfaces.BalanceService {
+func NewBalanceService(ctx context.Context, balanceRepo interfaces.BalanceRepository, userRepo interfaces.UserRepository, db interfaces.DB) interfaces.BalanceService {
 	return &BalanceService{
 		balanceRepo: balanceRepo,
+		userRepo:    userRepo,
 		db:          db,
 	}
 }
 
+// GetBalances returns the leave, expense and discount balances of a user, split into
+// consumed, reserved by pending requests and available. Leave balances are listed per
+// leave type as well.
 func (s *BalanceService) GetBalances(ctx context.Context, userID int64) (map[string]interface{}, error) {
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
@@ -292,23 +409,27 @@ func (s *BalanceService) GetBalances(ctx context.Context, userID int64) (map[str
 	}
 	defer tx.Rollback(ctx)
 
-	var leaveTotal, leaveRemaining int
-	var expenseTotal, expenseRemaining float64
-	var discountTotal, discountRemaining float64
-
-	leaveRemaining, err = s.balanceRepo.GetLeaveBalance(ctx, tx, userID)
+	leaveTypes, err := s.balanceRepo.GetLeaveBalances(ctx, tx, userID)
 	if err != nil {
 		return nil, err
 	}
-	// Note: interface has GetLeaveFullBalance too
-	leaveTotal, leaveRemaining, err = s.balanceRepo.GetLeaveFullBalance(ctx, tx, userID)
 
-	expenseTotal, expenseRemaining, err = s.balanceRepo.GetExpenseFullBalance(ctx, tx, userID)
+	// leave adds up the wallets of every leave type
+	var leave models.Balance
+	for _, wallet := range leaveTypes {
+		leave.Total += wallet.Total
+		leave.Consumed += wallet.Consumed
+		leave.Reserved += wallet.Reserved
+		leave.Available += wallet.Available
+		leave.Remaining += wallet.Remaining
+	}
+
+	expense, err := s.balanceRepo.GetExpenseFullBalance(ctx, tx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	discountTotal, discountRemaining, err = s.balanceRepo.GetDiscountFullBalance(ctx, tx, userID)
+	discount, err := s.balanceRepo.GetDiscountFullBalance(ctx, tx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -318,17 +439,219 @@ func (s *BalanceService) GetBalances(ctx context.Context, userID int64) (map[str
 	}
 
 	return map[string]interface{}{
-		"leave": map[string]interface{}{
-			"total":     leaveTotal,
-			"remaining": leaveRemaining,
-		},
-		"expense": map[string]interface{}{
-			"total":     expenseTotal,
-			"remaining": expenseRemaining,
-		},
-		"discount": map[string]interface{}{
-			"total":     discountTotal,
-			"remaining": discountRemaining,
-		},
+		"leave":       leave,
+		"leave_types": leaveTypes,
+		"expense":     expense,
+		"discount":    discount,
 	}, nil
 }
+
+// GetBalanceHistory lists the ledger entries behind the user's balances, newest first,
+// of one balance type when balanceType is set
+func (s *BalanceService) GetBalanceHistory(ctx context.Context, userID int64, balanceType string, limit, offset int) ([]models.BalanceLedgerEntry, error) {
+	switch balanceType {
+	case "", "LEAVE", "EXPENSE", "DISCOUNT":
+	default:
+		return nil, apperrors.ErrInvalidRequestType
+	}
+
+	if limit <= 0 {
+		limit = constants.DefaultLedgerLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.balanceRepo.GetLedger(ctx, userID, balanceType, limit, offset)
+}
+
+// AdjustBalance credits or debits a wallet of a user, recording the admin and the
+// reason in the balance ledger (admin only). The adjustment moves the total with the
+// remaining balance; a debit cannot take more than is available.
+func (s *BalanceService) AdjustBalance(ctx context.Context, role string, adminID int64, adjustment models.BalanceAdjustment) (*models.BalanceAdjustment, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if err := validateAdjustment(adjustment); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.applyAdjustment(ctx, tx, adminID, &adjustment); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return &adjustment, nil
+}
+
+// BulkAdjustBalances applies the adjustments of a CSV file with a header naming the
+// user_id, balance_type, amount and reason columns, in one transaction (admin only).
+// Every refused row is reported and none of the rows apply then; a preview checks the
+// rows against the balances and rolls back.
+func (s *BalanceService) BulkAdjustBalances(ctx context.Context, role string, adminID int64, file io.Reader, preview bool) (*models.BulkBalanceAdjustment, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	report := &models.BulkBalanceAdjustment{
+		Preview:     preview,
+		Adjustments: []models.BalanceAdjustment{},
+		Errors:      []models.BalanceAdjustmentError{},
+	}
+
+	rows, err := utils.ParseBalanceAdjustments(file)
+	if err != nil {
+		report.Errors = append(report.Errors, models.BalanceAdjustmentError{Row: 1, Error: err.Error()})
+		return report, apperrors.ErrInvalidAdjustmentFile
+	}
+
+	for _, row := range rows {
+		if row.Err == nil {
+			row.Err = validateAdjustment(row.Adjustment)
+		}
+		if row.Err != nil {
+			report.Errors = append(report.Errors, models.BalanceAdjustmentError{Row: row.Row, Error: row.Err.Error()})
+		}
+	}
+	if len(report.Errors) > 0 {
+		return report, apperrors.ErrInvalidAdjustmentFile
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	// rows of the same wallet apply one after the other, so each sees the ones before it
+	for _, row := range rows {
+		adjustment := row.Adjustment
+		err := s.applyAdjustment(ctx, tx, adminID, &adjustment)
+		switch err {
+		case nil:
+			report.Adjustments = append(report.Adjustments, adjustment)
+		case apperrors.ErrUserNotFound, apperrors.ErrAdjustmentExceedsBalance, apperrors.ErrLeaveBalanceMissing,
+			apperrors.ErrExpenseBalanceMissing, apperrors.ErrDiscountBalanceMissing:
+			report.Errors = append(report.Errors, models.BalanceAdjustmentError{Row: row.Row, Error: err.Error()})
+		default:
+			return nil, err
+		}
+	}
+	if len(report.Errors) > 0 {
+		report.Adjustments = []models.BalanceAdjustment{}
+		return report, apperrors.ErrInvalidAdjustmentFile
+	}
+
+	if preview {
+		return report, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+	report.Applied = true
+
+	return report, nil
+}
+
+// applyAdjustment moves the remaining balance and the total of a wallet by the amount
+// of the adjustment and fills in the wallet it leaves
+func (s *BalanceService) applyAdjustment(ctx context.Context, tx interfaces.Tx, adminID int64, adjustment *models.BalanceAdjustment) error {
+	// only leave balances come in several wallets
+	if adjustment.BalanceType != "LEAVE" {
+		adjustment.LeaveType = ""
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, adjustment.UserID); err != nil {
+		return err
+	}
+
+	// locks the wallet until the adjustment commits
+	available, err := s.getAvailableBalance(ctx, tx, adjustment.UserID, adjustment.BalanceType, adjustment.LeaveType)
+	if err != nil {
+		return err
+	}
+	if -adjustment.Amount > available {
+		return apperrors.ErrAdjustmentExceedsBalance
+	}
+
+	balance, err := s.getFullBalance(ctx, tx, adjustment.UserID, adjustment.BalanceType, adjustment.LeaveType)
+	if err != nil {
+		return err
+	}
+
+	err = s.balanceRepo.AdjustBalance(ctx, tx, adjustment.UserID, adjustment.BalanceType, adjustment.LeaveType, constants.LedgerAdjustment, adjustment.Amount, models.BalanceChange{
+		ActorID: &adminID,
+		Reason:  adjustment.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	balance.Total += adjustment.Amount
+	balance.Remaining += adjustment.Amount
+	balance.Available += adjustment.Amount
+	if err := s.balanceRepo.SetBalanceTotal(ctx, tx, adjustment.UserID, adjustment.BalanceType, adjustment.LeaveType, balance.Total); err != nil {
+		return err
+	}
+
+	adjustment.Balance = &balance
+	return nil
+}
+
+func (s *BalanceService) getAvailableBalance(ctx context.Context, tx interfaces.Tx, userID int64, balanceType, leaveType string) (float64, error) {
+	switch balanceType {
+	case "LEAVE":
+		return s.balanceRepo.GetLeaveBalance(ctx, tx, userID, leaveType)
+	case "EXPENSE":
+		return s.balanceRepo.GetExpenseBalance(ctx, tx, userID)
+	case "DISCOUNT":
+		return s.balanceRepo.GetDiscountBalance(ctx, tx, userID)
+	default:
+		return 0, apperrors.ErrInvalidRequestType
+	}
+}
+
+func (s *BalanceService) getFullBalance(ctx context.Context, tx interfaces.Tx, userID int64, balanceType, leaveType string) (models.Balance, error) {
+	switch balanceType {
+	case "LEAVE":
+		return s.balanceRepo.GetLeaveFullBalance(ctx, tx, userID, leaveType)
+	case "EXPENSE":
+		return s.balanceRepo.GetExpenseFullBalance(ctx, tx, userID)
+	case "DISCOUNT":
+		return s.balanceRepo.GetDiscountFullBalance(ctx, tx, userID)
+	default:
+		return models.Balance{}, apperrors.ErrInvalidRequestType
+	}
+}
+
+// validateAdjustment checks an adjustment before it touches any balance
+func validateAdjustment(adjustment models.BalanceAdjustment) error {
+	if adjustment.UserID <= 0 {
+		return apperrors.ErrInvalidUser
+	}
+	if !utils.IsValidRequestType(adjustment.BalanceType) {
+		return apperrors.ErrInvalidRequestType
+	}
+	if adjustment.BalanceType == "LEAVE" && adjustment.LeaveType == "" {
+		return apperrors.ErrLeaveTypeRequired
+	}
+	if adjustment.Amount == 0 || math.IsNaN(adjustment.Amount) || math.IsInf(adjustment.Amount, 0) ||
+		(adjustment.BalanceType == "LEAVE" && !utils.IsWholeLeaveHours(adjustment.Amount)) {
+		return apperrors.ErrInvalidAdjustmentAmount
+	}
+	if strings.TrimSpace(adjustment.Reason) == "" {
+		return apperrors.ErrAdjustmentReasonRequired
+	}
+	return nil
+}
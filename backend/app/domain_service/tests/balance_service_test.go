@@ -0,0 +1,340 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBalanceService_GetBalanceHistory(t *testing.T) {
+	ctx := context.Background()
+
+	entries := []models.BalanceLedgerEntry{
+		{ID: 2, UserID: 1, BalanceType: "LEAVE", EntryType: constants.LedgerDeduction, Amount: -2, BalanceAfter: 10},
+		{ID: 1, UserID: 1, BalanceType: "LEAVE", EntryType: constants.LedgerAllocation, Amount: 12, BalanceAfter: 12},
+	}
+
+	tests := []struct {
+		name        string
+		balanceType string
+		limit       int
+		offset      int
+		mockSetup   func(b *mocks.BalanceRepository)
+		expectedErr error
+	}{
+		{
+			name:        "Default Limit",
+			balanceType: "LEAVE",
+			mockSetup: func(b *mocks.BalanceRepository) {
+				b.EXPECT().GetLedger(ctx, int64(1), "LEAVE", constants.DefaultLedgerLimit, 0).Return(entries, nil)
+			},
+		},
+		{
+			name:   "All Types",
+			limit:  10,
+			offset: 20,
+			mockSetup: func(b *mocks.BalanceRepository) {
+				b.EXPECT().GetLedger(ctx, int64(1), "", 10, 20).Return(entries, nil)
+			},
+		},
+		{
+			name:        "Unknown Type",
+			balanceType: "TRAVEL",
+			mockSetup:   func(b *mocks.BalanceRepository) {},
+			expectedErr: apperrors.ErrInvalidRequestType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			tt.mockSetup(mockBalanceRepo)
+
+			service := domain_service.NewBalanceService(ctx, mockBalanceRepo, mocks.NewUserRepository(t), mocks.NewDB(t))
+			history, err := service.GetBalanceHistory(ctx, 1, tt.balanceType, tt.limit, tt.offset)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, entries, history)
+		})
+	}
+}
+
+func TestBalanceService_GetBalances(t *testing.T) {
+	ctx := context.Background()
+
+	earn := models.Balance{LeaveType: "EARN", Total: 12, Consumed: 2, Reserved: 10, Available: 0, Remaining: 10}
+	sick := models.Balance{LeaveType: "SICK", Total: 6, Consumed: 1, Available: 5, Remaining: 5}
+	expense := models.Balance{Total: 5000, Consumed: 0, Reserved: 1200, Available: 3800, Remaining: 5000}
+	discount := models.Balance{Total: 20, Remaining: 20, Available: 20}
+
+	mockBalanceRepo := mocks.NewBalanceRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockBalanceRepo.EXPECT().GetLeaveBalances(ctx, mockTx, int64(1)).Return([]models.Balance{earn, sick}, nil)
+	mockBalanceRepo.EXPECT().GetExpenseFullBalance(ctx, mockTx, int64(1)).Return(expense, nil)
+	mockBalanceRepo.EXPECT().GetDiscountFullBalance(ctx, mockTx, int64(1)).Return(discount, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := domain_service.NewBalanceService(ctx, mockBalanceRepo, mocks.NewUserRepository(t), mockDB)
+	balances, err := service.GetBalances(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Balance{Total: 18, Consumed: 3, Reserved: 10, Available: 5, Remaining: 15}, balances["leave"])
+	assert.Equal(t, []models.Balance{earn, sick}, balances["leave_types"])
+	assert.Equal(t, expense, balances["expense"])
+	assert.Equal(t, discount, balances["discount"])
+}
+
+func TestBalanceService_AdjustBalance(t *testing.T) {
+	ctx := context.Background()
+	adminID := int64(1)
+
+	tests := []struct {
+		name        string
+		role        string
+		adjustment  models.BalanceAdjustment
+		mockSetup   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		expected    *models.Balance
+		expectedErr error
+	}{
+		{
+			name:       "Half Day Leave Credit",
+			role:       constants.RoleAdmin,
+			adjustment: models.BalanceAdjustment{UserID: 2, BalanceType: "LEAVE", LeaveType: "EARN", Amount: 1.5, Reason: "comp off"},
+			mockSetup: func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetByID(ctx, int64(2)).Return(&models.User{ID: 2}, nil)
+				b.EXPECT().GetLeaveBalance(ctx, tx, int64(2), "EARN").Return(float64(8), nil)
+				b.EXPECT().GetLeaveFullBalance(ctx, tx, int64(2), "EARN").Return(models.Balance{Total: 12, Consumed: 2, Reserved: 2, Available: 8, Remaining: 10}, nil)
+				b.EXPECT().AdjustBalance(ctx, tx, int64(2), "LEAVE", "EARN", constants.LedgerAdjustment, 1.5, mock.MatchedBy(func(change models.BalanceChange) bool {
+					return change.ActorID != nil && *change.ActorID == adminID && change.Reason == "comp off"
+				})).Return(nil)
+				b.EXPECT().SetBalanceTotal(ctx, tx, int64(2), "LEAVE", "EARN", 13.5).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expected: &models.Balance{Total: 13.5, Consumed: 2, Reserved: 2, Available: 9.5, Remaining: 11.5},
+		},
+		{
+			name:       "Expense Debit",
+			role:       constants.RoleAdmin,
+			adjustment: models.BalanceAdjustment{UserID: 2, BalanceType: "EXPENSE", Amount: -500, Reason: "overpaid claim"},
+			mockSetup: func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetByID(ctx, int64(2)).Return(&models.User{ID: 2}, nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(2)).Return(float64(3000), nil)
+				b.EXPECT().GetExpenseFullBalance(ctx, tx, int64(2)).Return(models.Balance{Total: 5000, Consumed: 2000, Available: 3000, Remaining: 3000}, nil)
+				b.EXPECT().AdjustBalance(ctx, tx, int64(2), "EXPENSE", "", constants.LedgerAdjustment, float64(-500), mock.Anything).Return(nil)
+				b.EXPECT().SetBalanceTotal(ctx, tx, int64(2), "EXPENSE", "", float64(4500)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expected: &models.Balance{Total: 4500, Consumed: 2000, Available: 2500, Remaining: 2500},
+		},
+		{
+			name:       "Debit Exceeds Available",
+			role:       constants.RoleAdmin,
+			adjustment: models.BalanceAdjustment{UserID: 2, BalanceType: "DISCOUNT", Amount: -10, Reason: "correction"},
+			mockSetup: func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetByID(ctx, int64(2)).Return(&models.User{ID: 2}, nil)
+				b.EXPECT().GetDiscountBalance(ctx, tx, int64(2)).Return(float64(5), nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrAdjustmentExceedsBalance,
+		},
+		{
+			name:       "Unknown User",
+			role:       constants.RoleAdmin,
+			adjustment: models.BalanceAdjustment{UserID: 9, BalanceType: "LEAVE", LeaveType: "EARN", Amount: 1, Reason: "correction"},
+			mockSetup: func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetByID(ctx, int64(9)).Return(nil, apperrors.ErrUserNotFound)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrUserNotFound,
+		},
+		{
+			name:        "Not Admin",
+			role:        constants.RoleManager,
+			adjustment:  models.BalanceAdjustment{UserID: 2, BalanceType: "LEAVE", LeaveType: "EARN", Amount: 1, Reason: "correction"},
+			mockSetup:   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrUnauthorized,
+		},
+		{
+			name:        "Missing Reason",
+			role:        constants.RoleAdmin,
+			adjustment:  models.BalanceAdjustment{UserID: 2, BalanceType: "LEAVE", LeaveType: "EARN", Amount: 1, Reason: "  "},
+			mockSetup:   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrAdjustmentReasonRequired,
+		},
+		{
+			name:        "Leave Under An Hour",
+			role:        constants.RoleAdmin,
+			adjustment:  models.BalanceAdjustment{UserID: 2, BalanceType: "LEAVE", LeaveType: "EARN", Amount: 1.1, Reason: "correction"},
+			mockSetup:   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidAdjustmentAmount,
+		},
+		{
+			name:        "Zero Amount",
+			role:        constants.RoleAdmin,
+			adjustment:  models.BalanceAdjustment{UserID: 2, BalanceType: "EXPENSE", Reason: "correction"},
+			mockSetup:   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidAdjustmentAmount,
+		},
+		{
+			name:        "Missing Leave Type",
+			role:        constants.RoleAdmin,
+			adjustment:  models.BalanceAdjustment{UserID: 2, BalanceType: "LEAVE", Amount: 1, Reason: "correction"},
+			mockSetup:   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrLeaveTypeRequired,
+		},
+		{
+			name:        "Unknown Type",
+			role:        constants.RoleAdmin,
+			adjustment:  models.BalanceAdjustment{UserID: 2, BalanceType: "TRAVEL", Amount: 1, Reason: "correction"},
+			mockSetup:   func(b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidRequestType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockBalanceRepo, mockUserRepo, mockDB, mockTx)
+
+			service := domain_service.NewBalanceService(ctx, mockBalanceRepo, mockUserRepo, mockDB)
+			adjustment, err := service.AdjustBalance(ctx, tt.role, adminID, tt.adjustment)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, adjustment.Balance)
+		})
+	}
+}
+
+func TestBalanceService_BulkAdjustBalances(t *testing.T) {
+	ctx := context.Background()
+	adminID := int64(1)
+
+	file := "user_id,balance_type,leave_type,amount,reason\n2,leave,earn,1,comp off\n3,EXPENSE,,-100,overpaid claim\n"
+
+	expectRows := func(b *mocks.BalanceRepository, u *mocks.UserRepository, tx *mocks.Tx, expenseAvailable float64) {
+		u.EXPECT().GetByID(ctx, int64(2)).Return(&models.User{ID: 2}, nil)
+		b.EXPECT().GetLeaveBalance(ctx, tx, int64(2), "EARN").Return(float64(5), nil)
+		b.EXPECT().GetLeaveFullBalance(ctx, tx, int64(2), "EARN").Return(models.Balance{Total: 12, Available: 5, Remaining: 5}, nil)
+		b.EXPECT().AdjustBalance(ctx, tx, int64(2), "LEAVE", "EARN", constants.LedgerAdjustment, float64(1), mock.Anything).Return(nil)
+		b.EXPECT().SetBalanceTotal(ctx, tx, int64(2), "LEAVE", "EARN", float64(13)).Return(nil)
+
+		u.EXPECT().GetByID(ctx, int64(3)).Return(&models.User{ID: 3}, nil)
+		b.EXPECT().GetExpenseBalance(ctx, tx, int64(3)).Return(expenseAvailable, nil)
+		if expenseAvailable < 100 {
+			return
+		}
+		b.EXPECT().GetExpenseFullBalance(ctx, tx, int64(3)).Return(models.Balance{Total: 5000, Available: expenseAvailable, Remaining: expenseAvailable}, nil)
+		b.EXPECT().AdjustBalance(ctx, tx, int64(3), "EXPENSE", "", constants.LedgerAdjustment, float64(-100), mock.Anything).Return(nil)
+		b.EXPECT().SetBalanceTotal(ctx, tx, int64(3), "EXPENSE", "", float64(4900)).Return(nil)
+	}
+
+	t.Run("Applied", func(t *testing.T) {
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		expectRows(mockBalanceRepo, mockUserRepo, mockTx, 1000)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := domain_service.NewBalanceService(ctx, mockBalanceRepo, mockUserRepo, mockDB)
+		report, err := service.BulkAdjustBalances(ctx, constants.RoleAdmin, adminID, strings.NewReader(file), false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Applied)
+		assert.Len(t, report.Adjustments, 2)
+		assert.Empty(t, report.Errors)
+		assert.Equal(t, float64(6), report.Adjustments[0].Balance.Available)
+	})
+
+	t.Run("Preview Rolls Back", func(t *testing.T) {
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		expectRows(mockBalanceRepo, mockUserRepo, mockTx, 1000)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := domain_service.NewBalanceService(ctx, mockBalanceRepo, mockUserRepo, mockDB)
+		report, err := service.BulkAdjustBalances(ctx, constants.RoleAdmin, adminID, strings.NewReader(file), true)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Preview)
+		assert.False(t, report.Applied)
+		assert.Len(t, report.Adjustments, 2)
+	})
+
+	t.Run("Refused Row Applies Nothing", func(t *testing.T) {
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		expectRows(mockBalanceRepo, mockUserRepo, mockTx, 50)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := domain_service.NewBalanceService(ctx, mockBalanceRepo, mockUserRepo, mockDB)
+		report, err := service.BulkAdjustBalances(ctx, constants.RoleAdmin, adminID, strings.NewReader(file), false)
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidAdjustmentFile)
+		assert.False(t, report.Applied)
+		assert.Empty(t, report.Adjustments)
+		assert.Equal(t, []models.BalanceAdjustmentError{{Row: 3, Error: apperrors.ErrAdjustmentExceedsBalance.Error()}}, report.Errors)
+	})
+
+	t.Run("Invalid Rows Are Reported Before Applying", func(t *testing.T) {
+		service := domain_service.NewBalanceService(ctx, mocks.NewBalanceRepository(t), mocks.NewUserRepository(t), mocks.NewDB(t))
+		invalid := "user_id,balance_type,leave_type,amount,reason\nabc,LEAVE,EARN,1,x\n2,LEAVE,EARN,0.3,x\n2,EXPENSE,,10,\n2,LEAVE,,1,x\n"
+
+		report, err := service.BulkAdjustBalances(ctx, constants.RoleAdmin, adminID, strings.NewReader(invalid), false)
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidAdjustmentFile)
+		assert.Equal(t, []models.BalanceAdjustmentError{
+			{Row: 2, Error: apperrors.ErrInvalidUser.Error()},
+			{Row: 3, Error: apperrors.ErrInvalidAdjustmentAmount.Error()},
+			{Row: 4, Error: apperrors.ErrAdjustmentReasonRequired.Error()},
+			{Row: 5, Error: apperrors.ErrLeaveTypeRequired.Error()},
+		}, report.Errors)
+	})
+
+	t.Run("Not Admin", func(t *testing.T) {
+		service := domain_service.NewBalanceService(ctx, mocks.NewBalanceRepository(t), mocks.NewUserRepository(t), mocks.NewDB(t))
+
+		_, err := service.BulkAdjustBalances(ctx, constants.RoleEmployee, 2, strings.NewReader(file), false)
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,174 @@
+package escalations
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles escalation HTTP requests
+type EscalationHandler struct {
+	escalationService interfaces.EscalationService
+}
+
+// creates a new EscalationHandler instance
+func NewEscalationHandler(ctx context.Context, escalationService interfaces.EscalationService) *EscalationHandler {
+	return &EscalationHandler{escalationService: escalationService}
+}
+
+func (h *EscalationHandler) GetPolicies(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleEscalationError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	policies, err := h.escalationService.GetPolicies(ctx, role)
+	if err != nil {
+		handleEscalationError(c, err)
+		return
+	}
+
+	response.Success(c, "SLA policies fetched successfully", policies)
+}
+
+func (h *EscalationHandler) CreatePolicy(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+	if role != constants.RoleAdmin {
+		handleEscalationError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	var policy models.SLAPolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		handleEscalationError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+	policy.RequestType = strings.ToUpper(policy.RequestType)
+
+	ctx := c.Request.Context()
+	created, err := h.escalationService.CreatePolicy(ctx, role, adminID, policy)
+	if err != nil {
+		handleEscalationError(c, err)
+		return
+	}
+
+	response.Created(c, "SLA policy created successfully", created)
+}
+
+func (h *EscalationHandler) UpdatePolicy(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+	if role != constants.RoleAdmin {
+		handleEscalationError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleEscalationError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var policy models.SLAPolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		handleEscalationError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+	policy.ID = policyID
+
+	ctx := c.Request.Context()
+	updated, err := h.escalationService.UpdatePolicy(ctx, role, adminID, policy)
+	if err != nil {
+		handleEscalationError(c, err)
+		return
+	}
+
+	response.Success(c, "SLA policy updated successfully", updated)
+}
+
+func (h *EscalationHandler) DeletePolicy(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleEscalationError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleEscalationError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.escalationService.DeletePolicy(ctx, role, policyID); err != nil {
+		handleEscalationError(c, err)
+		return
+	}
+
+	response.Success(c, "SLA policy deleted successfully", nil)
+}
+
+func (h *EscalationHandler) GetLeaveEscalations(c *gin.Context) {
+	h.getRequestEscalations(c, "LEAVE")
+}
+
+func (h *EscalationHandler) GetExpenseEscalations(c *gin.Context) {
+	h.getRequestEscalations(c, "EXPENSE")
+}
+
+func (h *EscalationHandler) GetDiscountEscalations(c *gin.Context) {
+	h.getRequestEscalations(c, "DISCOUNT")
+}
+
+func (h *EscalationHandler) getRequestEscalations(c *gin.Context, requestType string) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleEscalationError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	escalations, err := h.escalationService.GetRequestEscalations(ctx, role, userID, requestType, requestID)
+	if err != nil {
+		handleEscalationError(c, err)
+		return
+	}
+
+	response.Success(c, "Escalations fetched successfully", escalations)
+}
+
+func handleEscalationError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrSLAPolicyNotFound, apperrors.ErrLeaveRequestNotFound,
+		apperrors.ErrExpenseRequestNotFound, apperrors.ErrDiscountRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID,
+		apperrors.ErrInvalidRequestType, apperrors.ErrRejectDeadlineRequired,
+		apperrors.ErrInvalidEscalationOrder, apperrors.ErrCheckConstraintFailed,
+		apperrors.ErrForeignKeyViolation:
+		status = http.StatusBadRequest
+	case apperrors.ErrSLAPolicyExists:
+		status = http.StatusConflict
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,329 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// EscalationService is an autogenerated mock type for the EscalationService type
+type EscalationService struct {
+	mock.Mock
+}
+
+type EscalationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EscalationService) EXPECT() *EscalationService_Expecter {
+	return &EscalationService_Expecter{mock: &_m.Mock}
+}
+
+// CreatePolicy provides a mock function with given fields: ctx, role, adminID, policy
+func (_m *EscalationService) CreatePolicy(ctx context.Context, role string, adminID int64, policy models.SLAPolicy) (*models.SLAPolicy, error) {
+	ret := _m.Called(ctx, role, adminID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePolicy")
+	}
+
+	var r0 *models.SLAPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.SLAPolicy) (*models.SLAPolicy, error)); ok {
+		return rf(ctx, role, adminID, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.SLAPolicy) *models.SLAPolicy); ok {
+		r0 = rf(ctx, role, adminID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SLAPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.SLAPolicy) error); ok {
+		r1 = rf(ctx, role, adminID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationService_CreatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePolicy'
+type EscalationService_CreatePolicy_Call struct {
+	*mock.Call
+}
+
+// CreatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - policy models.SLAPolicy
+func (_e *EscalationService_Expecter) CreatePolicy(ctx interface{}, role interface{}, adminID interface{}, policy interface{}) *EscalationService_CreatePolicy_Call {
+	return &EscalationService_CreatePolicy_Call{Call: _e.mock.On("CreatePolicy", ctx, role, adminID, policy)}
+}
+
+func (_c *EscalationService_CreatePolicy_Call) Run(run func(ctx context.Context, role string, adminID int64, policy models.SLAPolicy)) *EscalationService_CreatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.SLAPolicy))
+	})
+	return _c
+}
+
+func (_c *EscalationService_CreatePolicy_Call) Return(_a0 *models.SLAPolicy, _a1 error) *EscalationService_CreatePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationService_CreatePolicy_Call) RunAndReturn(run func(context.Context, string, int64, models.SLAPolicy) (*models.SLAPolicy, error)) *EscalationService_CreatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePolicy provides a mock function with given fields: ctx, role, policyID
+func (_m *EscalationService) DeletePolicy(ctx context.Context, role string, policyID int64) error {
+	ret := _m.Called(ctx, role, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, role, policyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EscalationService_DeletePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePolicy'
+type EscalationService_DeletePolicy_Call struct {
+	*mock.Call
+}
+
+// DeletePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - policyID int64
+func (_e *EscalationService_Expecter) DeletePolicy(ctx interface{}, role interface{}, policyID interface{}) *EscalationService_DeletePolicy_Call {
+	return &EscalationService_DeletePolicy_Call{Call: _e.mock.On("DeletePolicy", ctx, role, policyID)}
+}
+
+func (_c *EscalationService_DeletePolicy_Call) Run(run func(ctx context.Context, role string, policyID int64)) *EscalationService_DeletePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *EscalationService_DeletePolicy_Call) Return(_a0 error) *EscalationService_DeletePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EscalationService_DeletePolicy_Call) RunAndReturn(run func(context.Context, string, int64) error) *EscalationService_DeletePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx, role
+func (_m *EscalationService) GetPolicies(ctx context.Context, role string) ([]models.SLAPolicy, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 []models.SLAPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.SLAPolicy, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.SLAPolicy); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SLAPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationService_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type EscalationService_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *EscalationService_Expecter) GetPolicies(ctx interface{}, role interface{}) *EscalationService_GetPolicies_Call {
+	return &EscalationService_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx, role)}
+}
+
+func (_c *EscalationService_GetPolicies_Call) Run(run func(ctx context.Context, role string)) *EscalationService_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *EscalationService_GetPolicies_Call) Return(_a0 []models.SLAPolicy, _a1 error) *EscalationService_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationService_GetPolicies_Call) RunAndReturn(run func(context.Context, string) ([]models.SLAPolicy, error)) *EscalationService_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestEscalations provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *EscalationService) GetRequestEscalations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestEscalations")
+	}
+
+	var r0 []models.RequestEscalation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.RequestEscalation, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.RequestEscalation); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestEscalation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationService_GetRequestEscalations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestEscalations'
+type EscalationService_GetRequestEscalations_Call struct {
+	*mock.Call
+}
+
+// GetRequestEscalations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *EscalationService_Expecter) GetRequestEscalations(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *EscalationService_GetRequestEscalations_Call {
+	return &EscalationService_GetRequestEscalations_Call{Call: _e.mock.On("GetRequestEscalations", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *EscalationService_GetRequestEscalations_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *EscalationService_GetRequestEscalations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *EscalationService_GetRequestEscalations_Call) Return(_a0 []models.RequestEscalation, _a1 error) *EscalationService_GetRequestEscalations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationService_GetRequestEscalations_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.RequestEscalation, error)) *EscalationService_GetRequestEscalations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePolicy provides a mock function with given fields: ctx, role, adminID, policy
+func (_m *EscalationService) UpdatePolicy(ctx context.Context, role string, adminID int64, policy models.SLAPolicy) (*models.SLAPolicy, error) {
+	ret := _m.Called(ctx, role, adminID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolicy")
+	}
+
+	var r0 *models.SLAPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.SLAPolicy) (*models.SLAPolicy, error)); ok {
+		return rf(ctx, role, adminID, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.SLAPolicy) *models.SLAPolicy); ok {
+		r0 = rf(ctx, role, adminID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SLAPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.SLAPolicy) error); ok {
+		r1 = rf(ctx, role, adminID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EscalationService_UpdatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePolicy'
+type EscalationService_UpdatePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - policy models.SLAPolicy
+func (_e *EscalationService_Expecter) UpdatePolicy(ctx interface{}, role interface{}, adminID interface{}, policy interface{}) *EscalationService_UpdatePolicy_Call {
+	return &EscalationService_UpdatePolicy_Call{Call: _e.mock.On("UpdatePolicy", ctx, role, adminID, policy)}
+}
+
+func (_c *EscalationService_UpdatePolicy_Call) Run(run func(ctx context.Context, role string, adminID int64, policy models.SLAPolicy)) *EscalationService_UpdatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.SLAPolicy))
+	})
+	return _c
+}
+
+func (_c *EscalationService_UpdatePolicy_Call) Return(_a0 *models.SLAPolicy, _a1 error) *EscalationService_UpdatePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EscalationService_UpdatePolicy_Call) RunAndReturn(run func(context.Context, string, int64, models.SLAPolicy) (*models.SLAPolicy, error)) *EscalationService_UpdatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEscalationService creates a new instance of EscalationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEscalationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EscalationService {
+	mock := &EscalationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,125 @@
+package escalations
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// EscalationService manages SLA policies and exposes the escalation steps of requests
+type EscalationService struct {
+	escalationRepo interfaces.EscalationRepository
+	chainRepo      interfaces.ApprovalChainRepository
+}
+
+// NewEscalationService creates a new instance of EscalationService
+func NewEscalationService(ctx context.Context, escalationRepo interfaces.EscalationRepository, chainRepo interfaces.ApprovalChainRepository) interfaces.EscalationService {
+	return &EscalationService{
+		escalationRepo: escalationRepo,
+		chainRepo:      chainRepo,
+	}
+}
+
+// GetPolicies lists the active SLA policies (admin only)
+func (s *EscalationService) GetPolicies(ctx context.Context, role string) ([]models.SLAPolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+	return s.escalationRepo.GetPolicies(ctx)
+}
+
+// CreatePolicy adds the SLA policy of a request type, or of one grade when GradeID is set
+// (admin only). Each request type and grade has at most one active policy.
+func (s *EscalationService) CreatePolicy(ctx context.Context, role string, adminID int64, policy models.SLAPolicy) (*models.SLAPolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if !utils.IsValidRequestType(policy.RequestType) {
+		return nil, apperrors.ErrInvalidRequestType
+	}
+	if err := validateThresholds(policy); err != nil {
+		return nil, err
+	}
+
+	policy.UpdatedBy = &adminID
+	if err := s.escalationRepo.CreatePolicy(ctx, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdatePolicy replaces the thresholds of an SLA policy; its request type and grade stay (admin only)
+func (s *EscalationService) UpdatePolicy(ctx context.Context, role string, adminID int64, policy models.SLAPolicy) (*models.SLAPolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if err := validateThresholds(policy); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.escalationRepo.GetPolicyByID(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing.ReminderAfterDays = policy.ReminderAfterDays
+	existing.EscalateAfterDays = policy.EscalateAfterDays
+	existing.RejectAfterDays = policy.RejectAfterDays
+	existing.UpdatedBy = &adminID
+	if err := s.escalationRepo.UpdatePolicy(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// DeletePolicy deactivates an SLA policy (admin only). Requests of a type and grade
+// without any policy are no longer escalated or auto-rejected.
+func (s *EscalationService) DeletePolicy(ctx context.Context, role string, policyID int64) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrUnauthorized
+	}
+	return s.escalationRepo.DeactivatePolicy(ctx, policyID)
+}
+
+// GetRequestEscalations returns the escalation steps of a request to its owner, approvers and admins
+func (s *EscalationService) GetRequestEscalations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	ownerID, err := s.chainRepo.GetRequestOwner(ctx, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == constants.RoleEmployee && ownerID != userID {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	return s.escalationRepo.GetRequestEscalations(ctx, requestType, requestID)
+}
+
+// validateThresholds checks that each configured threshold is positive and comes after
+// the previous one: reminder, then escalation, then rejection
+func validateThresholds(policy models.SLAPolicy) error {
+	if policy.RejectAfterDays <= 0 {
+		return apperrors.ErrRejectDeadlineRequired
+	}
+
+	previous := 0
+	for _, threshold := range []*int{policy.ReminderAfterDays, policy.EscalateAfterDays, &policy.RejectAfterDays} {
+		if threshold == nil {
+			continue
+		}
+		if *threshold <= previous {
+			return apperrors.ErrInvalidEscalationOrder
+		}
+		previous = *threshold
+	}
+
+	return nil
+}
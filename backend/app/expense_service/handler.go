@@ -220,7 +220,8 @@ func handleExpenseApprovalError(c *gin.Context, err error) {
 
 	switch err {
 	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
-		apperrors.ErrSelfApprovalNotAllowed:
+		apperrors.ErrSelfApprovalNotAllowed, apperrors.ErrNotRoutedApprover,
+		apperrors.ErrStepApproverRepeated:
 		status = http.StatusForbidden
 	case apperrors.ErrExpenseRequestNotFound, apperrors.ErrUserNotFound:
 		status = http.StatusNotFound
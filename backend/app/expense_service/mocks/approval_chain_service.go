@@ -0,0 +1,482 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// ApprovalChainService is an autogenerated mock type for the ApprovalChainService type
+type ApprovalChainService struct {
+	mock.Mock
+}
+
+type ApprovalChainService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ApprovalChainService) EXPECT() *ApprovalChainService_Expecter {
+	return &ApprovalChainService_Expecter{mock: &_m.Mock}
+}
+
+// ApproveStep provides a mock function with given fields: ctx, tx, requestType, requestID, approverID, delegation, comment
+func (_m *ApprovalChainService) ApproveStep(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string) (bool, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID, approverID, delegation, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, *models.ApprovalDelegation, string) (bool, error)); ok {
+		return rf(ctx, tx, requestType, requestID, approverID, delegation, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, *models.ApprovalDelegation, string) bool); ok {
+		r0 = rf(ctx, tx, requestType, requestID, approverID, delegation, comment)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64, int64, *models.ApprovalDelegation, string) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID, approverID, delegation, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_ApproveStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveStep'
+type ApprovalChainService_ApproveStep_Call struct {
+	*mock.Call
+}
+
+// ApproveStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - approverID int64
+//   - delegation *models.ApprovalDelegation
+//   - comment string
+func (_e *ApprovalChainService_Expecter) ApproveStep(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, approverID interface{}, delegation interface{}, comment interface{}) *ApprovalChainService_ApproveStep_Call {
+	return &ApprovalChainService_ApproveStep_Call{Call: _e.mock.On("ApproveStep", ctx, tx, requestType, requestID, approverID, delegation, comment)}
+}
+
+func (_c *ApprovalChainService_ApproveStep_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string)) *ApprovalChainService_ApproveStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(*models.ApprovalDelegation), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_ApproveStep_Call) Return(_a0 bool, _a1 error) *ApprovalChainService_ApproveStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_ApproveStep_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, *models.ApprovalDelegation, string) (bool, error)) *ApprovalChainService_ApproveStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainService) CancelSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelSteps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_CancelSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelSteps'
+type ApprovalChainService_CancelSteps_Call struct {
+	*mock.Call
+}
+
+// CancelSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainService_Expecter) CancelSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainService_CancelSteps_Call {
+	return &ApprovalChainService_CancelSteps_Call{Call: _e.mock.On("CancelSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainService_CancelSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainService_CancelSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_CancelSteps_Call) Return(_a0 error) *ApprovalChainService_CancelSteps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_CancelSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) error) *ApprovalChainService_CancelSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChain provides a mock function with given fields: ctx, role, chain
+func (_m *ApprovalChainService) CreateChain(ctx context.Context, role string, chain models.ApprovalChain) (*models.ApprovalChain, error) {
+	ret := _m.Called(ctx, role, chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChain")
+	}
+
+	var r0 *models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ApprovalChain) (*models.ApprovalChain, error)); ok {
+		return rf(ctx, role, chain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ApprovalChain) *models.ApprovalChain); ok {
+		r0 = rf(ctx, role, chain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.ApprovalChain) error); ok {
+		r1 = rf(ctx, role, chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_CreateChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChain'
+type ApprovalChainService_CreateChain_Call struct {
+	*mock.Call
+}
+
+// CreateChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - chain models.ApprovalChain
+func (_e *ApprovalChainService_Expecter) CreateChain(ctx interface{}, role interface{}, chain interface{}) *ApprovalChainService_CreateChain_Call {
+	return &ApprovalChainService_CreateChain_Call{Call: _e.mock.On("CreateChain", ctx, role, chain)}
+}
+
+func (_c *ApprovalChainService_CreateChain_Call) Run(run func(ctx context.Context, role string, chain models.ApprovalChain)) *ApprovalChainService_CreateChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.ApprovalChain))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_CreateChain_Call) Return(_a0 *models.ApprovalChain, _a1 error) *ApprovalChainService_CreateChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_CreateChain_Call) RunAndReturn(run func(context.Context, string, models.ApprovalChain) (*models.ApprovalChain, error)) *ApprovalChainService_CreateChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChain provides a mock function with given fields: ctx, role, chainID
+func (_m *ApprovalChainService) DeleteChain(ctx context.Context, role string, chainID int64) error {
+	ret := _m.Called(ctx, role, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, role, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_DeleteChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChain'
+type ApprovalChainService_DeleteChain_Call struct {
+	*mock.Call
+}
+
+// DeleteChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - chainID int64
+func (_e *ApprovalChainService_Expecter) DeleteChain(ctx interface{}, role interface{}, chainID interface{}) *ApprovalChainService_DeleteChain_Call {
+	return &ApprovalChainService_DeleteChain_Call{Call: _e.mock.On("DeleteChain", ctx, role, chainID)}
+}
+
+func (_c *ApprovalChainService_DeleteChain_Call) Run(run func(ctx context.Context, role string, chainID int64)) *ApprovalChainService_DeleteChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_DeleteChain_Call) Return(_a0 error) *ApprovalChainService_DeleteChain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_DeleteChain_Call) RunAndReturn(run func(context.Context, string, int64) error) *ApprovalChainService_DeleteChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChains provides a mock function with given fields: ctx, role
+func (_m *ApprovalChainService) GetChains(ctx context.Context, role string) ([]models.ApprovalChain, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChains")
+	}
+
+	var r0 []models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ApprovalChain, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ApprovalChain); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_GetChains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChains'
+type ApprovalChainService_GetChains_Call struct {
+	*mock.Call
+}
+
+// GetChains is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *ApprovalChainService_Expecter) GetChains(ctx interface{}, role interface{}) *ApprovalChainService_GetChains_Call {
+	return &ApprovalChainService_GetChains_Call{Call: _e.mock.On("GetChains", ctx, role)}
+}
+
+func (_c *ApprovalChainService_GetChains_Call) Run(run func(ctx context.Context, role string)) *ApprovalChainService_GetChains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_GetChains_Call) Return(_a0 []models.ApprovalChain, _a1 error) *ApprovalChainService_GetChains_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_GetChains_Call) RunAndReturn(run func(context.Context, string) ([]models.ApprovalChain, error)) *ApprovalChainService_GetChains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestSteps provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *ApprovalChainService) GetRequestSteps(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_GetRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestSteps'
+type ApprovalChainService_GetRequestSteps_Call struct {
+	*mock.Call
+}
+
+// GetRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainService_Expecter) GetRequestSteps(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *ApprovalChainService_GetRequestSteps_Call {
+	return &ApprovalChainService_GetRequestSteps_Call{Call: _e.mock.On("GetRequestSteps", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *ApprovalChainService_GetRequestSteps_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *ApprovalChainService_GetRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_GetRequestSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainService_GetRequestSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_GetRequestSteps_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainService_GetRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectStep provides a mock function with given fields: ctx, tx, requestType, requestID, approverID, delegation, comment
+func (_m *ApprovalChainService) RejectStep(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, approverID, delegation, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, *models.ApprovalDelegation, string) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, approverID, delegation, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_RejectStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectStep'
+type ApprovalChainService_RejectStep_Call struct {
+	*mock.Call
+}
+
+// RejectStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - approverID int64
+//   - delegation *models.ApprovalDelegation
+//   - comment string
+func (_e *ApprovalChainService_Expecter) RejectStep(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, approverID interface{}, delegation interface{}, comment interface{}) *ApprovalChainService_RejectStep_Call {
+	return &ApprovalChainService_RejectStep_Call{Call: _e.mock.On("RejectStep", ctx, tx, requestType, requestID, approverID, delegation, comment)}
+}
+
+func (_c *ApprovalChainService_RejectStep_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string)) *ApprovalChainService_RejectStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(*models.ApprovalDelegation), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_RejectStep_Call) Return(_a0 error) *ApprovalChainService_RejectStep_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_RejectStep_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, *models.ApprovalDelegation, string) error) *ApprovalChainService_RejectStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartChain provides a mock function with given fields: ctx, tx, requestType, requestID, requester, amount
+func (_m *ApprovalChainService) StartChain(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, requester *models.User, amount float64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, requester, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartChain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, *models.User, float64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, requester, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_StartChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartChain'
+type ApprovalChainService_StartChain_Call struct {
+	*mock.Call
+}
+
+// StartChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - requester *models.User
+//   - amount float64
+func (_e *ApprovalChainService_Expecter) StartChain(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, requester interface{}, amount interface{}) *ApprovalChainService_StartChain_Call {
+	return &ApprovalChainService_StartChain_Call{Call: _e.mock.On("StartChain", ctx, tx, requestType, requestID, requester, amount)}
+}
+
+func (_c *ApprovalChainService_StartChain_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, requester *models.User, amount float64)) *ApprovalChainService_StartChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(*models.User), args[5].(float64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_StartChain_Call) Return(_a0 error) *ApprovalChainService_StartChain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_StartChain_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, *models.User, float64) error) *ApprovalChainService_StartChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewApprovalChainService creates a new instance of ApprovalChainService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApprovalChainService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApprovalChainService {
+	mock := &ApprovalChainService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +22,68 @@ func (_m *BalanceService) EXPECT() *BalanceService_Expecter {
 	return &BalanceService_Expecter{mock: &_m.Mock}
 }
 
+// GetBalanceHistory provides a mock function with given fields: ctx, userID, balanceType, limit, offset
+func (_m *BalanceService) GetBalanceHistory(ctx context.Context, userID int64, balanceType string, limit int, offset int) ([]models.BalanceLedgerEntry, error) {
+	ret := _m.Called(ctx, userID, balanceType, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceHistory")
+	}
+
+	var r0 []models.BalanceLedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int, int) ([]models.BalanceLedgerEntry, error)); ok {
+		return rf(ctx, userID, balanceType, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int, int) []models.BalanceLedgerEntry); ok {
+		r0 = rf(ctx, userID, balanceType, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BalanceLedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int, int) error); ok {
+		r1 = rf(ctx, userID, balanceType, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceService_GetBalanceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceHistory'
+type BalanceService_GetBalanceHistory_Call struct {
+	*mock.Call
+}
+
+// GetBalanceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - balanceType string
+//   - limit int
+//   - offset int
+func (_e *BalanceService_Expecter) GetBalanceHistory(ctx interface{}, userID interface{}, balanceType interface{}, limit interface{}, offset interface{}) *BalanceService_GetBalanceHistory_Call {
+	return &BalanceService_GetBalanceHistory_Call{Call: _e.mock.On("GetBalanceHistory", ctx, userID, balanceType, limit, offset)}
+}
+
+func (_c *BalanceService_GetBalanceHistory_Call) Run(run func(ctx context.Context, userID int64, balanceType string, limit int, offset int)) *BalanceService_GetBalanceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *BalanceService_GetBalanceHistory_Call) Return(_a0 []models.BalanceLedgerEntry, _a1 error) *BalanceService_GetBalanceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceService_GetBalanceHistory_Call) RunAndReturn(run func(context.Context, int64, string, int, int) ([]models.BalanceLedgerEntry, error)) *BalanceService_GetBalanceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBalances provides a mock function with given fields: ctx, userID
 func (_m *BalanceService) GetBalances(ctx context.Context, userID int64) (map[string]interface{}, error) {
 	ret := _m.Called(ctx, userID)
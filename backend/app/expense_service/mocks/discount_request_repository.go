@@ -182,34 +182,41 @@ func (_c *DiscountRequestRepository_GetByID_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// GetPendingForAdmin provides a mock function with given fields: ctx
-func (_m *DiscountRequestRepository) GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error) {
-	ret := _m.Called(ctx)
+// GetPendingForAdmin provides a mock function with given fields: ctx, limit, offset
+func (_m *DiscountRequestRepository) GetPendingForAdmin(ctx context.Context, limit int, offset int) ([]map[string]interface{}, int, error) {
+	ret := _m.Called(ctx, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingForAdmin")
 	}
 
 	var r0 []map[string]interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]map[string]interface{}, error)); ok {
-		return rf(ctx)
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]map[string]interface{}, int, error)); ok {
+		return rf(ctx, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []map[string]interface{}); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []map[string]interface{}); ok {
+		r0 = rf(ctx, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, limit, offset)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // DiscountRequestRepository_GetPendingForAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForAdmin'
@@ -219,55 +226,64 @@ type DiscountRequestRepository_GetPendingForAdmin_Call struct {
 
 // GetPendingForAdmin is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *DiscountRequestRepository_Expecter) GetPendingForAdmin(ctx interface{}) *DiscountRequestRepository_GetPendingForAdmin_Call {
-	return &DiscountRequestRepository_GetPendingForAdmin_Call{Call: _e.mock.On("GetPendingForAdmin", ctx)}
+//   - limit int
+//   - offset int
+func (_e *DiscountRequestRepository_Expecter) GetPendingForAdmin(ctx interface{}, limit interface{}, offset interface{}) *DiscountRequestRepository_GetPendingForAdmin_Call {
+	return &DiscountRequestRepository_GetPendingForAdmin_Call{Call: _e.mock.On("GetPendingForAdmin", ctx, limit, offset)}
 }
 
-func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) Run(run func(ctx context.Context)) *DiscountRequestRepository_GetPendingForAdmin_Call {
+func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) Run(run func(ctx context.Context, limit int, offset int)) *DiscountRequestRepository_GetPendingForAdmin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) Return(_a0 []map[string]interface{}, _a1 error) *DiscountRequestRepository_GetPendingForAdmin_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) Return(_a0 []map[string]interface{}, _a1 int, _a2 error) *DiscountRequestRepository_GetPendingForAdmin_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) RunAndReturn(run func(context.Context) ([]map[string]interface{}, error)) *DiscountRequestRepository_GetPendingForAdmin_Call {
+func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) RunAndReturn(run func(context.Context, int, int) ([]map[string]interface{}, int, error)) *DiscountRequestRepository_GetPendingForAdmin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPendingForManager provides a mock function with given fields: ctx, managerID
-func (_m *DiscountRequestRepository) GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error) {
-	ret := _m.Called(ctx, managerID)
+// GetPendingForManager provides a mock function with given fields: ctx, managerIDs, limit, offset
+func (_m *DiscountRequestRepository) GetPendingForManager(ctx context.Context, managerIDs []int64, limit int, offset int) ([]map[string]interface{}, int, error) {
+	ret := _m.Called(ctx, managerIDs, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingForManager")
 	}
 
 	var r0 []map[string]interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]map[string]interface{}, error)); ok {
-		return rf(ctx, managerID)
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int, int) ([]map[string]interface{}, int, error)); ok {
+		return rf(ctx, managerIDs, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []map[string]interface{}); ok {
-		r0 = rf(ctx, managerID)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int, int) []map[string]interface{}); ok {
+		r0 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, managerID)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, int, int) int); ok {
+		r1 = rf(ctx, managerIDs, limit, offset)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, []int64, int, int) error); ok {
+		r2 = rf(ctx, managerIDs, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // DiscountRequestRepository_GetPendingForManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForManager'
@@ -277,24 +293,26 @@ type DiscountRequestRepository_GetPendingForManager_Call struct {
 
 // GetPendingForManager is a helper method to define mock.On call
 //   - ctx context.Context
-//   - managerID int64
-func (_e *DiscountRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerID interface{}) *DiscountRequestRepository_GetPendingForManager_Call {
-	return &DiscountRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerID)}
+//   - managerIDs []int64
+//   - limit int
+//   - offset int
+func (_e *DiscountRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerIDs interface{}, limit interface{}, offset interface{}) *DiscountRequestRepository_GetPendingForManager_Call {
+	return &DiscountRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerIDs, limit, offset)}
 }
 
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerID int64)) *DiscountRequestRepository_GetPendingForManager_Call {
+func (_c *DiscountRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerIDs []int64, limit int, offset int)) *DiscountRequestRepository_GetPendingForManager_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].([]int64), args[2].(int), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) Return(_a0 []map[string]interface{}, _a1 error) *DiscountRequestRepository_GetPendingForManager_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *DiscountRequestRepository_GetPendingForManager_Call) Return(_a0 []map[string]interface{}, _a1 int, _a2 error) *DiscountRequestRepository_GetPendingForManager_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, int64) ([]map[string]interface{}, error)) *DiscountRequestRepository_GetPendingForManager_Call {
+func (_c *DiscountRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, []int64, int, int) ([]map[string]interface{}, int, error)) *DiscountRequestRepository_GetPendingForManager_Call {
 	_c.Call.Return(run)
 	return _c
 }
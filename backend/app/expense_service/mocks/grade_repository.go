@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +23,123 @@ func (_m *GradeRepository) EXPECT() *GradeRepository_Expecter {
 	return &GradeRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetAll provides a mock function with given fields: ctx
+func (_m *GradeRepository) GetAll(ctx context.Context) ([]models.Grade, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.Grade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Grade, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Grade); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Grade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GradeRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type GradeRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GradeRepository_Expecter) GetAll(ctx interface{}) *GradeRepository_GetAll_Call {
+	return &GradeRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *GradeRepository_GetAll_Call) Run(run func(ctx context.Context)) *GradeRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GradeRepository_GetAll_Call) Return(_a0 []models.Grade, _a1 error) *GradeRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GradeRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.Grade, error)) *GradeRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllInTx provides a mock function with given fields: ctx, tx
+func (_m *GradeRepository) GetAllInTx(ctx context.Context, tx interfaces.Tx) ([]models.Grade, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInTx")
+	}
+
+	var r0 []models.Grade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) ([]models.Grade, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) []models.Grade); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Grade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GradeRepository_GetAllInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInTx'
+type GradeRepository_GetAllInTx_Call struct {
+	*mock.Call
+}
+
+// GetAllInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+func (_e *GradeRepository_Expecter) GetAllInTx(ctx interface{}, tx interface{}) *GradeRepository_GetAllInTx_Call {
+	return &GradeRepository_GetAllInTx_Call{Call: _e.mock.On("GetAllInTx", ctx, tx)}
+}
+
+func (_c *GradeRepository_GetAllInTx_Call) Run(run func(ctx context.Context, tx interfaces.Tx)) *GradeRepository_GetAllInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx))
+	})
+	return _c
+}
+
+func (_c *GradeRepository_GetAllInTx_Call) Return(_a0 []models.Grade, _a1 error) *GradeRepository_GetAllInTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GradeRepository_GetAllInTx_Call) RunAndReturn(run func(context.Context, interfaces.Tx) ([]models.Grade, error)) *GradeRepository_GetAllInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLimits provides a mock function with given fields: ctx, tx, gradeID
 func (_m *GradeRepository) GetLimits(ctx context.Context, tx interfaces.Tx, gradeID int64) (int, float64, float64, error) {
 	ret := _m.Called(ctx, tx, gradeID)
@@ -94,6 +212,54 @@ func (_c *GradeRepository_GetLimits_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Upsert provides a mock function with given fields: ctx, tx, grade
+func (_m *GradeRepository) Upsert(ctx context.Context, tx interfaces.Tx, grade *models.Grade) error {
+	ret := _m.Called(ctx, tx, grade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Grade) error); ok {
+		r0 = rf(ctx, tx, grade)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GradeRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type GradeRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - grade *models.Grade
+func (_e *GradeRepository_Expecter) Upsert(ctx interface{}, tx interface{}, grade interface{}) *GradeRepository_Upsert_Call {
+	return &GradeRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, tx, grade)}
+}
+
+func (_c *GradeRepository_Upsert_Call) Run(run func(ctx context.Context, tx interfaces.Tx, grade *models.Grade)) *GradeRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Grade))
+	})
+	return _c
+}
+
+func (_c *GradeRepository_Upsert_Call) Return(_a0 error) *GradeRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GradeRepository_Upsert_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Grade) error) *GradeRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewGradeRepository creates a new instance of GradeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGradeRepository(t interface {
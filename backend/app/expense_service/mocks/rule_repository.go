@@ -4,10 +4,11 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
-	mock "github.com/stretchr/testify/mock"
-
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // RuleRepository is an autogenerated mock type for the RuleRepository type
@@ -23,17 +24,66 @@ func (_m *RuleRepository) EXPECT() *RuleRepository_Expecter {
 	return &RuleRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, rule
-func (_m *RuleRepository) Create(ctx context.Context, rule *models.Rule) error {
-	ret := _m.Called(ctx, rule)
+// CloseOpenVersions provides a mock function with given fields: ctx, tx, ruleKey, effectiveTo
+func (_m *RuleRepository) CloseOpenVersions(ctx context.Context, tx interfaces.Tx, ruleKey int64, effectiveTo time.Time) error {
+	ret := _m.Called(ctx, tx, ruleKey, effectiveTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseOpenVersions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time) error); ok {
+		r0 = rf(ctx, tx, ruleKey, effectiveTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleRepository_CloseOpenVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseOpenVersions'
+type RuleRepository_CloseOpenVersions_Call struct {
+	*mock.Call
+}
+
+// CloseOpenVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - ruleKey int64
+//   - effectiveTo time.Time
+func (_e *RuleRepository_Expecter) CloseOpenVersions(ctx interface{}, tx interface{}, ruleKey interface{}, effectiveTo interface{}) *RuleRepository_CloseOpenVersions_Call {
+	return &RuleRepository_CloseOpenVersions_Call{Call: _e.mock.On("CloseOpenVersions", ctx, tx, ruleKey, effectiveTo)}
+}
+
+func (_c *RuleRepository_CloseOpenVersions_Call) Run(run func(ctx context.Context, tx interfaces.Tx, ruleKey int64, effectiveTo time.Time)) *RuleRepository_CloseOpenVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_CloseOpenVersions_Call) Return(_a0 error) *RuleRepository_CloseOpenVersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RuleRepository_CloseOpenVersions_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time) error) *RuleRepository_CloseOpenVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, tx, rule
+func (_m *RuleRepository) Create(ctx context.Context, tx interfaces.Tx, rule *models.Rule) error {
+	ret := _m.Called(ctx, tx, rule)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Rule) error); ok {
-		r0 = rf(ctx, rule)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Rule) error); ok {
+		r0 = rf(ctx, tx, rule)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,14 +98,15 @@ type RuleRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tx interfaces.Tx
 //   - rule *models.Rule
-func (_e *RuleRepository_Expecter) Create(ctx interface{}, rule interface{}) *RuleRepository_Create_Call {
-	return &RuleRepository_Create_Call{Call: _e.mock.On("Create", ctx, rule)}
+func (_e *RuleRepository_Expecter) Create(ctx interface{}, tx interface{}, rule interface{}) *RuleRepository_Create_Call {
+	return &RuleRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, rule)}
 }
 
-func (_c *RuleRepository_Create_Call) Run(run func(ctx context.Context, rule *models.Rule)) *RuleRepository_Create_Call {
+func (_c *RuleRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, rule *models.Rule)) *RuleRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.Rule))
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Rule))
 	})
 	return _c
 }
@@ -65,54 +116,67 @@ func (_c *RuleRepository_Create_Call) Return(_a0 error) *RuleRepository_Create_C
 	return _c
 }
 
-func (_c *RuleRepository_Create_Call) RunAndReturn(run func(context.Context, *models.Rule) error) *RuleRepository_Create_Call {
+func (_c *RuleRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Rule) error) *RuleRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, ruleID
-func (_m *RuleRepository) Delete(ctx context.Context, ruleID int64) error {
-	ret := _m.Called(ctx, ruleID)
+// GetActiveByTypeAndGrade provides a mock function with given fields: ctx, requestType, gradeID
+func (_m *RuleRepository) GetActiveByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) ([]models.Rule, error) {
+	ret := _m.Called(ctx, requestType, gradeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for GetActiveByTypeAndGrade")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, ruleID)
+	var r0 []models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.Rule, error)); ok {
+		return rf(ctx, requestType, gradeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.Rule); ok {
+		r0 = rf(ctx, requestType, gradeID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rule)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, gradeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// RuleRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type RuleRepository_Delete_Call struct {
+// RuleRepository_GetActiveByTypeAndGrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveByTypeAndGrade'
+type RuleRepository_GetActiveByTypeAndGrade_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// GetActiveByTypeAndGrade is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ruleID int64
-func (_e *RuleRepository_Expecter) Delete(ctx interface{}, ruleID interface{}) *RuleRepository_Delete_Call {
-	return &RuleRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, ruleID)}
+//   - requestType string
+//   - gradeID int64
+func (_e *RuleRepository_Expecter) GetActiveByTypeAndGrade(ctx interface{}, requestType interface{}, gradeID interface{}) *RuleRepository_GetActiveByTypeAndGrade_Call {
+	return &RuleRepository_GetActiveByTypeAndGrade_Call{Call: _e.mock.On("GetActiveByTypeAndGrade", ctx, requestType, gradeID)}
 }
 
-func (_c *RuleRepository_Delete_Call) Run(run func(ctx context.Context, ruleID int64)) *RuleRepository_Delete_Call {
+func (_c *RuleRepository_GetActiveByTypeAndGrade_Call) Run(run func(ctx context.Context, requestType string, gradeID int64)) *RuleRepository_GetActiveByTypeAndGrade_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *RuleRepository_Delete_Call) Return(_a0 error) *RuleRepository_Delete_Call {
-	_c.Call.Return(_a0)
+func (_c *RuleRepository_GetActiveByTypeAndGrade_Call) Return(_a0 []models.Rule, _a1 error) *RuleRepository_GetActiveByTypeAndGrade_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RuleRepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *RuleRepository_Delete_Call {
+func (_c *RuleRepository_GetActiveByTypeAndGrade_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.Rule, error)) *RuleRepository_GetActiveByTypeAndGrade_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -175,25 +239,82 @@ func (_c *RuleRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]
 	return _c
 }
 
-// GetByTypeAndGrade provides a mock function with given fields: ctx, requestType, gradeID
-func (_m *RuleRepository) GetByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error) {
+// GetAllInTx provides a mock function with given fields: ctx, tx
+func (_m *RuleRepository) GetAllInTx(ctx context.Context, tx interfaces.Tx) ([]models.Rule, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInTx")
+	}
+
+	var r0 []models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) ([]models.Rule, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) []models.Rule); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetAllInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInTx'
+type RuleRepository_GetAllInTx_Call struct {
+	*mock.Call
+}
+
+// GetAllInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+func (_e *RuleRepository_Expecter) GetAllInTx(ctx interface{}, tx interface{}) *RuleRepository_GetAllInTx_Call {
+	return &RuleRepository_GetAllInTx_Call{Call: _e.mock.On("GetAllInTx", ctx, tx)}
+}
+
+func (_c *RuleRepository_GetAllInTx_Call) Run(run func(ctx context.Context, tx interfaces.Tx)) *RuleRepository_GetAllInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetAllInTx_Call) Return(_a0 []models.Rule, _a1 error) *RuleRepository_GetAllInTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetAllInTx_Call) RunAndReturn(run func(context.Context, interfaces.Tx) ([]models.Rule, error)) *RuleRepository_GetAllInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvaluationMode provides a mock function with given fields: ctx, requestType, gradeID
+func (_m *RuleRepository) GetEvaluationMode(ctx context.Context, requestType string, gradeID int64) (string, error) {
 	ret := _m.Called(ctx, requestType, gradeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByTypeAndGrade")
+		panic("no return value specified for GetEvaluationMode")
 	}
 
-	var r0 *models.Rule
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*models.Rule, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (string, error)); ok {
 		return rf(ctx, requestType, gradeID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *models.Rule); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) string); ok {
 		r0 = rf(ctx, requestType, gradeID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Rule)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
@@ -205,47 +326,345 @@ func (_m *RuleRepository) GetByTypeAndGrade(ctx context.Context, requestType str
 	return r0, r1
 }
 
-// RuleRepository_GetByTypeAndGrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTypeAndGrade'
-type RuleRepository_GetByTypeAndGrade_Call struct {
+// RuleRepository_GetEvaluationMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvaluationMode'
+type RuleRepository_GetEvaluationMode_Call struct {
 	*mock.Call
 }
 
-// GetByTypeAndGrade is a helper method to define mock.On call
+// GetEvaluationMode is a helper method to define mock.On call
 //   - ctx context.Context
 //   - requestType string
 //   - gradeID int64
-func (_e *RuleRepository_Expecter) GetByTypeAndGrade(ctx interface{}, requestType interface{}, gradeID interface{}) *RuleRepository_GetByTypeAndGrade_Call {
-	return &RuleRepository_GetByTypeAndGrade_Call{Call: _e.mock.On("GetByTypeAndGrade", ctx, requestType, gradeID)}
+func (_e *RuleRepository_Expecter) GetEvaluationMode(ctx interface{}, requestType interface{}, gradeID interface{}) *RuleRepository_GetEvaluationMode_Call {
+	return &RuleRepository_GetEvaluationMode_Call{Call: _e.mock.On("GetEvaluationMode", ctx, requestType, gradeID)}
 }
 
-func (_c *RuleRepository_GetByTypeAndGrade_Call) Run(run func(ctx context.Context, requestType string, gradeID int64)) *RuleRepository_GetByTypeAndGrade_Call {
+func (_c *RuleRepository_GetEvaluationMode_Call) Run(run func(ctx context.Context, requestType string, gradeID int64)) *RuleRepository_GetEvaluationMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *RuleRepository_GetByTypeAndGrade_Call) Return(_a0 *models.Rule, _a1 error) *RuleRepository_GetByTypeAndGrade_Call {
+func (_c *RuleRepository_GetEvaluationMode_Call) Return(_a0 string, _a1 error) *RuleRepository_GetEvaluationMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetEvaluationMode_Call) RunAndReturn(run func(context.Context, string, int64) (string, error)) *RuleRepository_GetEvaluationMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvaluationModes provides a mock function with given fields: ctx
+func (_m *RuleRepository) GetEvaluationModes(ctx context.Context) ([]models.EvaluationMode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvaluationModes")
+	}
+
+	var r0 []models.EvaluationMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.EvaluationMode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.EvaluationMode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EvaluationMode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetEvaluationModes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvaluationModes'
+type RuleRepository_GetEvaluationModes_Call struct {
+	*mock.Call
+}
+
+// GetEvaluationModes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RuleRepository_Expecter) GetEvaluationModes(ctx interface{}) *RuleRepository_GetEvaluationModes_Call {
+	return &RuleRepository_GetEvaluationModes_Call{Call: _e.mock.On("GetEvaluationModes", ctx)}
+}
+
+func (_c *RuleRepository_GetEvaluationModes_Call) Run(run func(ctx context.Context)) *RuleRepository_GetEvaluationModes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetEvaluationModes_Call) Return(_a0 []models.EvaluationMode, _a1 error) *RuleRepository_GetEvaluationModes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetEvaluationModes_Call) RunAndReturn(run func(context.Context) ([]models.EvaluationMode, error)) *RuleRepository_GetEvaluationModes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvaluationModesInTx provides a mock function with given fields: ctx, tx
+func (_m *RuleRepository) GetEvaluationModesInTx(ctx context.Context, tx interfaces.Tx) ([]models.EvaluationMode, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvaluationModesInTx")
+	}
+
+	var r0 []models.EvaluationMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) ([]models.EvaluationMode, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) []models.EvaluationMode); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EvaluationMode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetEvaluationModesInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvaluationModesInTx'
+type RuleRepository_GetEvaluationModesInTx_Call struct {
+	*mock.Call
+}
+
+// GetEvaluationModesInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+func (_e *RuleRepository_Expecter) GetEvaluationModesInTx(ctx interface{}, tx interface{}) *RuleRepository_GetEvaluationModesInTx_Call {
+	return &RuleRepository_GetEvaluationModesInTx_Call{Call: _e.mock.On("GetEvaluationModesInTx", ctx, tx)}
+}
+
+func (_c *RuleRepository_GetEvaluationModesInTx_Call) Run(run func(ctx context.Context, tx interfaces.Tx)) *RuleRepository_GetEvaluationModesInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetEvaluationModesInTx_Call) Return(_a0 []models.EvaluationMode, _a1 error) *RuleRepository_GetEvaluationModesInTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetEvaluationModesInTx_Call) RunAndReturn(run func(context.Context, interfaces.Tx) ([]models.EvaluationMode, error)) *RuleRepository_GetEvaluationModesInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: ctx, ruleID
+func (_m *RuleRepository) GetHistory(ctx context.Context, ruleID int64) ([]models.Rule, error) {
+	ret := _m.Called(ctx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.Rule, error)); ok {
+		return rf(ctx, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Rule); ok {
+		r0 = rf(ctx, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type RuleRepository_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID int64
+func (_e *RuleRepository_Expecter) GetHistory(ctx interface{}, ruleID interface{}) *RuleRepository_GetHistory_Call {
+	return &RuleRepository_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, ruleID)}
+}
+
+func (_c *RuleRepository_GetHistory_Call) Run(run func(ctx context.Context, ruleID int64)) *RuleRepository_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetHistory_Call) Return(_a0 []models.Rule, _a1 error) *RuleRepository_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetHistory_Call) RunAndReturn(run func(context.Context, int64) ([]models.Rule, error)) *RuleRepository_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestVersion provides a mock function with given fields: ctx, tx, ruleID
+func (_m *RuleRepository) GetLatestVersion(ctx context.Context, tx interfaces.Tx, ruleID int64) (*models.Rule, error) {
+	ret := _m.Called(ctx, tx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestVersion")
+	}
+
+	var r0 *models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (*models.Rule, error)); ok {
+		return rf(ctx, tx, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) *models.Rule); ok {
+		r0 = rf(ctx, tx, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetLatestVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestVersion'
+type RuleRepository_GetLatestVersion_Call struct {
+	*mock.Call
+}
+
+// GetLatestVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - ruleID int64
+func (_e *RuleRepository_Expecter) GetLatestVersion(ctx interface{}, tx interface{}, ruleID interface{}) *RuleRepository_GetLatestVersion_Call {
+	return &RuleRepository_GetLatestVersion_Call{Call: _e.mock.On("GetLatestVersion", ctx, tx, ruleID)}
+}
+
+func (_c *RuleRepository_GetLatestVersion_Call) Run(run func(ctx context.Context, tx interfaces.Tx, ruleID int64)) *RuleRepository_GetLatestVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetLatestVersion_Call) Return(_a0 *models.Rule, _a1 error) *RuleRepository_GetLatestVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetLatestVersion_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (*models.Rule, error)) *RuleRepository_GetLatestVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimulationSamples provides a mock function with given fields: ctx, requestType, gradeID, from, to
+func (_m *RuleRepository) GetSimulationSamples(ctx context.Context, requestType string, gradeID int64, from time.Time, to time.Time) ([]models.SimulationSample, error) {
+	ret := _m.Called(ctx, requestType, gradeID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSimulationSamples")
+	}
+
+	var r0 []models.SimulationSample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time, time.Time) ([]models.SimulationSample, error)); ok {
+		return rf(ctx, requestType, gradeID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time, time.Time) []models.SimulationSample); ok {
+		r0 = rf(ctx, requestType, gradeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SimulationSample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, requestType, gradeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetSimulationSamples_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimulationSamples'
+type RuleRepository_GetSimulationSamples_Call struct {
+	*mock.Call
+}
+
+// GetSimulationSamples is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - gradeID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *RuleRepository_Expecter) GetSimulationSamples(ctx interface{}, requestType interface{}, gradeID interface{}, from interface{}, to interface{}) *RuleRepository_GetSimulationSamples_Call {
+	return &RuleRepository_GetSimulationSamples_Call{Call: _e.mock.On("GetSimulationSamples", ctx, requestType, gradeID, from, to)}
+}
+
+func (_c *RuleRepository_GetSimulationSamples_Call) Run(run func(ctx context.Context, requestType string, gradeID int64, from time.Time, to time.Time)) *RuleRepository_GetSimulationSamples_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetSimulationSamples_Call) Return(_a0 []models.SimulationSample, _a1 error) *RuleRepository_GetSimulationSamples_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RuleRepository_GetByTypeAndGrade_Call) RunAndReturn(run func(context.Context, string, int64) (*models.Rule, error)) *RuleRepository_GetByTypeAndGrade_Call {
+func (_c *RuleRepository_GetSimulationSamples_Call) RunAndReturn(run func(context.Context, string, int64, time.Time, time.Time) ([]models.SimulationSample, error)) *RuleRepository_GetSimulationSamples_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, ruleID, rule
-func (_m *RuleRepository) Update(ctx context.Context, ruleID int64, rule *models.Rule) error {
-	ret := _m.Called(ctx, ruleID, rule)
+// InsertVersion provides a mock function with given fields: ctx, tx, rule
+func (_m *RuleRepository) InsertVersion(ctx context.Context, tx interfaces.Tx, rule *models.Rule) error {
+	ret := _m.Called(ctx, tx, rule)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for InsertVersion")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *models.Rule) error); ok {
-		r0 = rf(ctx, ruleID, rule)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Rule) error); ok {
+		r0 = rf(ctx, tx, rule)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -253,32 +672,129 @@ func (_m *RuleRepository) Update(ctx context.Context, ruleID int64, rule *models
 	return r0
 }
 
-// RuleRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type RuleRepository_Update_Call struct {
+// RuleRepository_InsertVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertVersion'
+type RuleRepository_InsertVersion_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// InsertVersion is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ruleID int64
+//   - tx interfaces.Tx
 //   - rule *models.Rule
-func (_e *RuleRepository_Expecter) Update(ctx interface{}, ruleID interface{}, rule interface{}) *RuleRepository_Update_Call {
-	return &RuleRepository_Update_Call{Call: _e.mock.On("Update", ctx, ruleID, rule)}
+func (_e *RuleRepository_Expecter) InsertVersion(ctx interface{}, tx interface{}, rule interface{}) *RuleRepository_InsertVersion_Call {
+	return &RuleRepository_InsertVersion_Call{Call: _e.mock.On("InsertVersion", ctx, tx, rule)}
+}
+
+func (_c *RuleRepository_InsertVersion_Call) Run(run func(ctx context.Context, tx interfaces.Tx, rule *models.Rule)) *RuleRepository_InsertVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Rule))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_InsertVersion_Call) Return(_a0 error) *RuleRepository_InsertVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RuleRepository_InsertVersion_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Rule) error) *RuleRepository_InsertVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPolicy provides a mock function with given fields: ctx, tx
+func (_m *RuleRepository) LockPolicy(ctx context.Context, tx interfaces.Tx) error {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) error); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleRepository_LockPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPolicy'
+type RuleRepository_LockPolicy_Call struct {
+	*mock.Call
+}
+
+// LockPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+func (_e *RuleRepository_Expecter) LockPolicy(ctx interface{}, tx interface{}) *RuleRepository_LockPolicy_Call {
+	return &RuleRepository_LockPolicy_Call{Call: _e.mock.On("LockPolicy", ctx, tx)}
+}
+
+func (_c *RuleRepository_LockPolicy_Call) Run(run func(ctx context.Context, tx interfaces.Tx)) *RuleRepository_LockPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_LockPolicy_Call) Return(_a0 error) *RuleRepository_LockPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RuleRepository_LockPolicy_Call) RunAndReturn(run func(context.Context, interfaces.Tx) error) *RuleRepository_LockPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEvaluationMode provides a mock function with given fields: ctx, tx, requestType, gradeID, mode
+func (_m *RuleRepository) SetEvaluationMode(ctx context.Context, tx interfaces.Tx, requestType string, gradeID int64, mode string) error {
+	ret := _m.Called(ctx, tx, requestType, gradeID, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEvaluationMode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestType, gradeID, mode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleRepository_SetEvaluationMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEvaluationMode'
+type RuleRepository_SetEvaluationMode_Call struct {
+	*mock.Call
+}
+
+// SetEvaluationMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - gradeID int64
+//   - mode string
+func (_e *RuleRepository_Expecter) SetEvaluationMode(ctx interface{}, tx interface{}, requestType interface{}, gradeID interface{}, mode interface{}) *RuleRepository_SetEvaluationMode_Call {
+	return &RuleRepository_SetEvaluationMode_Call{Call: _e.mock.On("SetEvaluationMode", ctx, tx, requestType, gradeID, mode)}
 }
 
-func (_c *RuleRepository_Update_Call) Run(run func(ctx context.Context, ruleID int64, rule *models.Rule)) *RuleRepository_Update_Call {
+func (_c *RuleRepository_SetEvaluationMode_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, gradeID int64, mode string)) *RuleRepository_SetEvaluationMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*models.Rule))
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(string))
 	})
 	return _c
 }
 
-func (_c *RuleRepository_Update_Call) Return(_a0 error) *RuleRepository_Update_Call {
+func (_c *RuleRepository_SetEvaluationMode_Call) Return(_a0 error) *RuleRepository_SetEvaluationMode_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *RuleRepository_Update_Call) RunAndReturn(run func(context.Context, int64, *models.Rule) error) *RuleRepository_Update_Call {
+func (_c *RuleRepository_SetEvaluationMode_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, string) error) *RuleRepository_SetEvaluationMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,7 @@ package expense_service
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -16,6 +17,7 @@ type ExpenseService struct {
 	expenseReqRepo interfaces.ExpenseRequestRepository
 	balanceRepo    interfaces.BalanceRepository
 	ruleService    interfaces.RuleService
+	chainService   interfaces.ApprovalChainService
 	userRepo       interfaces.UserRepository
 	db             interfaces.DB
 }
@@ -26,6 +28,7 @@ func NewExpenseService(
 	expenseReqRepo interfaces.ExpenseRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
 	ruleService interfaces.RuleService,
+	chainService interfaces.ApprovalChainService,
 	userRepo interfaces.UserRepository,
 	db interfaces.DB,
 ) interfaces.ExpenseService {
@@ -33,6 +36,7 @@ func NewExpenseService(
 		expenseReqRepo: expenseReqRepo,
 		balanceRepo:    balanceRepo,
 		ruleService:    ruleService,
+		chainService:   chainService,
 		userRepo:       userRepo,
 		db:             db,
 	}
@@ -66,40 +70,53 @@ func (s *ExpenseService) ApplyExpense(
 	defer tx.Rollback(ctx)
 
 	// expense balance
-	remaining, err := s.balanceRepo.GetExpenseBalance(ctx, tx, userID)
+	available, err := s.balanceRepo.GetExpenseBalance(ctx, tx, userID)
 	if err != nil {
 		return "", "", err
 	}
 
-	if amount > remaining {
+	if amount > available {
 		return "", "", apperrors.ErrExpenseLimitExceeded
 	}
 
-	// user grade
-	gradeID, err := s.userRepo.GetGrade(ctx, tx, userID)
+	// requester details (grade, role, tenure)
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return "", "", err
 	}
 
-	// fetch rule
-	rule, err := s.ruleService.GetRule(ctx, "EXPENSE", gradeID)
+	// fetch prioritized rules
+	ruleSet, err := s.ruleService.GetRuleSet(ctx, "EXPENSE", user.GradeID)
 	if err != nil {
 		return "", "", apperrors.ErrRuleNotFound
 	}
 
 	// apply rule
-	result := utils.MakeDecision("EXPENSE", rule.Condition, amount)
+	facts := utils.NewRequestFacts("EXPENSE", user, time.Now())
+	facts.Amount = amount
+	facts.Category = category
+
+	result := utils.MakeRuleSetDecision(*ruleSet, facts)
 	status := result.Status
 	message := result.Message
 
 	// create request
 	expenseReq := &models.ExpenseRequest{
-		EmployeeID: userID,
-		Amount:     amount,
-		Category:   category,
-		Reason:     reason,
-		Status:     status,
-		RuleID:     &rule.ID,
+		EmployeeID:     userID,
+		Amount:         amount,
+		Category:       category,
+		Reason:         reason,
+		Status:         status,
+		RuleID:         result.RuleID,
+		DecisionAction: result.Action,
+		RoutedToRole:   result.RouteToRole,
+		RoutedToUserID: result.RouteToUserID,
+		DecisionTrace:  result.Trace,
+	}
+
+	// keep the policy message on auto-rejected requests
+	if status == constants.StatusAutoRejected {
+		expenseReq.ApprovalComment = message
 	}
 
 	err = s.expenseReqRepo.Create(ctx, tx, expenseReq)
@@ -107,14 +124,28 @@ func (s *ExpenseService) ApplyExpense(
 		return "", "", apperrors.ErrInsertFailed
 	}
 
-	// deduct if auto-approved
-	if status == constants.StatusAutoApproved {
-		err = s.balanceRepo.DeductExpenseBalance(ctx, tx, userID, amount)
+	// requests left for manual review go through the approval chain of their band
+	if status == constants.StatusPending && result.Action == constants.ActionManual {
+		err = s.chainService.StartChain(ctx, tx, "EXPENSE", expenseReq.ID, user, amount)
 		if err != nil {
 			return "", "", err
 		}
 	}
 
+	// deduct if auto-approved, hold the amount while the request is pending
+	switch status {
+	case constants.StatusAutoApproved:
+		err = s.balanceRepo.DeductExpenseBalance(ctx, tx, userID, amount, models.BalanceChange{
+			RequestID: &expenseReq.ID,
+			Reason:    "Expense auto-approved",
+		})
+	case constants.StatusPending:
+		err = s.balanceRepo.ReserveBalance(ctx, tx, userID, "EXPENSE", "", amount)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return "", "", apperrors.ErrTransactionCommit
 	}
@@ -150,11 +181,23 @@ func (s *ExpenseService) CancelExpense(ctx context.Context, userID, requestID in
 		return err
 	}
 
-	if expenseReq.Status == constants.StatusAutoApproved {
-		err = s.balanceRepo.RestoreExpenseBalance(ctx, tx, userID, expenseReq.Amount)
-		if err != nil {
-			return err
-		}
+	err = s.chainService.CancelSteps(ctx, tx, "EXPENSE", requestID)
+	if err != nil {
+		return err
+	}
+
+	switch expenseReq.Status {
+	case constants.StatusAutoApproved:
+		err = s.balanceRepo.RestoreExpenseBalance(ctx, tx, userID, expenseReq.Amount, models.BalanceChange{
+			RequestID: &requestID,
+			ActorID:   &userID,
+			Reason:    "Expense cancelled",
+		})
+	case constants.StatusPending:
+		err = s.balanceRepo.ReleaseReservation(ctx, tx, userID, "EXPENSE", "", expenseReq.Amount)
+	}
+	if err != nil {
+		return err
 	}
 
 	return tx.Commit(ctx)
@@ -162,10 +205,12 @@ func (s *ExpenseService) CancelExpense(ctx context.Context, userID, requestID in
 
 // ExpenseApprovalService handles business logic for expense approval operations
 type ExpenseApprovalService struct {
-	expenseReqRepo interfaces.ExpenseRequestRepository
-	balanceRepo    interfaces.BalanceRepository
-	userRepo       interfaces.UserRepository
-	db             interfaces.DB
+	expenseReqRepo    interfaces.ExpenseRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	userRepo          interfaces.UserRepository
+	db                interfaces.DB
 }
 
 // NewExpenseApprovalService creates a new instance of ExpenseApprovalService
@@ -173,21 +218,30 @@ func NewExpenseApprovalService(
 	ctx context.Context,
 	expenseReqRepo interfaces.ExpenseRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
 	userRepo interfaces.UserRepository,
 	db interfaces.DB,
 ) interfaces.ExpenseApprovalService {
 	return &ExpenseApprovalService{
-		expenseReqRepo: expenseReqRepo,
-		balanceRepo:    balanceRepo,
-		userRepo:       userRepo,
-		db:             db,
+		expenseReqRepo:    expenseReqRepo,
+		balanceRepo:       balanceRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		userRepo:          userRepo,
+		db:                db,
 	}
 }
 
 // GetPendingExpenseRequests retrieves pending expense requests based on role
 func (s *ExpenseApprovalService) GetPendingExpenseRequests(ctx context.Context, role string, approverID int64, limit, offset int) ([]map[string]interface{}, int, error) {
 	if role == constants.RoleManager {
-		return s.expenseReqRepo.GetPendingForManager(ctx, approverID, limit, offset)
+		// delegates also see the queues of the managers they stand in for
+		approverIDs, err := s.delegationService.GetApproverIDs(ctx, approverID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return s.expenseReqRepo.GetPendingForManager(ctx, approverIDs, limit, offset)
 	} else if role == constants.RoleAdmin {
 		return s.expenseReqRepo.GetPendingForAdmin(ctx, limit, offset)
 	} else {
@@ -238,13 +292,37 @@ func (s *ExpenseApprovalService) ApproveExpense(
 		return err
 	}
 
+	// approvers standing in for a delegator decide as the delegator
+	actor, err := s.delegationService.ResolveActor(ctx, tx, role, approverID, expenseReq.EmployeeID, requesterRole, expenseReq.RoutedToRole, expenseReq.RoutedToUserID)
+	if err != nil {
+		return err
+	}
+
 	// validate role
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
+	if err := utils.ValidateRequestApprover(actor.Role, actor.UserID, requesterRole, expenseReq.RoutedToRole, expenseReq.RoutedToUserID); err != nil {
+		return err
+	}
+
+	// the request stays pending until the last step of its approval chain approves
+	final, err := s.chainService.ApproveStep(ctx, tx, "EXPENSE", requestID, approverID, actor.Delegation, comment)
+	if err != nil {
 		return err
 	}
+	if !final {
+		return tx.Commit(ctx)
+	}
 
-	// Deduct balance
-	err = s.balanceRepo.DeductExpenseBalance(ctx, tx, expenseReq.EmployeeID, expenseReq.Amount)
+	// the amount held while the request was pending is consumed
+	err = s.balanceRepo.ReleaseReservation(ctx, tx, expenseReq.EmployeeID, "EXPENSE", "", expenseReq.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = s.balanceRepo.DeductExpenseBalance(ctx, tx, expenseReq.EmployeeID, expenseReq.Amount, models.BalanceChange{
+		RequestID: &requestID,
+		ActorID:   &approverID,
+		Reason:    "Expense approved",
+	})
 	if err != nil {
 		return err
 	}
@@ -255,6 +333,11 @@ func (s *ExpenseApprovalService) ApproveExpense(
 		return err
 	}
 
+	err = s.delegationService.RecordDecision(ctx, tx, "EXPENSE", requestID, actor)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -301,8 +384,14 @@ func (s *ExpenseApprovalService) RejectExpense(
 		return err
 	}
 
+	// approvers standing in for a delegator decide as the delegator
+	actor, err := s.delegationService.ResolveActor(ctx, tx, role, approverID, expenseReq.EmployeeID, requesterRole, expenseReq.RoutedToRole, expenseReq.RoutedToUserID)
+	if err != nil {
+		return err
+	}
+
 	// 5. Validate approver role
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
+	if err := utils.ValidateRequestApprover(actor.Role, actor.UserID, requesterRole, expenseReq.RoutedToRole, expenseReq.RoutedToUserID); err != nil {
 		return err
 	}
 
@@ -311,11 +400,26 @@ func (s *ExpenseApprovalService) RejectExpense(
 		comment = "Rejected"
 	}
 
+	err = s.chainService.RejectStep(ctx, tx, "EXPENSE", requestID, approverID, actor.Delegation, comment)
+	if err != nil {
+		return err
+	}
+
 	// 7. Update request
 	err = s.expenseReqRepo.UpdateStatus(ctx, tx, requestID, "REJECTED", approverID, comment)
 	if err != nil {
 		return err
 	}
 
+	err = s.balanceRepo.ReleaseReservation(ctx, tx, expenseReq.EmployeeID, "EXPENSE", "", expenseReq.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = s.delegationService.RecordDecision(ctx, tx, "EXPENSE", requestID, actor)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
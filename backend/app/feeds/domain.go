@@ -0,0 +1,14 @@
+package feeds
+
+import "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+type FeedTokenRequest struct {
+	Scope string `json:"scope"`
+}
+
+// FeedTokenResponse is a new feed token with the paths of the feeds it opens
+type FeedTokenResponse struct {
+	*models.FeedToken
+	LeaveFeed   string `json:"leave_feed"`
+	HolidayFeed string `json:"holiday_feed"`
+}
@@ -0,0 +1,124 @@
+package feeds
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+const icalContentType = "text/calendar; charset=utf-8"
+
+// handles calendar feed token and iCalendar feed HTTP requests
+type FeedHandler struct {
+	feedService interfaces.FeedService
+}
+
+// creates a new FeedHandler instance
+func NewFeedHandler(ctx context.Context, feedService interfaces.FeedService) *FeedHandler {
+	return &FeedHandler{feedService: feedService}
+}
+
+func (h *FeedHandler) CreateFeedToken(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	var req FeedTokenRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			handleFeedError(c, apperrors.ErrInvalidInput)
+			return
+		}
+	}
+
+	ctx := c.Request.Context()
+	token, err := h.feedService.CreateFeedToken(ctx, role, userID, strings.ToUpper(req.Scope))
+	if err != nil {
+		handleFeedError(c, err)
+		return
+	}
+
+	response.Created(c, "Feed token created successfully", FeedTokenResponse{
+		FeedToken:   token,
+		LeaveFeed:   "/api/feeds/" + token.Token + "/leaves.ics",
+		HolidayFeed: "/api/feeds/" + token.Token + "/holidays.ics",
+	})
+}
+
+func (h *FeedHandler) GetFeedTokens(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+
+	ctx := c.Request.Context()
+	tokens, err := h.feedService.GetFeedTokens(ctx, userID)
+	if err != nil {
+		handleFeedError(c, err)
+		return
+	}
+
+	response.Success(c, "Feed tokens fetched successfully", tokens)
+}
+
+func (h *FeedHandler) RevokeFeedToken(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	tokenID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleFeedError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.feedService.RevokeFeedToken(ctx, role, userID, tokenID); err != nil {
+		handleFeedError(c, err)
+		return
+	}
+
+	response.Success(c, "Feed token revoked successfully", nil)
+}
+
+// GetLeaveFeed serves the approved leave feed of the token in the path; calendar apps
+// subscribe to it without signing in
+func (h *FeedHandler) GetLeaveFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	feed, err := h.feedService.GetLeaveFeed(ctx, c.Param("token"))
+	if err != nil {
+		handleFeedError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, icalContentType, feed)
+}
+
+// GetHolidayFeed serves the holiday feed of the token in the path
+func (h *FeedHandler) GetHolidayFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	feed, err := h.feedService.GetHolidayFeed(ctx, c.Param("token"))
+	if err != nil {
+		handleFeedError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, icalContentType, feed)
+}
+
+func handleFeedError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrTeamFeedNotAllowed:
+		status = http.StatusForbidden
+	case apperrors.ErrFeedTokenNotFound, apperrors.ErrUserNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidInput, apperrors.ErrInvalidID, apperrors.ErrInvalidFeedScope:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,371 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	time "time"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedRepository is an autogenerated mock type for the FeedRepository type
+type FeedRepository struct {
+	mock.Mock
+}
+
+type FeedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedRepository) EXPECT() *FeedRepository_Expecter {
+	return &FeedRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, token
+func (_m *FeedRepository) Create(ctx context.Context, token *models.FeedToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.FeedToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type FeedRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *models.FeedToken
+func (_e *FeedRepository_Expecter) Create(ctx interface{}, token interface{}) *FeedRepository_Create_Call {
+	return &FeedRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *FeedRepository_Create_Call) Run(run func(ctx context.Context, token *models.FeedToken)) *FeedRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.FeedToken))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_Create_Call) Return(_a0 error) *FeedRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedRepository_Create_Call) RunAndReturn(run func(context.Context, *models.FeedToken) error) *FeedRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApprovedLeaves provides a mock function with given fields: ctx, userID, team, since
+func (_m *FeedRepository) GetApprovedLeaves(ctx context.Context, userID int64, team bool, since time.Time) ([]models.FeedLeave, error) {
+	ret := _m.Called(ctx, userID, team, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApprovedLeaves")
+	}
+
+	var r0 []models.FeedLeave
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool, time.Time) ([]models.FeedLeave, error)); ok {
+		return rf(ctx, userID, team, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool, time.Time) []models.FeedLeave); ok {
+		r0 = rf(ctx, userID, team, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FeedLeave)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, bool, time.Time) error); ok {
+		r1 = rf(ctx, userID, team, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_GetApprovedLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApprovedLeaves'
+type FeedRepository_GetApprovedLeaves_Call struct {
+	*mock.Call
+}
+
+// GetApprovedLeaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - team bool
+//   - since time.Time
+func (_e *FeedRepository_Expecter) GetApprovedLeaves(ctx interface{}, userID interface{}, team interface{}, since interface{}) *FeedRepository_GetApprovedLeaves_Call {
+	return &FeedRepository_GetApprovedLeaves_Call{Call: _e.mock.On("GetApprovedLeaves", ctx, userID, team, since)}
+}
+
+func (_c *FeedRepository_GetApprovedLeaves_Call) Run(run func(ctx context.Context, userID int64, team bool, since time.Time)) *FeedRepository_GetApprovedLeaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(bool), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_GetApprovedLeaves_Call) Return(_a0 []models.FeedLeave, _a1 error) *FeedRepository_GetApprovedLeaves_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_GetApprovedLeaves_Call) RunAndReturn(run func(context.Context, int64, bool, time.Time) ([]models.FeedLeave, error)) *FeedRepository_GetApprovedLeaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, tokenID
+func (_m *FeedRepository) GetByID(ctx context.Context, tokenID int64) (*models.FeedToken, error) {
+	ret := _m.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.FeedToken, error)); ok {
+		return rf(ctx, tokenID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.FeedToken); ok {
+		r0 = rf(ctx, tokenID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type FeedRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID int64
+func (_e *FeedRepository_Expecter) GetByID(ctx interface{}, tokenID interface{}) *FeedRepository_GetByID_Call {
+	return &FeedRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, tokenID)}
+}
+
+func (_c *FeedRepository_GetByID_Call) Run(run func(ctx context.Context, tokenID int64)) *FeedRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_GetByID_Call) Return(_a0 *models.FeedToken, _a1 error) *FeedRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64) (*models.FeedToken, error)) *FeedRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUser provides a mock function with given fields: ctx, userID
+func (_m *FeedRepository) GetForUser(ctx context.Context, userID int64) ([]models.FeedToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUser")
+	}
+
+	var r0 []models.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.FeedToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.FeedToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_GetForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUser'
+type FeedRepository_GetForUser_Call struct {
+	*mock.Call
+}
+
+// GetForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *FeedRepository_Expecter) GetForUser(ctx interface{}, userID interface{}) *FeedRepository_GetForUser_Call {
+	return &FeedRepository_GetForUser_Call{Call: _e.mock.On("GetForUser", ctx, userID)}
+}
+
+func (_c *FeedRepository_GetForUser_Call) Run(run func(ctx context.Context, userID int64)) *FeedRepository_GetForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_GetForUser_Call) Return(_a0 []models.FeedToken, _a1 error) *FeedRepository_GetForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_GetForUser_Call) RunAndReturn(run func(context.Context, int64) ([]models.FeedToken, error)) *FeedRepository_GetForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, tokenID
+func (_m *FeedRepository) Revoke(ctx context.Context, tokenID int64) error {
+	ret := _m.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type FeedRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID int64
+func (_e *FeedRepository_Expecter) Revoke(ctx interface{}, tokenID interface{}) *FeedRepository_Revoke_Call {
+	return &FeedRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, tokenID)}
+}
+
+func (_c *FeedRepository_Revoke_Call) Run(run func(ctx context.Context, tokenID int64)) *FeedRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_Revoke_Call) Return(_a0 error) *FeedRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedRepository_Revoke_Call) RunAndReturn(run func(context.Context, int64) error) *FeedRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Use provides a mock function with given fields: ctx, tokenHash
+func (_m *FeedRepository) Use(ctx context.Context, tokenHash string) (*models.FeedToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Use")
+	}
+
+	var r0 *models.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.FeedToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.FeedToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_Use_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Use'
+type FeedRepository_Use_Call struct {
+	*mock.Call
+}
+
+// Use is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *FeedRepository_Expecter) Use(ctx interface{}, tokenHash interface{}) *FeedRepository_Use_Call {
+	return &FeedRepository_Use_Call{Call: _e.mock.On("Use", ctx, tokenHash)}
+}
+
+func (_c *FeedRepository_Use_Call) Run(run func(ctx context.Context, tokenHash string)) *FeedRepository_Use_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_Use_Call) Return(_a0 *models.FeedToken, _a1 error) *FeedRepository_Use_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_Use_Call) RunAndReturn(run func(context.Context, string) (*models.FeedToken, error)) *FeedRepository_Use_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeedRepository creates a new instance of FeedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedRepository {
+	mock := &FeedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,324 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedService is an autogenerated mock type for the FeedService type
+type FeedService struct {
+	mock.Mock
+}
+
+type FeedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedService) EXPECT() *FeedService_Expecter {
+	return &FeedService_Expecter{mock: &_m.Mock}
+}
+
+// CreateFeedToken provides a mock function with given fields: ctx, role, userID, scope
+func (_m *FeedService) CreateFeedToken(ctx context.Context, role string, userID int64, scope string) (*models.FeedToken, error) {
+	ret := _m.Called(ctx, role, userID, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeedToken")
+	}
+
+	var r0 *models.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) (*models.FeedToken, error)); ok {
+		return rf(ctx, role, userID, scope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) *models.FeedToken); ok {
+		r0 = rf(ctx, role, userID, scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string) error); ok {
+		r1 = rf(ctx, role, userID, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_CreateFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeedToken'
+type FeedService_CreateFeedToken_Call struct {
+	*mock.Call
+}
+
+// CreateFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - scope string
+func (_e *FeedService_Expecter) CreateFeedToken(ctx interface{}, role interface{}, userID interface{}, scope interface{}) *FeedService_CreateFeedToken_Call {
+	return &FeedService_CreateFeedToken_Call{Call: _e.mock.On("CreateFeedToken", ctx, role, userID, scope)}
+}
+
+func (_c *FeedService_CreateFeedToken_Call) Run(run func(ctx context.Context, role string, userID int64, scope string)) *FeedService_CreateFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *FeedService_CreateFeedToken_Call) Return(_a0 *models.FeedToken, _a1 error) *FeedService_CreateFeedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_CreateFeedToken_Call) RunAndReturn(run func(context.Context, string, int64, string) (*models.FeedToken, error)) *FeedService_CreateFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeedTokens provides a mock function with given fields: ctx, userID
+func (_m *FeedService) GetFeedTokens(ctx context.Context, userID int64) ([]models.FeedToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedTokens")
+	}
+
+	var r0 []models.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.FeedToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.FeedToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_GetFeedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeedTokens'
+type FeedService_GetFeedTokens_Call struct {
+	*mock.Call
+}
+
+// GetFeedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *FeedService_Expecter) GetFeedTokens(ctx interface{}, userID interface{}) *FeedService_GetFeedTokens_Call {
+	return &FeedService_GetFeedTokens_Call{Call: _e.mock.On("GetFeedTokens", ctx, userID)}
+}
+
+func (_c *FeedService_GetFeedTokens_Call) Run(run func(ctx context.Context, userID int64)) *FeedService_GetFeedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeedService_GetFeedTokens_Call) Return(_a0 []models.FeedToken, _a1 error) *FeedService_GetFeedTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_GetFeedTokens_Call) RunAndReturn(run func(context.Context, int64) ([]models.FeedToken, error)) *FeedService_GetFeedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHolidayFeed provides a mock function with given fields: ctx, token
+func (_m *FeedService) GetHolidayFeed(ctx context.Context, token string) ([]byte, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidayFeed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_GetHolidayFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidayFeed'
+type FeedService_GetHolidayFeed_Call struct {
+	*mock.Call
+}
+
+// GetHolidayFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *FeedService_Expecter) GetHolidayFeed(ctx interface{}, token interface{}) *FeedService_GetHolidayFeed_Call {
+	return &FeedService_GetHolidayFeed_Call{Call: _e.mock.On("GetHolidayFeed", ctx, token)}
+}
+
+func (_c *FeedService_GetHolidayFeed_Call) Run(run func(ctx context.Context, token string)) *FeedService_GetHolidayFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FeedService_GetHolidayFeed_Call) Return(_a0 []byte, _a1 error) *FeedService_GetHolidayFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_GetHolidayFeed_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *FeedService_GetHolidayFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaveFeed provides a mock function with given fields: ctx, token
+func (_m *FeedService) GetLeaveFeed(ctx context.Context, token string) ([]byte, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveFeed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_GetLeaveFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveFeed'
+type FeedService_GetLeaveFeed_Call struct {
+	*mock.Call
+}
+
+// GetLeaveFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *FeedService_Expecter) GetLeaveFeed(ctx interface{}, token interface{}) *FeedService_GetLeaveFeed_Call {
+	return &FeedService_GetLeaveFeed_Call{Call: _e.mock.On("GetLeaveFeed", ctx, token)}
+}
+
+func (_c *FeedService_GetLeaveFeed_Call) Run(run func(ctx context.Context, token string)) *FeedService_GetLeaveFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FeedService_GetLeaveFeed_Call) Return(_a0 []byte, _a1 error) *FeedService_GetLeaveFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_GetLeaveFeed_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *FeedService_GetLeaveFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFeedToken provides a mock function with given fields: ctx, role, userID, tokenID
+func (_m *FeedService) RevokeFeedToken(ctx context.Context, role string, userID int64, tokenID int64) error {
+	ret := _m.Called(ctx, role, userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFeedToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) error); ok {
+		r0 = rf(ctx, role, userID, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedService_RevokeFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFeedToken'
+type FeedService_RevokeFeedToken_Call struct {
+	*mock.Call
+}
+
+// RevokeFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - tokenID int64
+func (_e *FeedService_Expecter) RevokeFeedToken(ctx interface{}, role interface{}, userID interface{}, tokenID interface{}) *FeedService_RevokeFeedToken_Call {
+	return &FeedService_RevokeFeedToken_Call{Call: _e.mock.On("RevokeFeedToken", ctx, role, userID, tokenID)}
+}
+
+func (_c *FeedService_RevokeFeedToken_Call) Run(run func(ctx context.Context, role string, userID int64, tokenID int64)) *FeedService_RevokeFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *FeedService_RevokeFeedToken_Call) Return(_a0 error) *FeedService_RevokeFeedToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedService_RevokeFeedToken_Call) RunAndReturn(run func(context.Context, string, int64, int64) error) *FeedService_RevokeFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeedService creates a new instance of FeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedService {
+	mock := &FeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,147 @@
+package feeds
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// how far back and ahead of today the feeds reach
+const (
+	feedHistoryYears = 1
+	feedAheadYears   = 2
+)
+
+// FeedService manages the tokens calendar apps subscribe with and serves the iCalendar
+// feeds of approved leave and holidays behind them
+type FeedService struct {
+	feedRepo    interfaces.FeedRepository
+	holidayRepo interfaces.HolidayRepository
+}
+
+// NewFeedService creates a new instance of FeedService
+func NewFeedService(ctx context.Context, feedRepo interfaces.FeedRepository, holidayRepo interfaces.HolidayRepository) interfaces.FeedService {
+	return &FeedService{
+		feedRepo:    feedRepo,
+		holidayRepo: holidayRepo,
+	}
+}
+
+// CreateFeedToken gives the user a new feed token, SELF unless another scope is asked for.
+// Only managers and admins, who have reports, can subscribe to a TEAM feed. The token is
+// returned this once; only its hash is kept.
+func (s *FeedService) CreateFeedToken(ctx context.Context, role string, userID int64, scope string) (*models.FeedToken, error) {
+	switch scope {
+	case "":
+		scope = constants.FeedScopeSelf
+	case constants.FeedScopeSelf:
+	case constants.FeedScopeTeam:
+		if role == constants.RoleEmployee {
+			return nil, apperrors.ErrTeamFeedNotAllowed
+		}
+	default:
+		return nil, apperrors.ErrInvalidFeedScope
+	}
+
+	token, hash, err := utils.GenerateFeedToken()
+	if err != nil {
+		return nil, apperrors.ErrInternalServer
+	}
+
+	feedToken := &models.FeedToken{UserID: userID, Scope: scope, TokenHash: hash}
+	if err := s.feedRepo.Create(ctx, feedToken); err != nil {
+		return nil, err
+	}
+
+	feedToken.Token = token
+	return feedToken, nil
+}
+
+// GetFeedTokens lists the user's tokens that are not revoked
+func (s *FeedService) GetFeedTokens(ctx context.Context, userID int64) ([]models.FeedToken, error) {
+	return s.feedRepo.GetForUser(ctx, userID)
+}
+
+// RevokeFeedToken stops a token from opening its feeds; only its owner or an admin may
+// revoke it
+func (s *FeedService) RevokeFeedToken(ctx context.Context, role string, userID, tokenID int64) error {
+	token, err := s.feedRepo.GetByID(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if role != constants.RoleAdmin && token.UserID != userID {
+		return apperrors.ErrUnauthorized
+	}
+
+	return s.feedRepo.Revoke(ctx, tokenID)
+}
+
+// GetLeaveFeed builds the iCalendar feed of the approved leave a token shows: its owner's,
+// and their direct reports' for a team token, from a year back on. A team token whose
+// owner is no longer a manager or admin is revoked instead.
+func (s *FeedService) GetLeaveFeed(ctx context.Context, token string) ([]byte, error) {
+	feedToken, err := s.feedRepo.Use(ctx, utils.HashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	team := feedToken.Scope == constants.FeedScopeTeam
+	if team && feedToken.OwnerRole == constants.RoleEmployee {
+		if err := s.feedRepo.Revoke(ctx, feedToken.ID); err != nil {
+			return nil, err
+		}
+		return nil, apperrors.ErrTeamFeedNotAllowed
+	}
+
+	since := today().AddDate(-feedHistoryYears, 0, 0)
+	leaves, err := s.feedRepo.GetApprovedLeaves(ctx, feedToken.UserID, team, since)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]models.ICalEvent, len(leaves))
+	for i, leave := range leaves {
+		events[i] = utils.LeaveEvent(leave)
+	}
+
+	name := "My leave"
+	if team {
+		name = "Team leave"
+	}
+	return utils.BuildICal(name, events), nil
+}
+
+// GetHolidayFeed builds the iCalendar feed of the holidays of the token owner's work
+// calendar, from a year back to two years ahead
+func (s *FeedService) GetHolidayFeed(ctx context.Context, token string) ([]byte, error) {
+	feedToken, err := s.feedRepo.Use(ctx, utils.HashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	from := today().AddDate(-feedHistoryYears, 0, 0)
+	to := today().AddDate(feedAheadYears, 0, 0)
+	calendar, err := s.holidayRepo.GetUserWorkCalendar(ctx, feedToken.UserID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []models.ICalEvent{}
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if description, ok := calendar.Holidays[d.Format("2006-01-02")]; ok {
+			events = append(events, utils.HolidayEvent(calendar.CalendarID, d, description))
+		}
+	}
+
+	return utils.BuildICal("Holidays", events), nil
+}
+
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/feeds"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/feeds/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFeedService_CreateFeedToken(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		role          string
+		scope         string
+		expectedScope string
+		expectedError error
+	}{
+		{name: "Defaults To Self", role: constants.RoleEmployee, scope: "", expectedScope: constants.FeedScopeSelf},
+		{name: "Manager Team Feed", role: constants.RoleManager, scope: constants.FeedScopeTeam, expectedScope: constants.FeedScopeTeam},
+		{name: "Employee Team Feed", role: constants.RoleEmployee, scope: constants.FeedScopeTeam, expectedError: apperrors.ErrTeamFeedNotAllowed},
+		{name: "Unknown Scope", role: constants.RoleAdmin, scope: "COMPANY", expectedError: apperrors.ErrInvalidFeedScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedRepo := mocks.NewFeedRepository(t)
+			if tt.expectedError == nil {
+				feedRepo.EXPECT().Create(ctx, mock.AnythingOfType("*models.FeedToken")).
+					Run(func(_ context.Context, token *models.FeedToken) { token.ID = 3 }).
+					Return(nil)
+			}
+
+			service := feeds.NewFeedService(ctx, feedRepo, mocks.NewHolidayRepository(t))
+			token, err := service.CreateFeedToken(ctx, tt.role, 10, tt.scope)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), token.ID)
+			assert.Equal(t, int64(10), token.UserID)
+			assert.Equal(t, tt.expectedScope, token.Scope)
+			// only the hash of the token is stored
+			assert.NotEmpty(t, token.Token)
+			assert.Equal(t, utils.HashFeedToken(token.Token), token.TokenHash)
+		})
+	}
+}
+
+func TestFeedService_RevokeFeedToken(t *testing.T) {
+	ctx := context.Background()
+
+	feedRepo := mocks.NewFeedRepository(t)
+	service := feeds.NewFeedService(ctx, feedRepo, mocks.NewHolidayRepository(t))
+
+	feedRepo.EXPECT().GetByID(ctx, int64(9)).Return(nil, apperrors.ErrFeedTokenNotFound)
+	assert.ErrorIs(t, service.RevokeFeedToken(ctx, constants.RoleEmployee, 10, 9), apperrors.ErrFeedTokenNotFound)
+
+	feedRepo.EXPECT().GetByID(ctx, int64(3)).Return(&models.FeedToken{ID: 3, UserID: 10}, nil)
+	assert.ErrorIs(t, service.RevokeFeedToken(ctx, constants.RoleEmployee, 11, 3), apperrors.ErrUnauthorized)
+
+	feedRepo.EXPECT().Revoke(ctx, int64(3)).Return(nil).Twice()
+	assert.NoError(t, service.RevokeFeedToken(ctx, constants.RoleEmployee, 10, 3))
+	assert.NoError(t, service.RevokeFeedToken(ctx, constants.RoleAdmin, 1, 3))
+}
+
+func TestFeedService_GetLeaveFeed(t *testing.T) {
+	ctx := context.Background()
+	hash := utils.HashFeedToken("secret")
+
+	t.Run("Revoked Token", func(t *testing.T) {
+		feedRepo := mocks.NewFeedRepository(t)
+		feedRepo.EXPECT().Use(ctx, hash).Return(nil, apperrors.ErrFeedTokenNotFound)
+
+		service := feeds.NewFeedService(ctx, feedRepo, mocks.NewHolidayRepository(t))
+		_, err := service.GetLeaveFeed(ctx, "secret")
+		assert.ErrorIs(t, err, apperrors.ErrFeedTokenNotFound)
+	})
+
+	t.Run("Team Token Of Demoted Owner", func(t *testing.T) {
+		feedRepo := mocks.NewFeedRepository(t)
+		feedRepo.EXPECT().Use(ctx, hash).Return(&models.FeedToken{ID: 3, UserID: 10, Scope: constants.FeedScopeTeam, OwnerRole: constants.RoleEmployee}, nil)
+		feedRepo.EXPECT().Revoke(ctx, int64(3)).Return(nil)
+
+		service := feeds.NewFeedService(ctx, feedRepo, mocks.NewHolidayRepository(t))
+		_, err := service.GetLeaveFeed(ctx, "secret")
+		assert.ErrorIs(t, err, apperrors.ErrTeamFeedNotAllowed)
+	})
+
+	t.Run("Team Feed", func(t *testing.T) {
+		feedRepo := mocks.NewFeedRepository(t)
+		feedRepo.EXPECT().Use(ctx, hash).Return(&models.FeedToken{ID: 3, UserID: 10, Scope: constants.FeedScopeTeam, OwnerRole: constants.RoleManager}, nil)
+		feedRepo.EXPECT().GetApprovedLeaves(ctx, int64(10), true, mock.AnythingOfType("time.Time")).Return([]models.FeedLeave{
+			{
+				ID: 41, EmployeeName: "Ravi", LeaveType: "CASUAL",
+				FromDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), ToDate: time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC),
+				FromSession: constants.SessionFull, ToSession: constants.SessionFull, Days: 2,
+			},
+		}, nil)
+
+		service := feeds.NewFeedService(ctx, feedRepo, mocks.NewHolidayRepository(t))
+		feed, err := service.GetLeaveFeed(ctx, "secret")
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(feed), "X-WR-CALNAME:Team leave\r\n")
+		assert.Contains(t, string(feed), "UID:leave-41@rule-based-approval-engine\r\n")
+		assert.Contains(t, string(feed), "DTSTART;VALUE=DATE:20261102\r\nDTEND;VALUE=DATE:20261104\r\n")
+		assert.Contains(t, string(feed), "SUMMARY:Ravi - CASUAL leave\r\n")
+	})
+}
+
+func TestFeedService_GetHolidayFeed(t *testing.T) {
+	ctx := context.Background()
+	holiday := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+
+	feedRepo := mocks.NewFeedRepository(t)
+	holidayRepo := mocks.NewHolidayRepository(t)
+	feedRepo.EXPECT().Use(ctx, utils.HashFeedToken("secret")).Return(&models.FeedToken{ID: 3, UserID: 10, Scope: constants.FeedScopeSelf}, nil)
+	holidayRepo.EXPECT().GetUserWorkCalendar(ctx, int64(10), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+		Return(models.WorkCalendar{CalendarID: 2, Holidays: map[string]string{holiday.Format("2006-01-02"): "Founders Day"}}, nil)
+
+	service := feeds.NewFeedService(ctx, feedRepo, holidayRepo)
+	feed, err := service.GetHolidayFeed(ctx, "secret")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(feed), "BEGIN:VEVENT"))
+	assert.Contains(t, string(feed), "UID:holiday-2-"+holiday.Format("20060102")+"@rule-based-approval-engine\r\n")
+	assert.Contains(t, string(feed), "SUMMARY:Founders Day\r\n")
+}
@@ -1,6 +1,20 @@
 package holidays
 
 type HolidayRequest struct {
+	CalendarID  int64  `json:"calendar_id"`
 	Date        string `json:"date"`
 	Description string `json:"description"`
 }
+
+type WorkWeekRequest struct {
+	WorkingDays []string `json:"working_days"`
+}
+
+type CalendarRequest struct {
+	Name        string   `json:"name"`
+	WorkingDays []string `json:"working_days"`
+}
+
+type CalendarUsersRequest struct {
+	UserIDs []int64 `json:"user_ids"`
+}
@@ -2,8 +2,10 @@ package holidays
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -38,7 +40,7 @@ func (h *HolidayHandler) AddHoliday(c *gin.Context) {
 	}
 
 	ctx := c.Request.Context()
-	err = h.holidayService.AddHoliday(ctx, role, adminID, date, req.Description)
+	err = h.holidayService.AddHoliday(ctx, role, adminID, req.CalendarID, date, req.Description)
 	if err != nil {
 		handleHolidayError(c, err)
 		return
@@ -49,9 +51,15 @@ func (h *HolidayHandler) AddHoliday(c *gin.Context) {
 
 func (h *HolidayHandler) GetHolidays(c *gin.Context) {
 	role := c.GetString("role")
-	ctx := c.Request.Context()
 
-	holidays, err := h.holidayService.GetHolidays(ctx, role)
+	calendarID, err := calendarIDQuery(c)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	holidays, err := h.holidayService.GetHolidays(ctx, role, calendarID)
 	if err != nil {
 		handleHolidayError(c, err)
 		return
@@ -83,13 +91,244 @@ func (h *HolidayHandler) DeleteHoliday(c *gin.Context) {
 	response.Success(c, "holiday removed successfully", nil)
 }
 
+func (h *HolidayHandler) GetWorkWeek(c *gin.Context) {
+	calendarID, err := calendarIDQuery(c)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	days, err := h.holidayService.GetWorkWeek(ctx, calendarID)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Success(c, "work week fetched successfully", gin.H{"working_days": days})
+}
+
+func (h *HolidayHandler) SetWorkWeek(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+
+	calendarID, err := calendarIDQuery(c)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	var req WorkWeekRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidInput)
+		return
+	}
+
+	ctx := c.Request.Context()
+	days, err := h.holidayService.SetWorkWeek(ctx, role, adminID, calendarID, req.WorkingDays)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Success(c, "work week updated successfully", gin.H{"working_days": days})
+}
+
+func (h *HolidayHandler) GetCalendars(c *gin.Context) {
+	role := c.GetString("role")
+	ctx := c.Request.Context()
+
+	calendars, err := h.holidayService.GetCalendars(ctx, role)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Success(c, "calendars fetched successfully", calendars)
+}
+
+func (h *HolidayHandler) CreateCalendar(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+
+	var req CalendarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidInput)
+		return
+	}
+
+	ctx := c.Request.Context()
+	calendar, err := h.holidayService.CreateCalendar(ctx, role, adminID, req.Name, req.WorkingDays)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Created(c, "calendar created successfully", calendar)
+}
+
+func (h *HolidayHandler) UpdateCalendar(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req CalendarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidInput)
+		return
+	}
+
+	ctx := c.Request.Context()
+	calendar, err := h.holidayService.UpdateCalendar(ctx, role, adminID, id, req.Name, req.WorkingDays)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Success(c, "calendar updated successfully", calendar)
+}
+
+func (h *HolidayHandler) DeleteCalendar(c *gin.Context) {
+	role := c.GetString("role")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.holidayService.DeleteCalendar(ctx, role, id); err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Success(c, "calendar deleted successfully", nil)
+}
+
+func (h *HolidayHandler) AssignCalendar(c *gin.Context) {
+	role := c.GetString("role")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req CalendarUsersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleHolidayError(c, apperrors.ErrInvalidInput)
+		return
+	}
+
+	ctx := c.Request.Context()
+	assigned, err := h.holidayService.AssignCalendar(ctx, role, id, req.UserIDs)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	response.Success(c, "users assigned to calendar successfully", gin.H{"assigned": assigned})
+}
+
+// ImportHolidays adds the events of an iCalendar (.ics) file as holidays of a calendar,
+// the file uploaded as the "file" form field or sent as the request body (admin only)
+func (h *HolidayHandler) ImportHolidays(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+
+	calendarID, err := calendarIDQuery(c)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	var file io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			handleHolidayError(c, apperrors.ErrInvalidInput)
+			return
+		}
+		upload, err := header.Open()
+		if err != nil {
+			handleHolidayError(c, apperrors.ErrInvalidInput)
+			return
+		}
+		defer upload.Close()
+		file = upload
+	}
+
+	preview := c.Query("preview") == "true"
+
+	ctx := c.Request.Context()
+	report, err := h.holidayService.ImportHolidays(ctx, role, adminID, calendarID, file, preview)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	if preview {
+		response.Success(c, "holiday import preview generated", report)
+		return
+	}
+	response.Success(c, "holidays imported successfully", report)
+}
+
+// ExportHolidays downloads the holidays of a calendar as an iCalendar (.ics) file
+func (h *HolidayHandler) ExportHolidays(c *gin.Context) {
+	role := c.GetString("role")
+
+	calendarID, err := calendarIDQuery(c)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	feed, err := h.holidayService.ExportHolidays(ctx, role, calendarID)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="holidays.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", feed)
+}
+
+// calendarIDQuery reads the optional calendar_id query parameter; 0 is the default calendar
+func calendarIDQuery(c *gin.Context) (int64, error) {
+	raw := c.Query("calendar_id")
+	if raw == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, apperrors.ErrInvalidID
+	}
+	return id, nil
+}
+
 func handleHolidayError(c *gin.Context, err error) {
 	status := http.StatusInternalServerError
 
 	switch err {
 	case apperrors.ErrAdminOnly:
 		status = http.StatusForbidden
-	case apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidInput, apperrors.ErrInvalidID:
+	case apperrors.ErrCalendarNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrCalendarExists, apperrors.ErrDuplicateEntry:
+		status = http.StatusConflict
+	case apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidInput, apperrors.ErrInvalidID,
+		apperrors.ErrInvalidWorkWeek, apperrors.ErrCalendarNameRequired,
+		apperrors.ErrDefaultCalendarDelete, apperrors.ErrCalendarUsersRequired,
+		apperrors.ErrInvalidICalFile, apperrors.ErrICalEventTooLong:
 		status = http.StatusBadRequest
 	}
 
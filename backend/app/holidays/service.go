@@ -2,19 +2,29 @@ package holidays
 
 import (
 	"context"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
+// holiday descriptions are stored in at most this many characters
+const maxHolidayDescription = 100
+
+// HolidayService manages the work calendars: their holidays, working weeks and the users
+// following them. A calendar id of 0 means the default calendar.
 type HolidayService struct {
 	holidayRepo interfaces.HolidayRepository
+	db          interfaces.DB
 }
 
-func NewHolidayService(ctx context.Context, holidayRepo interfaces.HolidayRepository) interfaces.HolidayService {
-	return &HolidayService{holidayRepo: holidayRepo}
+func NewHolidayService(ctx context.Context, holidayRepo interfaces.HolidayRepository, db interfaces.DB) interfaces.HolidayService {
+	return &HolidayService{holidayRepo: holidayRepo, db: db}
 }
 
 func (s *HolidayService) ensureAdmin(role string) error {
@@ -24,18 +34,36 @@ func (s *HolidayService) ensureAdmin(role string) error {
 	return nil
 }
 
-func (s *HolidayService) AddHoliday(ctx context.Context, role string, adminID int64, date time.Time, desc string) error {
+// getCalendar returns the calendar with the id, or the default calendar for 0
+func (s *HolidayService) getCalendar(ctx context.Context, calendarID int64) (*models.Calendar, error) {
+	if calendarID == 0 {
+		return s.holidayRepo.GetDefaultCalendar(ctx)
+	}
+	return s.holidayRepo.GetCalendar(ctx, calendarID)
+}
+
+func (s *HolidayService) AddHoliday(ctx context.Context, role string, adminID, calendarID int64, date time.Time, desc string) error {
 	if err := s.ensureAdmin(role); err != nil {
 		return err
 	}
-	return s.holidayRepo.AddHoliday(ctx, date, desc, adminID)
+
+	calendar, err := s.getCalendar(ctx, calendarID)
+	if err != nil {
+		return err
+	}
+	return s.holidayRepo.AddHoliday(ctx, calendar.ID, date, desc, adminID)
 }
 
-func (s *HolidayService) GetHolidays(ctx context.Context, role string) ([]map[string]interface{}, error) {
+func (s *HolidayService) GetHolidays(ctx context.Context, role string, calendarID int64) ([]map[string]interface{}, error) {
 	if err := s.ensureAdmin(role); err != nil {
 		return nil, err
 	}
-	return s.holidayRepo.GetHolidays(ctx)
+
+	calendar, err := s.getCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	return s.holidayRepo.GetHolidays(ctx, calendar.ID)
 }
 
 func (s *HolidayService) DeleteHoliday(ctx context.Context, role string, holidayID int64) error {
@@ -44,3 +72,256 @@ func (s *HolidayService) DeleteHoliday(ctx context.Context, role string, holiday
 	}
 	return s.holidayRepo.DeleteHoliday(ctx, holidayID)
 }
+
+// GetWorkWeek lists the working days of a calendar by name, Sunday first
+func (s *HolidayService) GetWorkWeek(ctx context.Context, calendarID int64) ([]string, error) {
+	calendar, err := s.getCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	return calendar.WorkingDays, nil
+}
+
+// SetWorkWeek sets the working days of a calendar by name (admin only). Leave applied
+// for from then on is charged for the working days it covers.
+func (s *HolidayService) SetWorkWeek(ctx context.Context, role string, adminID, calendarID int64, workingDays []string) ([]string, error) {
+	if err := s.ensureAdmin(role); err != nil {
+		return nil, err
+	}
+
+	days, err := utils.ParseWorkWeek(workingDays)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.getCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.holidayRepo.SetWorkWeek(ctx, tx, calendar.ID, days, adminID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return weekdayNames(days), nil
+}
+
+// GetCalendars lists the calendars with their work week and user count (admin only)
+func (s *HolidayService) GetCalendars(ctx context.Context, role string) ([]models.Calendar, error) {
+	if err := s.ensureAdmin(role); err != nil {
+		return nil, err
+	}
+	return s.holidayRepo.GetCalendars(ctx)
+}
+
+// CreateCalendar adds a named calendar with its work week (admin only). It has no
+// holidays and no users until they are added.
+func (s *HolidayService) CreateCalendar(ctx context.Context, role string, adminID int64, name string, workingDays []string) (*models.Calendar, error) {
+	return s.saveCalendar(ctx, role, adminID, &models.Calendar{Name: name}, workingDays)
+}
+
+// UpdateCalendar renames a calendar and replaces its work week (admin only)
+func (s *HolidayService) UpdateCalendar(ctx context.Context, role string, adminID, calendarID int64, name string, workingDays []string) (*models.Calendar, error) {
+	return s.saveCalendar(ctx, role, adminID, &models.Calendar{ID: calendarID, Name: name}, workingDays)
+}
+
+// saveCalendar creates the calendar, or updates it when it has an id, and sets its work week
+func (s *HolidayService) saveCalendar(ctx context.Context, role string, adminID int64, calendar *models.Calendar, workingDays []string) (*models.Calendar, error) {
+	if err := s.ensureAdmin(role); err != nil {
+		return nil, err
+	}
+
+	calendar.Name = strings.TrimSpace(calendar.Name)
+	if calendar.Name == "" {
+		return nil, apperrors.ErrCalendarNameRequired
+	}
+
+	days, err := utils.ParseWorkWeek(workingDays)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	calendar.UpdatedBy = &adminID
+	if calendar.ID == 0 {
+		err = s.holidayRepo.CreateCalendar(ctx, tx, calendar)
+	} else {
+		err = s.holidayRepo.UpdateCalendar(ctx, tx, calendar)
+	}
+	if err == apperrors.ErrDuplicateEntry {
+		return nil, apperrors.ErrCalendarExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.holidayRepo.SetWorkWeek(ctx, tx, calendar.ID, days, adminID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	calendar.WorkingDays = weekdayNames(days)
+	return calendar, nil
+}
+
+// DeleteCalendar removes a calendar with its holidays (admin only). Its users follow the
+// default calendar from then on; the default calendar itself cannot be deleted.
+func (s *HolidayService) DeleteCalendar(ctx context.Context, role string, calendarID int64) error {
+	if err := s.ensureAdmin(role); err != nil {
+		return err
+	}
+
+	calendar, err := s.holidayRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return err
+	}
+	if calendar.IsDefault {
+		return apperrors.ErrDefaultCalendarDelete
+	}
+
+	return s.holidayRepo.DeleteCalendar(ctx, calendarID)
+}
+
+// AssignCalendar moves users to a calendar and returns how many were moved (admin only).
+// Their leave, SLAs and reports use it from then on.
+func (s *HolidayService) AssignCalendar(ctx context.Context, role string, calendarID int64, userIDs []int64) (int64, error) {
+	if err := s.ensureAdmin(role); err != nil {
+		return 0, err
+	}
+
+	if len(userIDs) == 0 {
+		return 0, apperrors.ErrCalendarUsersRequired
+	}
+
+	if _, err := s.holidayRepo.GetCalendar(ctx, calendarID); err != nil {
+		return 0, err
+	}
+
+	return s.holidayRepo.AssignCalendar(ctx, calendarID, userIDs)
+}
+
+// ImportHolidays adds the dates of the events of an iCalendar file as holidays of a
+// calendar, each described by its event's summary, in one transaction (admin only). A
+// date the calendar already has takes the new description; a date of several events keeps
+// the first. Events of more than 31 days are refused. A preview reports the holidays and
+// rolls back.
+func (s *HolidayService) ImportHolidays(ctx context.Context, role string, adminID, calendarID int64, file io.Reader, preview bool) (*models.HolidayImport, error) {
+	if err := s.ensureAdmin(role); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.getCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := utils.ParseICalEvents(file)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.HolidayImport{
+		CalendarID: calendar.ID,
+		Preview:    preview,
+		Holidays:   []models.ImportedHoliday{},
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	seen := map[string]bool{}
+	for _, event := range events {
+		description := truncate(event.Summary, maxHolidayDescription)
+		for d := event.Start; !d.After(event.End); d = d.AddDate(0, 0, 1) {
+			date := d.Format("2006-01-02")
+			if seen[date] {
+				report.Skipped++
+				continue
+			}
+			seen[date] = true
+
+			if err := s.holidayRepo.UpsertHoliday(ctx, tx, calendar.ID, d, description, adminID); err != nil {
+				return nil, err
+			}
+			report.Holidays = append(report.Holidays, models.ImportedHoliday{Date: date, Description: description})
+		}
+	}
+
+	if preview {
+		return report, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+	report.Applied = true
+
+	return report, nil
+}
+
+// ExportHolidays writes the holidays of a calendar as an iCalendar file (admin only)
+func (s *HolidayService) ExportHolidays(ctx context.Context, role string, calendarID int64) ([]byte, error) {
+	if err := s.ensureAdmin(role); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.getCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := s.holidayRepo.GetHolidays(ctx, calendar.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]models.ICalEvent, 0, len(holidays))
+	for _, holiday := range holidays {
+		date, err := time.Parse("2006-01-02", holiday["date"].(string))
+		if err != nil {
+			return nil, apperrors.ErrInvalidDateFormat
+		}
+		description, _ := holiday["description"].(string)
+		events = append(events, utils.HolidayEvent(calendar.ID, date, description))
+	}
+
+	return utils.BuildICal(calendar.Name+" holidays", events), nil
+}
+
+// truncate cuts text to at most max characters
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max])
+}
+
+func weekdayNames(days []time.Weekday) []string {
+	names := make([]string, len(days))
+	for i, d := range days {
+		names[i] = d.String()
+	}
+	return names
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -38,10 +40,25 @@ func TestHolidayHandler_AddHoliday(t *testing.T) {
 			},
 			mockSetup: func(s *mocks.HolidayService) {
 				date, _ := time.Parse("2006-01-02", "2026-01-01")
-				s.EXPECT().AddHoliday(mock.Anything, "ADMIN", int64(1), date, "New Year").Return(nil)
+				s.EXPECT().AddHoliday(mock.Anything, "ADMIN", int64(1), int64(0), date, "New Year").Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
+		{
+			name:   "Unknown Calendar",
+			role:   "ADMIN",
+			userID: 1,
+			reqBody: holidays.HolidayRequest{
+				CalendarID:  9,
+				Date:        "2026-01-26",
+				Description: "Australia Day",
+			},
+			mockSetup: func(s *mocks.HolidayService) {
+				date, _ := time.Parse("2006-01-02", "2026-01-26")
+				s.EXPECT().AddHoliday(mock.Anything, "ADMIN", int64(1), int64(9), date, "Australia Day").Return(apperrors.ErrCalendarNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
 		{
 			name:   "Invalid Date Format",
 			role:   "ADMIN",
@@ -59,7 +76,7 @@ func TestHolidayHandler_AddHoliday(t *testing.T) {
 			reqBody: holidays.HolidayRequest{Date: "2026-01-01"},
 			mockSetup: func(s *mocks.HolidayService) {
 				date, _ := time.Parse("2006-01-02", "2026-01-01")
-				s.EXPECT().AddHoliday(mock.Anything, "EMPLOYEE", int64(2), date, "").Return(apperrors.ErrAdminOnly)
+				s.EXPECT().AddHoliday(mock.Anything, "EMPLOYEE", int64(2), int64(0), date, "").Return(apperrors.ErrAdminOnly)
 			},
 			expectedStatus: http.StatusForbidden,
 		},
@@ -94,7 +111,7 @@ func TestHolidayHandler_GetHolidays(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockS := mocks.NewHolidayService(t)
-		mockS.EXPECT().GetHolidays(mock.Anything, "ADMIN").Return([]map[string]interface{}{{"id": 1}}, nil)
+		mockS.EXPECT().GetHolidays(mock.Anything, "ADMIN", int64(0)).Return([]map[string]interface{}{{"id": 1}}, nil)
 
 		handler := holidays.NewHolidayHandler(context.Background(), mockS)
 		r := gin.New()
@@ -109,6 +126,39 @@ func TestHolidayHandler_GetHolidays(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+
+	t.Run("Of A Calendar", func(t *testing.T) {
+		mockS := mocks.NewHolidayService(t)
+		mockS.EXPECT().GetHolidays(mock.Anything, "ADMIN", int64(3)).Return([]map[string]interface{}{}, nil)
+
+		handler := holidays.NewHolidayHandler(context.Background(), mockS)
+		r := gin.New()
+		r.GET("/list", func(c *gin.Context) {
+			c.Set("role", "ADMIN")
+			handler.GetHolidays(c)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/list?calendar_id=3", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Invalid Calendar ID", func(t *testing.T) {
+		handler := holidays.NewHolidayHandler(context.Background(), mocks.NewHolidayService(t))
+		r := gin.New()
+		r.GET("/list", func(c *gin.Context) {
+			c.Set("role", "ADMIN")
+			handler.GetHolidays(c)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/list?calendar_id=abc", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 func TestHolidayHandler_DeleteHoliday(t *testing.T) {
@@ -159,3 +209,79 @@ func TestHolidayHandler_DeleteHoliday(t *testing.T) {
 		})
 	}
 }
+
+func TestHolidayHandler_ImportHolidays(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	file := "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+
+	t.Run("Multipart Upload", func(t *testing.T) {
+		mockS := mocks.NewHolidayService(t)
+		mockS.EXPECT().ImportHolidays(mock.Anything, "ADMIN", int64(1), int64(2), mock.Anything, true).
+			Return(&models.HolidayImport{CalendarID: 2, Preview: true}, nil)
+
+		handler := holidays.NewHolidayHandler(context.Background(), mockS)
+		r := gin.New()
+		r.POST("/import", func(c *gin.Context) {
+			c.Set("role", "ADMIN")
+			c.Set("user_id", int64(1))
+			handler.ImportHolidays(c)
+		})
+
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, _ := form.CreateFormFile("file", "holidays.ics")
+		part.Write([]byte(file))
+		form.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/import?calendar_id=2&preview=true", &body)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Invalid File", func(t *testing.T) {
+		mockS := mocks.NewHolidayService(t)
+		mockS.EXPECT().ImportHolidays(mock.Anything, "ADMIN", int64(1), int64(0), mock.Anything, false).
+			Return(nil, apperrors.ErrInvalidICalFile)
+
+		handler := holidays.NewHolidayHandler(context.Background(), mockS)
+		r := gin.New()
+		r.POST("/import", func(c *gin.Context) {
+			c.Set("role", "ADMIN")
+			c.Set("user_id", int64(1))
+			handler.ImportHolidays(c)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/import", bytes.NewBufferString("not a calendar"))
+		req.Header.Set("Content-Type", "text/calendar")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHolidayHandler_ExportHolidays(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockS := mocks.NewHolidayService(t)
+	mockS.EXPECT().ExportHolidays(mock.Anything, "ADMIN", int64(0)).Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	handler := holidays.NewHolidayHandler(context.Background(), mockS)
+	r := gin.New()
+	r.GET("/export", func(c *gin.Context) {
+		c.Set("role", "ADMIN")
+		handler.ExportHolidays(c)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/export", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "holidays.ics")
+	assert.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", w.Body.String())
+}
@@ -2,34 +2,62 @@ package tests
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestHolidayService(t *testing.T) {
 	ctx := context.Background()
+	defaultCalendar := &models.Calendar{ID: 1, Name: "Default", IsDefault: true, WorkingDays: []string{"Monday", "Friday"}}
 
 	t.Run("AddHoliday - Repository Error", func(t *testing.T) {
 		mockRepo := mocks.NewHolidayRepository(t)
-		mockRepo.EXPECT().AddHoliday(ctx, mock.Anything, "Fail", int64(1)).Return(assert.AnError)
+		mockRepo.EXPECT().GetDefaultCalendar(ctx).Return(defaultCalendar, nil)
+		mockRepo.EXPECT().AddHoliday(ctx, int64(1), mock.Anything, "Fail", int64(1)).Return(assert.AnError)
 
-		service := holidays.NewHolidayService(ctx, mockRepo)
-		err := service.AddHoliday(ctx, "ADMIN", 1, time.Now(), "Fail")
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		err := service.AddHoliday(ctx, "ADMIN", 1, 0, time.Now(), "Fail")
 
 		assert.Error(t, err)
 	})
 
+	t.Run("AddHoliday - To A Calendar", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(&models.Calendar{ID: 2, Name: "India"}, nil)
+		mockRepo.EXPECT().AddHoliday(ctx, int64(2), mock.Anything, "Diwali", int64(1)).Return(nil)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		err := service.AddHoliday(ctx, "ADMIN", 1, 2, time.Now(), "Diwali")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AddHoliday - Unknown Calendar", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetCalendar(ctx, int64(9)).Return(nil, apperrors.ErrCalendarNotFound)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		err := service.AddHoliday(ctx, "ADMIN", 1, 9, time.Now(), "Diwali")
+
+		assert.ErrorIs(t, err, apperrors.ErrCalendarNotFound)
+	})
+
 	t.Run("GetHolidays - Repository Error", func(t *testing.T) {
 		mockRepo := mocks.NewHolidayRepository(t)
-		mockRepo.EXPECT().GetHolidays(ctx).Return(nil, assert.AnError)
+		mockRepo.EXPECT().GetDefaultCalendar(ctx).Return(defaultCalendar, nil)
+		mockRepo.EXPECT().GetHolidays(ctx, int64(1)).Return(nil, assert.AnError)
 
-		service := holidays.NewHolidayService(ctx, mockRepo)
-		_, err := service.GetHolidays(ctx, "ADMIN")
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		_, err := service.GetHolidays(ctx, "ADMIN", 0)
 
 		assert.Error(t, err)
 	})
@@ -38,9 +66,316 @@ func TestHolidayService(t *testing.T) {
 		mockRepo := mocks.NewHolidayRepository(t)
 		mockRepo.EXPECT().DeleteHoliday(ctx, int64(99)).Return(assert.AnError)
 
-		service := holidays.NewHolidayService(ctx, mockRepo)
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
 		err := service.DeleteHoliday(ctx, "ADMIN", 99)
 
 		assert.Error(t, err)
 	})
+
+	t.Run("SetWorkWeek - Saves Parsed Days", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+		mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(&models.Calendar{ID: 2, Name: "UAE"}, nil)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().SetWorkWeek(ctx, mockTx, int64(2), []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}, int64(1)).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mockDB)
+		days, err := service.SetWorkWeek(ctx, "ADMIN", 1, 2, []string{"thursday", "Sunday", "MONDAY", "Tuesday", "wednesday"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday"}, days)
+	})
+
+	t.Run("SetWorkWeek - Invalid Day", func(t *testing.T) {
+		service := holidays.NewHolidayService(ctx, mocks.NewHolidayRepository(t), mocks.NewDB(t))
+		_, err := service.SetWorkWeek(ctx, "ADMIN", 1, 0, []string{"Monday", "Funday"})
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidWorkWeek)
+	})
+
+	t.Run("SetWorkWeek - Not Admin", func(t *testing.T) {
+		service := holidays.NewHolidayService(ctx, mocks.NewHolidayRepository(t), mocks.NewDB(t))
+		_, err := service.SetWorkWeek(ctx, "EMPLOYEE", 2, 0, []string{"Monday"})
+
+		assert.ErrorIs(t, err, apperrors.ErrAdminOnly)
+	})
+
+	t.Run("GetWorkWeek - Of The Default Calendar", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetDefaultCalendar(ctx).Return(defaultCalendar, nil)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		days, err := service.GetWorkWeek(ctx, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Monday", "Friday"}, days)
+	})
+}
+
+func TestHolidayService_SaveCalendar(t *testing.T) {
+	ctx := context.Background()
+	adminID := int64(1)
+	workWeek := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}
+
+	tests := []struct {
+		name        string
+		role        string
+		calendarID  int64
+		calendar    string
+		workingDays []string
+		mockSetup   func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedErr error
+	}{
+		{
+			name:        "Create",
+			role:        "ADMIN",
+			calendar:    " UAE Office ",
+			workingDays: []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday"},
+			mockSetup: func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				h.EXPECT().CreateCalendar(ctx, tx, mock.MatchedBy(func(c *models.Calendar) bool {
+					return c.Name == "UAE Office" && *c.UpdatedBy == adminID
+				})).Run(func(ctx context.Context, tx interfaces.Tx, c *models.Calendar) {
+					c.ID = 2
+				}).Return(nil)
+				h.EXPECT().SetWorkWeek(ctx, tx, int64(2), workWeek, adminID).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:        "Update",
+			role:        "ADMIN",
+			calendarID:  2,
+			calendar:    "UAE",
+			workingDays: []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday"},
+			mockSetup: func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				h.EXPECT().UpdateCalendar(ctx, tx, mock.MatchedBy(func(c *models.Calendar) bool {
+					return c.ID == 2 && c.Name == "UAE"
+				})).Return(nil)
+				h.EXPECT().SetWorkWeek(ctx, tx, int64(2), workWeek, adminID).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:        "Duplicate Name",
+			role:        "ADMIN",
+			calendar:    "Default",
+			workingDays: []string{"Monday"},
+			mockSetup: func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				h.EXPECT().CreateCalendar(ctx, tx, mock.Anything).Return(apperrors.ErrDuplicateEntry)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrCalendarExists,
+		},
+		{
+			name:        "Unknown Calendar",
+			role:        "ADMIN",
+			calendarID:  9,
+			calendar:    "UAE",
+			workingDays: []string{"Monday"},
+			mockSetup: func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				h.EXPECT().UpdateCalendar(ctx, tx, mock.Anything).Return(apperrors.ErrCalendarNotFound)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrCalendarNotFound,
+		},
+		{
+			name:        "Missing Name",
+			role:        "ADMIN",
+			calendar:    "  ",
+			workingDays: []string{"Monday"},
+			mockSetup:   func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrCalendarNameRequired,
+		},
+		{
+			name:        "No Working Days",
+			role:        "ADMIN",
+			calendar:    "UAE",
+			mockSetup:   func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidWorkWeek,
+		},
+		{
+			name:        "Not Admin",
+			role:        "MANAGER",
+			calendar:    "UAE",
+			workingDays: []string{"Monday"},
+			mockSetup:   func(h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrAdminOnly,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewHolidayRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockRepo, mockDB, mockTx)
+
+			service := holidays.NewHolidayService(ctx, mockRepo, mockDB)
+
+			var calendar *models.Calendar
+			var err error
+			if tt.calendarID == 0 {
+				calendar, err = service.CreateCalendar(ctx, tt.role, adminID, tt.calendar, tt.workingDays)
+			} else {
+				calendar, err = service.UpdateCalendar(ctx, tt.role, adminID, tt.calendarID, tt.calendar, tt.workingDays)
+			}
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), calendar.ID)
+			assert.Equal(t, []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday"}, calendar.WorkingDays)
+		})
+	}
+}
+
+func TestHolidayService_DeleteCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := mocks.NewHolidayRepository(t)
+	service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+
+	assert.ErrorIs(t, service.DeleteCalendar(ctx, "EMPLOYEE", 2), apperrors.ErrAdminOnly)
+
+	mockRepo.EXPECT().GetCalendar(ctx, int64(1)).Return(&models.Calendar{ID: 1, IsDefault: true}, nil)
+	mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(&models.Calendar{ID: 2}, nil)
+	mockRepo.EXPECT().DeleteCalendar(ctx, int64(2)).Return(nil)
+
+	assert.ErrorIs(t, service.DeleteCalendar(ctx, "ADMIN", 1), apperrors.ErrDefaultCalendarDelete)
+	assert.NoError(t, service.DeleteCalendar(ctx, "ADMIN", 2))
+}
+
+func TestHolidayService_AssignCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := mocks.NewHolidayRepository(t)
+	service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+
+	_, err := service.AssignCalendar(ctx, "ADMIN", 2, nil)
+	assert.ErrorIs(t, err, apperrors.ErrCalendarUsersRequired)
+
+	mockRepo.EXPECT().GetCalendar(ctx, int64(9)).Return(nil, apperrors.ErrCalendarNotFound)
+	_, err = service.AssignCalendar(ctx, "ADMIN", 9, []int64{10})
+	assert.ErrorIs(t, err, apperrors.ErrCalendarNotFound)
+
+	mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(&models.Calendar{ID: 2}, nil)
+	mockRepo.EXPECT().AssignCalendar(ctx, int64(2), []int64{10, 11}).Return(int64(2), nil)
+
+	assigned, err := service.AssignCalendar(ctx, "ADMIN", 2, []int64{10, 11})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), assigned)
+}
+
+func TestHolidayService_ImportHolidays(t *testing.T) {
+	ctx := context.Background()
+	office := &models.Calendar{ID: 2, Name: "Pune"}
+
+	file := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20261225\r\nDTEND;VALUE=DATE:20261227\r\nSUMMARY:Christmas\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20261226\r\nSUMMARY:Boxing Day\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	christmas := time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Admin Only", func(t *testing.T) {
+		service := holidays.NewHolidayService(ctx, mocks.NewHolidayRepository(t), mocks.NewDB(t))
+		_, err := service.ImportHolidays(ctx, "MANAGER", 1, 2, strings.NewReader(file), false)
+		assert.ErrorIs(t, err, apperrors.ErrAdminOnly)
+	})
+
+	t.Run("Invalid File", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(office, nil)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		_, err := service.ImportHolidays(ctx, "ADMIN", 1, 2, strings.NewReader("date,description\n"), false)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidICalFile)
+	})
+
+	t.Run("Event Too Long", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(office, nil)
+
+		long := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260101\r\nDURATION:P9999W\r\nSUMMARY:Forever\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+		_, err := service.ImportHolidays(ctx, "ADMIN", 1, 2, strings.NewReader(long), false)
+		assert.ErrorIs(t, err, apperrors.ErrICalEventTooLong)
+	})
+
+	t.Run("Imports Each Date Once", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(office, nil)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().UpsertHoliday(ctx, mockTx, int64(2), christmas, "Christmas", int64(1)).Return(nil)
+		mockRepo.EXPECT().UpsertHoliday(ctx, mockTx, int64(2), christmas.AddDate(0, 0, 1), "Christmas", int64(1)).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mockDB)
+		report, err := service.ImportHolidays(ctx, "ADMIN", 1, 2, strings.NewReader(file), false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Applied)
+		assert.Equal(t, int64(2), report.CalendarID)
+		assert.Equal(t, []models.ImportedHoliday{
+			{Date: "2026-12-25", Description: "Christmas"},
+			{Date: "2026-12-26", Description: "Christmas"},
+		}, report.Holidays)
+		assert.Equal(t, 1, report.Skipped)
+	})
+
+	t.Run("Preview Rolls Back", func(t *testing.T) {
+		mockRepo := mocks.NewHolidayRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetDefaultCalendar(ctx).Return(&models.Calendar{ID: 1, IsDefault: true}, nil)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().UpsertHoliday(ctx, mockTx, int64(1), mock.Anything, "Christmas", int64(1)).Return(nil).Twice()
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := holidays.NewHolidayService(ctx, mockRepo, mockDB)
+		report, err := service.ImportHolidays(ctx, "ADMIN", 1, 0, strings.NewReader(file), true)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Preview)
+		assert.False(t, report.Applied)
+		assert.Len(t, report.Holidays, 2)
+	})
+}
+
+func TestHolidayService_ExportHolidays(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := mocks.NewHolidayRepository(t)
+	service := holidays.NewHolidayService(ctx, mockRepo, mocks.NewDB(t))
+
+	_, err := service.ExportHolidays(ctx, "EMPLOYEE", 0)
+	assert.ErrorIs(t, err, apperrors.ErrAdminOnly)
+
+	mockRepo.EXPECT().GetCalendar(ctx, int64(2)).Return(&models.Calendar{ID: 2, Name: "Pune"}, nil)
+	mockRepo.EXPECT().GetHolidays(ctx, int64(2)).Return([]map[string]interface{}{
+		{"id": int64(5), "date": "2026-08-15", "description": "Independence Day"},
+	}, nil)
+
+	feed, err := service.ExportHolidays(ctx, "ADMIN", 2)
+	assert.NoError(t, err)
+	assert.Contains(t, string(feed), "X-WR-CALNAME:Pune holidays\r\n")
+	assert.Contains(t, string(feed), "UID:holiday-2-20260815@rule-based-approval-engine\r\n")
+	assert.Contains(t, string(feed), "DTSTART;VALUE=DATE:20260815\r\nDTEND;VALUE=DATE:20260816\r\n")
+	assert.Contains(t, string(feed), "SUMMARY:Independence Day\r\n")
 }
@@ -0,0 +1,59 @@
+package job_runs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles background job HTTP requests
+type JobRunHandler struct {
+	jobRunService interfaces.JobRunService
+}
+
+// creates a new JobRunHandler instance
+func NewJobRunHandler(ctx context.Context, jobRunService interfaces.JobRunService) *JobRunHandler {
+	return &JobRunHandler{jobRunService: jobRunService}
+}
+
+func (h *JobRunHandler) GetJobRuns(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleJobRunError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(constants.DefaultJobRunsLimit)))
+	if err != nil || limit <= 0 {
+		limit = constants.DefaultJobRunsLimit
+	}
+
+	ctx := c.Request.Context()
+	runs, err := h.jobRunService.GetRuns(ctx, role, c.Query("job"), limit)
+	if err != nil {
+		handleJobRunError(c, err)
+		return
+	}
+
+	response.Success(c, "job runs fetched successfully", runs)
+}
+
+func handleJobRunError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrJobAlreadyRunning:
+		status = http.StatusConflict
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
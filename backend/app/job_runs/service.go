@@ -0,0 +1,96 @@
+package job_runs
+
+import (
+	"context"
+	"os"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+// JobRunService runs background jobs on one instance at a time and keeps their run history
+type JobRunService struct {
+	jobRunRepo interfaces.JobRunRepository
+	db         interfaces.DB
+	instance   string
+}
+
+// NewJobRunService creates a new instance of JobRunService
+func NewJobRunService(ctx context.Context, jobRunRepo interfaces.JobRunRepository, db interfaces.DB) interfaces.JobRunService {
+	instance, _ := os.Hostname()
+
+	return &JobRunService{
+		jobRunRepo: jobRunRepo,
+		db:         db,
+		instance:   instance,
+	}
+}
+
+// Execute runs a job while holding its advisory lock and records the run. It returns
+// ErrJobAlreadyRunning while another run of the job, scheduled or triggered on any
+// instance, holds the lock. Runs a crashed instance left RUNNING are marked FAILED first.
+// A failing job is recorded as FAILED and returned with its error set rather than as an
+// error.
+func (s *JobRunService) Execute(ctx context.Context, jobName string, triggeredBy *int64, job func(ctx context.Context) (map[string]int, error)) (*models.JobRun, error) {
+	// the lock lives as long as this transaction, which holds its own connection for the whole run
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	locked, err := s.jobRunRepo.TryLock(ctx, tx, jobName)
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, apperrors.ErrJobAlreadyRunning
+	}
+
+	if err := s.jobRunRepo.FailStaleRuns(ctx, jobName); err != nil {
+		return nil, err
+	}
+
+	run := &models.JobRun{
+		JobName:     jobName,
+		Status:      constants.JobStatusRunning,
+		TriggeredBy: triggeredBy,
+		Instance:    s.instance,
+	}
+	if err := s.jobRunRepo.Start(ctx, run); err != nil {
+		return nil, err
+	}
+
+	counts, jobErr := job(ctx)
+
+	run.Counts = counts
+	run.Status = constants.JobStatusSucceeded
+	if jobErr != nil {
+		run.Status = constants.JobStatusFailed
+		run.Error = jobErr.Error()
+	}
+	if err := s.jobRunRepo.Finish(ctx, run); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// GetRuns lists the latest runs, of one job when jobName is set (admin only)
+func (s *JobRunService) GetRuns(ctx context.Context, role string, jobName string, limit int) ([]models.JobRun, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if limit <= 0 {
+		limit = constants.DefaultJobRunsLimit
+	}
+
+	return s.jobRunRepo.GetRecent(ctx, jobName, limit)
+}
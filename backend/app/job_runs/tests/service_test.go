@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/job_runs"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/job_runs/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJobRunService_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		job         func(ctx context.Context) (map[string]int, error)
+		mockSetup   func(r *mocks.JobRunRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedErr error
+		status      string
+		runError    string
+	}{
+		{
+			name: "Locked By Another Instance",
+			job: func(ctx context.Context) (map[string]int, error) {
+				t.Fatal("job must not run without the lock")
+				return nil, nil
+			},
+			mockSetup: func(r *mocks.JobRunRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().TryLock(ctx, tx, constants.JobAutoReject).Return(false, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrJobAlreadyRunning,
+		},
+		{
+			name: "Succeeded",
+			job: func(ctx context.Context) (map[string]int, error) {
+				return map[string]int{"auto_rejected": 2}, nil
+			},
+			mockSetup: func(r *mocks.JobRunRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().TryLock(ctx, tx, constants.JobAutoReject).Return(true, nil)
+				r.EXPECT().FailStaleRuns(ctx, constants.JobAutoReject).Return(nil)
+				r.EXPECT().Start(ctx, mock.MatchedBy(func(run *models.JobRun) bool {
+					return run.JobName == constants.JobAutoReject && run.Status == constants.JobStatusRunning
+				})).Return(nil)
+				r.EXPECT().Finish(ctx, mock.MatchedBy(func(run *models.JobRun) bool {
+					return run.Status == constants.JobStatusSucceeded && run.Counts["auto_rejected"] == 2
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			status: constants.JobStatusSucceeded,
+		},
+		{
+			name: "Stale Runs Not Closed",
+			job: func(ctx context.Context) (map[string]int, error) {
+				t.Fatal("job must not run before stale runs are closed")
+				return nil, nil
+			},
+			mockSetup: func(r *mocks.JobRunRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().TryLock(ctx, tx, constants.JobAutoReject).Return(true, nil)
+				r.EXPECT().FailStaleRuns(ctx, constants.JobAutoReject).Return(apperrors.ErrDatabase)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrDatabase,
+		},
+		{
+			name: "Failed Job Is Recorded",
+			job: func(ctx context.Context) (map[string]int, error) {
+				return nil, errors.New("db down")
+			},
+			mockSetup: func(r *mocks.JobRunRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				r.EXPECT().TryLock(ctx, tx, constants.JobAutoReject).Return(true, nil)
+				r.EXPECT().FailStaleRuns(ctx, constants.JobAutoReject).Return(nil)
+				r.EXPECT().Start(ctx, mock.Anything).Return(nil)
+				r.EXPECT().Finish(ctx, mock.MatchedBy(func(run *models.JobRun) bool {
+					return run.Status == constants.JobStatusFailed && run.Error == "db down"
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			status:   constants.JobStatusFailed,
+			runError: "db down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewJobRunRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockRepo, mockDB, mockTx)
+
+			service := job_runs.NewJobRunService(ctx, mockRepo, mockDB)
+			run, err := service.Execute(ctx, constants.JobAutoReject, nil, tt.job)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, run)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, run.Status)
+			assert.Equal(t, tt.runError, run.Error)
+		})
+	}
+}
+
+func TestJobRunService_GetRuns(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := mocks.NewJobRunRepository(t)
+	mockRepo.EXPECT().GetRecent(ctx, "", constants.DefaultJobRunsLimit).Return([]models.JobRun{}, nil)
+
+	service := job_runs.NewJobRunService(ctx, mockRepo, mocks.NewDB(t))
+
+	_, err := service.GetRuns(ctx, constants.RoleManager, "", 10)
+	assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+
+	runs, err := service.GetRuns(ctx, constants.RoleAdmin, "", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, runs)
+}
@@ -1,8 +1,11 @@
 package leave_service
 
 type LeaveApplyRequest struct {
-	FromDate  string `json:"from_date"`
-	ToDate    string `json:"to_date"`
-	LeaveType string `json:"leave_type"`
-	Reason    string `json:"reason"`
+	FromDate    string `json:"from_date"`
+	ToDate      string `json:"to_date"`
+	FromSession string `json:"from_session"`
+	ToSession   string `json:"to_session"`
+	Hours       int    `json:"hours"`
+	LeaveType   string `json:"leave_type"`
+	Reason      string `json:"reason"`
 }
@@ -49,15 +49,15 @@ func (h *LeaveHandler) ApplyLeave(c *gin.Context) {
 		return
 	}
 
-	days := utils.CalculateLeaveDays(from, to)
-	if days <= 0 {
-		handleApplyLeaveError(c, apperrors.ErrInvalidLeaveDays)
+	period, err := utils.NewLeavePeriod(from, to, req.FromSession, req.ToSession, req.Hours)
+	if err != nil {
+		handleApplyLeaveError(c, err)
 		return
 	}
 
 	ctx := c.Request.Context()
-	message, status, err := h.leaveService.ApplyLeave(
-		ctx, userID, from, to, days, req.LeaveType, req.Reason,
+	message, status, charged, err := h.leaveService.ApplyLeave(
+		ctx, userID, period, req.LeaveType, req.Reason,
 	)
 
 	if err != nil {
@@ -66,7 +66,11 @@ func (h *LeaveHandler) ApplyLeave(c *gin.Context) {
 	}
 
 	response.Success(c, message, gin.H{
-		"status": status,
+		"status":          status,
+		"days":            charged.Days,
+		"calendar_days":   period.Days,
+		"excluded_days":   charged.Excluded,
+		"sandwiched_days": charged.Sandwiched,
 	})
 }
 
@@ -75,7 +79,10 @@ func handleApplyLeaveError(c *gin.Context, err error) {
 	switch err {
 	case apperrors.ErrLeaveBalanceExceeded, apperrors.ErrInvalidLeaveDays,
 		apperrors.ErrLeaveOverlap, apperrors.ErrPastDate,
-		apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidRequestPayload:
+		apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidRequestPayload,
+		apperrors.ErrLeaveTypeRequired, apperrors.ErrLeaveTypeNotFound,
+		apperrors.ErrInvalidLeaveSession, apperrors.ErrInvalidLeaveHours,
+		apperrors.ErrNoWorkingDays:
 		status = http.StatusBadRequest
 	case apperrors.ErrUserNotFound, apperrors.ErrLeaveBalanceMissing:
 		status = http.StatusNotFound
@@ -222,7 +229,9 @@ func handleApprovalError(c *gin.Context, err error) {
 	status := http.StatusInternalServerError
 
 	switch err {
-	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole, apperrors.ErrSelfApprovalNotAllowed:
+	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole, apperrors.ErrSelfApprovalNotAllowed,
+		apperrors.ErrNotRoutedApprover,
+		apperrors.ErrStepApproverRepeated:
 		status = http.StatusForbidden
 	case apperrors.ErrLeaveRequestNotFound, apperrors.ErrUserNotFound:
 		status = http.StatusNotFound
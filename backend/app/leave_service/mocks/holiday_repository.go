@@ -0,0 +1,1015 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HolidayRepository is an autogenerated mock type for the HolidayRepository type
+type HolidayRepository struct {
+	mock.Mock
+}
+
+type HolidayRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HolidayRepository) EXPECT() *HolidayRepository_Expecter {
+	return &HolidayRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddHoliday provides a mock function with given fields: ctx, calendarID, date, desc, adminID
+func (_m *HolidayRepository) AddHoliday(ctx context.Context, calendarID int64, date time.Time, desc string, adminID int64) error {
+	ret := _m.Called(ctx, calendarID, date, desc, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string, int64) error); ok {
+		r0 = rf(ctx, calendarID, date, desc, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_AddHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddHoliday'
+type HolidayRepository_AddHoliday_Call struct {
+	*mock.Call
+}
+
+// AddHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+//   - date time.Time
+//   - desc string
+//   - adminID int64
+func (_e *HolidayRepository_Expecter) AddHoliday(ctx interface{}, calendarID interface{}, date interface{}, desc interface{}, adminID interface{}) *HolidayRepository_AddHoliday_Call {
+	return &HolidayRepository_AddHoliday_Call{Call: _e.mock.On("AddHoliday", ctx, calendarID, date, desc, adminID)}
+}
+
+func (_c *HolidayRepository_AddHoliday_Call) Run(run func(ctx context.Context, calendarID int64, date time.Time, desc string, adminID int64)) *HolidayRepository_AddHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_AddHoliday_Call) Return(_a0 error) *HolidayRepository_AddHoliday_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_AddHoliday_Call) RunAndReturn(run func(context.Context, int64, time.Time, string, int64) error) *HolidayRepository_AddHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignCalendar provides a mock function with given fields: ctx, calendarID, userIDs
+func (_m *HolidayRepository) AssignCalendar(ctx context.Context, calendarID int64, userIDs []int64) (int64, error) {
+	ret := _m.Called(ctx, calendarID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCalendar")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) (int64, error)); ok {
+		return rf(ctx, calendarID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) int64); ok {
+		r0 = rf(ctx, calendarID, userIDs)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, calendarID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_AssignCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCalendar'
+type HolidayRepository_AssignCalendar_Call struct {
+	*mock.Call
+}
+
+// AssignCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+//   - userIDs []int64
+func (_e *HolidayRepository_Expecter) AssignCalendar(ctx interface{}, calendarID interface{}, userIDs interface{}) *HolidayRepository_AssignCalendar_Call {
+	return &HolidayRepository_AssignCalendar_Call{Call: _e.mock.On("AssignCalendar", ctx, calendarID, userIDs)}
+}
+
+func (_c *HolidayRepository_AssignCalendar_Call) Run(run func(ctx context.Context, calendarID int64, userIDs []int64)) *HolidayRepository_AssignCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_AssignCalendar_Call) Return(_a0 int64, _a1 error) *HolidayRepository_AssignCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_AssignCalendar_Call) RunAndReturn(run func(context.Context, int64, []int64) (int64, error)) *HolidayRepository_AssignCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCalendar provides a mock function with given fields: ctx, tx, calendar
+func (_m *HolidayRepository) CreateCalendar(ctx context.Context, tx interfaces.Tx, calendar *models.Calendar) error {
+	ret := _m.Called(ctx, tx, calendar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Calendar) error); ok {
+		r0 = rf(ctx, tx, calendar)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_CreateCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCalendar'
+type HolidayRepository_CreateCalendar_Call struct {
+	*mock.Call
+}
+
+// CreateCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - calendar *models.Calendar
+func (_e *HolidayRepository_Expecter) CreateCalendar(ctx interface{}, tx interface{}, calendar interface{}) *HolidayRepository_CreateCalendar_Call {
+	return &HolidayRepository_CreateCalendar_Call{Call: _e.mock.On("CreateCalendar", ctx, tx, calendar)}
+}
+
+func (_c *HolidayRepository_CreateCalendar_Call) Run(run func(ctx context.Context, tx interfaces.Tx, calendar *models.Calendar)) *HolidayRepository_CreateCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Calendar))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_CreateCalendar_Call) Return(_a0 error) *HolidayRepository_CreateCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_CreateCalendar_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Calendar) error) *HolidayRepository_CreateCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCalendar provides a mock function with given fields: ctx, calendarID
+func (_m *HolidayRepository) DeleteCalendar(ctx context.Context, calendarID int64) error {
+	ret := _m.Called(ctx, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, calendarID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_DeleteCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCalendar'
+type HolidayRepository_DeleteCalendar_Call struct {
+	*mock.Call
+}
+
+// DeleteCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+func (_e *HolidayRepository_Expecter) DeleteCalendar(ctx interface{}, calendarID interface{}) *HolidayRepository_DeleteCalendar_Call {
+	return &HolidayRepository_DeleteCalendar_Call{Call: _e.mock.On("DeleteCalendar", ctx, calendarID)}
+}
+
+func (_c *HolidayRepository_DeleteCalendar_Call) Run(run func(ctx context.Context, calendarID int64)) *HolidayRepository_DeleteCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_DeleteCalendar_Call) Return(_a0 error) *HolidayRepository_DeleteCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_DeleteCalendar_Call) RunAndReturn(run func(context.Context, int64) error) *HolidayRepository_DeleteCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteHoliday provides a mock function with given fields: ctx, holidayID
+func (_m *HolidayRepository) DeleteHoliday(ctx context.Context, holidayID int64) error {
+	ret := _m.Called(ctx, holidayID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, holidayID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_DeleteHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHoliday'
+type HolidayRepository_DeleteHoliday_Call struct {
+	*mock.Call
+}
+
+// DeleteHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - holidayID int64
+func (_e *HolidayRepository_Expecter) DeleteHoliday(ctx interface{}, holidayID interface{}) *HolidayRepository_DeleteHoliday_Call {
+	return &HolidayRepository_DeleteHoliday_Call{Call: _e.mock.On("DeleteHoliday", ctx, holidayID)}
+}
+
+func (_c *HolidayRepository_DeleteHoliday_Call) Run(run func(ctx context.Context, holidayID int64)) *HolidayRepository_DeleteHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_DeleteHoliday_Call) Return(_a0 error) *HolidayRepository_DeleteHoliday_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_DeleteHoliday_Call) RunAndReturn(run func(context.Context, int64) error) *HolidayRepository_DeleteHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteHolidayByDate provides a mock function with given fields: ctx, tx, calendarID, date
+func (_m *HolidayRepository) DeleteHolidayByDate(ctx context.Context, tx interfaces.Tx, calendarID int64, date time.Time) error {
+	ret := _m.Called(ctx, tx, calendarID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHolidayByDate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time) error); ok {
+		r0 = rf(ctx, tx, calendarID, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_DeleteHolidayByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHolidayByDate'
+type HolidayRepository_DeleteHolidayByDate_Call struct {
+	*mock.Call
+}
+
+// DeleteHolidayByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - calendarID int64
+//   - date time.Time
+func (_e *HolidayRepository_Expecter) DeleteHolidayByDate(ctx interface{}, tx interface{}, calendarID interface{}, date interface{}) *HolidayRepository_DeleteHolidayByDate_Call {
+	return &HolidayRepository_DeleteHolidayByDate_Call{Call: _e.mock.On("DeleteHolidayByDate", ctx, tx, calendarID, date)}
+}
+
+func (_c *HolidayRepository_DeleteHolidayByDate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, calendarID int64, date time.Time)) *HolidayRepository_DeleteHolidayByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_DeleteHolidayByDate_Call) Return(_a0 error) *HolidayRepository_DeleteHolidayByDate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_DeleteHolidayByDate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time) error) *HolidayRepository_DeleteHolidayByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendar provides a mock function with given fields: ctx, calendarID
+func (_m *HolidayRepository) GetCalendar(ctx context.Context, calendarID int64) (*models.Calendar, error) {
+	ret := _m.Called(ctx, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendar")
+	}
+
+	var r0 *models.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Calendar, error)); ok {
+		return rf(ctx, calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Calendar); ok {
+		r0 = rf(ctx, calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendar'
+type HolidayRepository_GetCalendar_Call struct {
+	*mock.Call
+}
+
+// GetCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+func (_e *HolidayRepository_Expecter) GetCalendar(ctx interface{}, calendarID interface{}) *HolidayRepository_GetCalendar_Call {
+	return &HolidayRepository_GetCalendar_Call{Call: _e.mock.On("GetCalendar", ctx, calendarID)}
+}
+
+func (_c *HolidayRepository_GetCalendar_Call) Run(run func(ctx context.Context, calendarID int64)) *HolidayRepository_GetCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetCalendar_Call) Return(_a0 *models.Calendar, _a1 error) *HolidayRepository_GetCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetCalendar_Call) RunAndReturn(run func(context.Context, int64) (*models.Calendar, error)) *HolidayRepository_GetCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendars provides a mock function with given fields: ctx
+func (_m *HolidayRepository) GetCalendars(ctx context.Context) ([]models.Calendar, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendars")
+	}
+
+	var r0 []models.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Calendar, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Calendar); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetCalendars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendars'
+type HolidayRepository_GetCalendars_Call struct {
+	*mock.Call
+}
+
+// GetCalendars is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HolidayRepository_Expecter) GetCalendars(ctx interface{}) *HolidayRepository_GetCalendars_Call {
+	return &HolidayRepository_GetCalendars_Call{Call: _e.mock.On("GetCalendars", ctx)}
+}
+
+func (_c *HolidayRepository_GetCalendars_Call) Run(run func(ctx context.Context)) *HolidayRepository_GetCalendars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetCalendars_Call) Return(_a0 []models.Calendar, _a1 error) *HolidayRepository_GetCalendars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetCalendars_Call) RunAndReturn(run func(context.Context) ([]models.Calendar, error)) *HolidayRepository_GetCalendars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultCalendar provides a mock function with given fields: ctx
+func (_m *HolidayRepository) GetDefaultCalendar(ctx context.Context) (*models.Calendar, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultCalendar")
+	}
+
+	var r0 *models.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.Calendar, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.Calendar); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetDefaultCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultCalendar'
+type HolidayRepository_GetDefaultCalendar_Call struct {
+	*mock.Call
+}
+
+// GetDefaultCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HolidayRepository_Expecter) GetDefaultCalendar(ctx interface{}) *HolidayRepository_GetDefaultCalendar_Call {
+	return &HolidayRepository_GetDefaultCalendar_Call{Call: _e.mock.On("GetDefaultCalendar", ctx)}
+}
+
+func (_c *HolidayRepository_GetDefaultCalendar_Call) Run(run func(ctx context.Context)) *HolidayRepository_GetDefaultCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetDefaultCalendar_Call) Return(_a0 *models.Calendar, _a1 error) *HolidayRepository_GetDefaultCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetDefaultCalendar_Call) RunAndReturn(run func(context.Context) (*models.Calendar, error)) *HolidayRepository_GetDefaultCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultCalendarInTx provides a mock function with given fields: ctx, tx
+func (_m *HolidayRepository) GetDefaultCalendarInTx(ctx context.Context, tx interfaces.Tx) (*models.Calendar, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultCalendarInTx")
+	}
+
+	var r0 *models.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) (*models.Calendar, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) *models.Calendar); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetDefaultCalendarInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultCalendarInTx'
+type HolidayRepository_GetDefaultCalendarInTx_Call struct {
+	*mock.Call
+}
+
+// GetDefaultCalendarInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+func (_e *HolidayRepository_Expecter) GetDefaultCalendarInTx(ctx interface{}, tx interface{}) *HolidayRepository_GetDefaultCalendarInTx_Call {
+	return &HolidayRepository_GetDefaultCalendarInTx_Call{Call: _e.mock.On("GetDefaultCalendarInTx", ctx, tx)}
+}
+
+func (_c *HolidayRepository_GetDefaultCalendarInTx_Call) Run(run func(ctx context.Context, tx interfaces.Tx)) *HolidayRepository_GetDefaultCalendarInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetDefaultCalendarInTx_Call) Return(_a0 *models.Calendar, _a1 error) *HolidayRepository_GetDefaultCalendarInTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetDefaultCalendarInTx_Call) RunAndReturn(run func(context.Context, interfaces.Tx) (*models.Calendar, error)) *HolidayRepository_GetDefaultCalendarInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHolidays provides a mock function with given fields: ctx, calendarID
+func (_m *HolidayRepository) GetHolidays(ctx context.Context, calendarID int64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidays")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []map[string]interface{}); ok {
+		r0 = rf(ctx, calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidays'
+type HolidayRepository_GetHolidays_Call struct {
+	*mock.Call
+}
+
+// GetHolidays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+func (_e *HolidayRepository_Expecter) GetHolidays(ctx interface{}, calendarID interface{}) *HolidayRepository_GetHolidays_Call {
+	return &HolidayRepository_GetHolidays_Call{Call: _e.mock.On("GetHolidays", ctx, calendarID)}
+}
+
+func (_c *HolidayRepository_GetHolidays_Call) Run(run func(ctx context.Context, calendarID int64)) *HolidayRepository_GetHolidays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetHolidays_Call) Return(_a0 []map[string]interface{}, _a1 error) *HolidayRepository_GetHolidays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetHolidays_Call) RunAndReturn(run func(context.Context, int64) ([]map[string]interface{}, error)) *HolidayRepository_GetHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHolidaysInTx provides a mock function with given fields: ctx, tx, calendarID
+func (_m *HolidayRepository) GetHolidaysInTx(ctx context.Context, tx interfaces.Tx, calendarID int64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, tx, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidaysInTx")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, tx, calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) []map[string]interface{}); ok {
+		r0 = rf(ctx, tx, calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetHolidaysInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidaysInTx'
+type HolidayRepository_GetHolidaysInTx_Call struct {
+	*mock.Call
+}
+
+// GetHolidaysInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - calendarID int64
+func (_e *HolidayRepository_Expecter) GetHolidaysInTx(ctx interface{}, tx interface{}, calendarID interface{}) *HolidayRepository_GetHolidaysInTx_Call {
+	return &HolidayRepository_GetHolidaysInTx_Call{Call: _e.mock.On("GetHolidaysInTx", ctx, tx, calendarID)}
+}
+
+func (_c *HolidayRepository_GetHolidaysInTx_Call) Run(run func(ctx context.Context, tx interfaces.Tx, calendarID int64)) *HolidayRepository_GetHolidaysInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetHolidaysInTx_Call) Return(_a0 []map[string]interface{}, _a1 error) *HolidayRepository_GetHolidaysInTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetHolidaysInTx_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) ([]map[string]interface{}, error)) *HolidayRepository_GetHolidaysInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserWorkCalendar provides a mock function with given fields: ctx, userID, from, to
+func (_m *HolidayRepository) GetUserWorkCalendar(ctx context.Context, userID int64, from time.Time, to time.Time) (models.WorkCalendar, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserWorkCalendar")
+	}
+
+	var r0 models.WorkCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) (models.WorkCalendar, error)); ok {
+		return rf(ctx, userID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) models.WorkCalendar); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		r0 = ret.Get(0).(models.WorkCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetUserWorkCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserWorkCalendar'
+type HolidayRepository_GetUserWorkCalendar_Call struct {
+	*mock.Call
+}
+
+// GetUserWorkCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *HolidayRepository_Expecter) GetUserWorkCalendar(ctx interface{}, userID interface{}, from interface{}, to interface{}) *HolidayRepository_GetUserWorkCalendar_Call {
+	return &HolidayRepository_GetUserWorkCalendar_Call{Call: _e.mock.On("GetUserWorkCalendar", ctx, userID, from, to)}
+}
+
+func (_c *HolidayRepository_GetUserWorkCalendar_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time)) *HolidayRepository_GetUserWorkCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetUserWorkCalendar_Call) Return(_a0 models.WorkCalendar, _a1 error) *HolidayRepository_GetUserWorkCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetUserWorkCalendar_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) (models.WorkCalendar, error)) *HolidayRepository_GetUserWorkCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkCalendars provides a mock function with given fields: ctx
+func (_m *HolidayRepository) GetWorkCalendars(ctx context.Context) (map[int64]models.WorkCalendar, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkCalendars")
+	}
+
+	var r0 map[int64]models.WorkCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[int64]models.WorkCalendar, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[int64]models.WorkCalendar); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]models.WorkCalendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetWorkCalendars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkCalendars'
+type HolidayRepository_GetWorkCalendars_Call struct {
+	*mock.Call
+}
+
+// GetWorkCalendars is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HolidayRepository_Expecter) GetWorkCalendars(ctx interface{}) *HolidayRepository_GetWorkCalendars_Call {
+	return &HolidayRepository_GetWorkCalendars_Call{Call: _e.mock.On("GetWorkCalendars", ctx)}
+}
+
+func (_c *HolidayRepository_GetWorkCalendars_Call) Run(run func(ctx context.Context)) *HolidayRepository_GetWorkCalendars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetWorkCalendars_Call) Return(_a0 map[int64]models.WorkCalendar, _a1 error) *HolidayRepository_GetWorkCalendars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetWorkCalendars_Call) RunAndReturn(run func(context.Context) (map[int64]models.WorkCalendar, error)) *HolidayRepository_GetWorkCalendars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsHoliday provides a mock function with given fields: ctx, calendarID, date
+func (_m *HolidayRepository) IsHoliday(ctx context.Context, calendarID int64, date time.Time) (bool, error) {
+	ret := _m.Called(ctx, calendarID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsHoliday")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (bool, error)); ok {
+		return rf(ctx, calendarID, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) bool); ok {
+		r0 = rf(ctx, calendarID, date)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, calendarID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_IsHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsHoliday'
+type HolidayRepository_IsHoliday_Call struct {
+	*mock.Call
+}
+
+// IsHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+//   - date time.Time
+func (_e *HolidayRepository_Expecter) IsHoliday(ctx interface{}, calendarID interface{}, date interface{}) *HolidayRepository_IsHoliday_Call {
+	return &HolidayRepository_IsHoliday_Call{Call: _e.mock.On("IsHoliday", ctx, calendarID, date)}
+}
+
+func (_c *HolidayRepository_IsHoliday_Call) Run(run func(ctx context.Context, calendarID int64, date time.Time)) *HolidayRepository_IsHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_IsHoliday_Call) Return(_a0 bool, _a1 error) *HolidayRepository_IsHoliday_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_IsHoliday_Call) RunAndReturn(run func(context.Context, int64, time.Time) (bool, error)) *HolidayRepository_IsHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWorkWeek provides a mock function with given fields: ctx, tx, calendarID, workingDays, adminID
+func (_m *HolidayRepository) SetWorkWeek(ctx context.Context, tx interfaces.Tx, calendarID int64, workingDays []time.Weekday, adminID int64) error {
+	ret := _m.Called(ctx, tx, calendarID, workingDays, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWorkWeek")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, []time.Weekday, int64) error); ok {
+		r0 = rf(ctx, tx, calendarID, workingDays, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_SetWorkWeek_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkWeek'
+type HolidayRepository_SetWorkWeek_Call struct {
+	*mock.Call
+}
+
+// SetWorkWeek is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - calendarID int64
+//   - workingDays []time.Weekday
+//   - adminID int64
+func (_e *HolidayRepository_Expecter) SetWorkWeek(ctx interface{}, tx interface{}, calendarID interface{}, workingDays interface{}, adminID interface{}) *HolidayRepository_SetWorkWeek_Call {
+	return &HolidayRepository_SetWorkWeek_Call{Call: _e.mock.On("SetWorkWeek", ctx, tx, calendarID, workingDays, adminID)}
+}
+
+func (_c *HolidayRepository_SetWorkWeek_Call) Run(run func(ctx context.Context, tx interfaces.Tx, calendarID int64, workingDays []time.Weekday, adminID int64)) *HolidayRepository_SetWorkWeek_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].([]time.Weekday), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_SetWorkWeek_Call) Return(_a0 error) *HolidayRepository_SetWorkWeek_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_SetWorkWeek_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, []time.Weekday, int64) error) *HolidayRepository_SetWorkWeek_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCalendar provides a mock function with given fields: ctx, tx, calendar
+func (_m *HolidayRepository) UpdateCalendar(ctx context.Context, tx interfaces.Tx, calendar *models.Calendar) error {
+	ret := _m.Called(ctx, tx, calendar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Calendar) error); ok {
+		r0 = rf(ctx, tx, calendar)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_UpdateCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCalendar'
+type HolidayRepository_UpdateCalendar_Call struct {
+	*mock.Call
+}
+
+// UpdateCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - calendar *models.Calendar
+func (_e *HolidayRepository_Expecter) UpdateCalendar(ctx interface{}, tx interface{}, calendar interface{}) *HolidayRepository_UpdateCalendar_Call {
+	return &HolidayRepository_UpdateCalendar_Call{Call: _e.mock.On("UpdateCalendar", ctx, tx, calendar)}
+}
+
+func (_c *HolidayRepository_UpdateCalendar_Call) Run(run func(ctx context.Context, tx interfaces.Tx, calendar *models.Calendar)) *HolidayRepository_UpdateCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Calendar))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_UpdateCalendar_Call) Return(_a0 error) *HolidayRepository_UpdateCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_UpdateCalendar_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Calendar) error) *HolidayRepository_UpdateCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertHoliday provides a mock function with given fields: ctx, tx, calendarID, date, desc, adminID
+func (_m *HolidayRepository) UpsertHoliday(ctx context.Context, tx interfaces.Tx, calendarID int64, date time.Time, desc string, adminID int64) error {
+	ret := _m.Called(ctx, tx, calendarID, date, desc, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time, string, int64) error); ok {
+		r0 = rf(ctx, tx, calendarID, date, desc, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayRepository_UpsertHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertHoliday'
+type HolidayRepository_UpsertHoliday_Call struct {
+	*mock.Call
+}
+
+// UpsertHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - calendarID int64
+//   - date time.Time
+//   - desc string
+//   - adminID int64
+func (_e *HolidayRepository_Expecter) UpsertHoliday(ctx interface{}, tx interface{}, calendarID interface{}, date interface{}, desc interface{}, adminID interface{}) *HolidayRepository_UpsertHoliday_Call {
+	return &HolidayRepository_UpsertHoliday_Call{Call: _e.mock.On("UpsertHoliday", ctx, tx, calendarID, date, desc, adminID)}
+}
+
+func (_c *HolidayRepository_UpsertHoliday_Call) Run(run func(ctx context.Context, tx interfaces.Tx, calendarID int64, date time.Time, desc string, adminID int64)) *HolidayRepository_UpsertHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time), args[4].(string), args[5].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_UpsertHoliday_Call) Return(_a0 error) *HolidayRepository_UpsertHoliday_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayRepository_UpsertHoliday_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time, string, int64) error) *HolidayRepository_UpsertHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHolidayRepository creates a new instance of HolidayRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHolidayRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HolidayRepository {
+	mock := &HolidayRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,6 +2,7 @@ package leave_service
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
@@ -13,11 +14,14 @@ import (
 
 // handles business logic for leave requests
 type LeaveService struct {
-	leaveReqRepo interfaces.LeaveRequestRepository
-	balanceRepo  interfaces.BalanceRepository
-	ruleService  interfaces.RuleService
-	userRepo     interfaces.UserRepository
-	db           interfaces.DB
+	leaveReqRepo  interfaces.LeaveRequestRepository
+	balanceRepo   interfaces.BalanceRepository
+	leaveTypeRepo interfaces.LeaveTypeRepository
+	holidayRepo   interfaces.HolidayRepository
+	ruleService   interfaces.RuleService
+	chainService  interfaces.ApprovalChainService
+	userRepo      interfaces.UserRepository
+	db            interfaces.DB
 }
 
 // creates a new instance of LeaveService
@@ -25,119 +29,188 @@ func NewLeaveService(
 	ctx context.Context,
 	leaveReqRepo interfaces.LeaveRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
+	leaveTypeRepo interfaces.LeaveTypeRepository,
+	holidayRepo interfaces.HolidayRepository,
 	ruleService interfaces.RuleService,
+	chainService interfaces.ApprovalChainService,
 	userRepo interfaces.UserRepository,
 	db interfaces.DB,
 ) interfaces.LeaveService {
 	return &LeaveService{
-		leaveReqRepo: leaveReqRepo,
-		balanceRepo:  balanceRepo,
-		ruleService:  ruleService,
-		userRepo:     userRepo,
-		db:           db,
+		leaveReqRepo:  leaveReqRepo,
+		balanceRepo:   balanceRepo,
+		leaveTypeRepo: leaveTypeRepo,
+		holidayRepo:   holidayRepo,
+		ruleService:   ruleService,
+		chainService:  chainService,
+		userRepo:      userRepo,
+		db:            db,
 	}
 }
 
-// processes a leave application
+// processes a leave application. The period is charged for the working days it covers
+// and returned with the days left out.
 func (s *LeaveService) ApplyLeave(
 	ctx context.Context,
 	userID int64,
-	from time.Time,
-	to time.Time,
-	days int,
+	period models.LeavePeriod,
 	leaveType string,
 	reason string,
-) (string, string, error) {
+) (string, string, models.LeavePeriod, error) {
 	// validations
 	if userID <= 0 {
-		return "", "", apperrors.ErrInvalidUser
+		return "", "", models.LeavePeriod{}, apperrors.ErrInvalidUser
 	}
 
-	if days <= 0 {
-		return "", "", apperrors.ErrInvalidLeaveDays
+	if period.Days <= 0 {
+		return "", "", models.LeavePeriod{}, apperrors.ErrInvalidLeaveDays
 	}
 
-	if from.After(to) {
-		return "", "", apperrors.ErrInvalidDateRange
+	if period.FromDate.After(period.ToDate) {
+		return "", "", models.LeavePeriod{}, apperrors.ErrInvalidDateRange
 	}
 
 	// date validation
 	today := time.Now().Truncate(24 * time.Hour)
-	if from.Before(today) {
-		return "", "", apperrors.ErrPastDate
+	if period.FromDate.Before(today) {
+		return "", "", models.LeavePeriod{}, apperrors.ErrPastDate
+	}
+
+	leaveType = strings.ToUpper(strings.TrimSpace(leaveType))
+	if leaveType == "" {
+		return "", "", models.LeavePeriod{}, apperrors.ErrLeaveTypeRequired
+	}
+
+	// the leave type decides the wallet the days come from, if any
+	catalogType, err := s.leaveTypeRepo.GetByCode(ctx, leaveType)
+	if err != nil {
+		return "", "", models.LeavePeriod{}, err
+	}
+	if !catalogType.Active {
+		return "", "", models.LeavePeriod{}, apperrors.ErrLeaveTypeNotFound
+	}
+
+	// weekends and holidays of the requester's calendar are not charged, unless
+	// sandwiched under the type's rule
+	calendar, err := s.holidayRepo.GetUserWorkCalendar(ctx, userID, period.FromDate, period.ToDate)
+	if err != nil {
+		return "", "", models.LeavePeriod{}, err
+	}
+
+	period = utils.ChargeLeavePeriod(period, calendar, catalogType.SandwichRule)
+	if period.Days <= 0 {
+		return "", "", models.LeavePeriod{}, apperrors.ErrNoWorkingDays
 	}
 
 	// check overlap
-	overlap, err := s.leaveReqRepo.CheckOverlap(ctx, userID, from, to)
+	overlap, err := s.leaveReqRepo.CheckOverlap(ctx, userID, period)
 	if err != nil {
-		return "", "", apperrors.ErrLeaveVerificationFailed
+		return "", "", models.LeavePeriod{}, apperrors.ErrLeaveVerificationFailed
 	}
 
 	if overlap {
-		return "", "", apperrors.ErrLeaveOverlap
+		return "", "", models.LeavePeriod{}, apperrors.ErrLeaveOverlap
 	}
 
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return "", "", apperrors.ErrTransactionBegin
+		return "", "", models.LeavePeriod{}, apperrors.ErrTransactionBegin
 	}
 	defer tx.Rollback(ctx)
 
-	// leave balance
-	remaining, err := s.balanceRepo.GetLeaveBalance(ctx, tx, userID)
-	if err != nil {
-		return "", "", err
-	}
+	// leave balance of the type, unless the type bypasses it
+	if !catalogType.BypassBalance {
+		available, err := s.balanceRepo.GetLeaveBalance(ctx, tx, userID, leaveType)
+		if err != nil {
+			return "", "", models.LeavePeriod{}, err
+		}
 
-	if days > remaining {
-		return "", "", apperrors.ErrLeaveBalanceExceeded
+		if period.Days > available {
+			return "", "", models.LeavePeriod{}, apperrors.ErrLeaveBalanceExceeded
+		}
 	}
 
-	// user grade
-	gradeID, err := s.userRepo.GetGrade(ctx, tx, userID)
+	// requester details (grade, role, tenure)
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
-		return "", "", err
+		return "", "", models.LeavePeriod{}, err
 	}
 
-	// fetch rule
-	rule, err := s.ruleService.GetRule(ctx, "LEAVE", gradeID)
+	// fetch prioritized rules
+	ruleSet, err := s.ruleService.GetRuleSet(ctx, "LEAVE", user.GradeID)
 	if err != nil {
-		return "", "", apperrors.ErrRuleNotFound
+		return "", "", models.LeavePeriod{}, apperrors.ErrRuleNotFound
 	}
 
 	// apply rule
-	result := utils.MakeDecision("LEAVE", rule.Condition, float64(days))
+	facts := utils.NewRequestFacts("LEAVE", user, time.Now())
+	facts.Days = period.Days
+	facts.LeaveType = leaveType
+	facts.DayOfWeek = period.FromDate.Weekday()
+
+	result := utils.MakeRuleSetDecision(*ruleSet, facts)
 	status := result.Status
 	message := result.Message
 
 	leaveReq := &models.LeaveRequest{
-		EmployeeID: userID,
-		FromDate:   from,
-		ToDate:     to,
-		Reason:     reason,
-		LeaveType:  leaveType,
-		Status:     status,
-		RuleID:     &rule.ID,
+		EmployeeID:     userID,
+		FromDate:       period.FromDate,
+		ToDate:         period.ToDate,
+		FromSession:    period.FromSession,
+		ToSession:      period.ToSession,
+		Hours:          period.Hours,
+		Days:           period.Days,
+		CalendarID:     calendar.CalendarID,
+		Reason:         reason,
+		LeaveType:      leaveType,
+		BalanceExempt:  catalogType.BypassBalance,
+		Status:         status,
+		RuleID:         result.RuleID,
+		DecisionAction: result.Action,
+		RoutedToRole:   result.RouteToRole,
+		RoutedToUserID: result.RouteToUserID,
+		DecisionTrace:  result.Trace,
+	}
+
+	// keep the policy message on auto-rejected requests
+	if status == constants.StatusAutoRejected {
+		leaveReq.ApprovalComment = message
 	}
 
 	err = s.leaveReqRepo.Create(ctx, tx, leaveReq)
 	if err != nil {
-		return "", "", utils.MapPgError(err)
+		return "", "", models.LeavePeriod{}, utils.MapPgError(err)
+	}
+
+	// requests left for manual review go through the approval chain of their band
+	if status == constants.StatusPending && result.Action == constants.ActionManual {
+		err = s.chainService.StartChain(ctx, tx, "LEAVE", leaveReq.ID, user, period.Days)
+		if err != nil {
+			return "", "", models.LeavePeriod{}, err
+		}
 	}
 
-	// deduct if auto-approved
-	if status == constants.StatusAutoApproved {
-		err = s.balanceRepo.DeductLeaveBalance(ctx, tx, userID, days)
+	// deduct if auto-approved, hold the days while the request is pending
+	if !leaveReq.BalanceExempt {
+		switch status {
+		case constants.StatusAutoApproved:
+			err = s.balanceRepo.DeductLeaveBalance(ctx, tx, userID, leaveType, period.Days, models.BalanceChange{
+				RequestID: &leaveReq.ID,
+				Reason:    "Leave auto-approved",
+			})
+		case constants.StatusPending:
+			err = s.balanceRepo.ReserveBalance(ctx, tx, userID, "LEAVE", leaveType, period.Days)
+		}
 		if err != nil {
-			return "", "", err
+			return "", "", models.LeavePeriod{}, err
 		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return "", "", apperrors.ErrTransactionCommit
+		return "", "", models.LeavePeriod{}, apperrors.ErrTransactionCommit
 	}
 
-	return message, status, nil
+	return message, status, period, nil
 }
 
 // cancels a leave request
@@ -163,15 +236,27 @@ func (s *LeaveService) CancelLeave(ctx context.Context, userID, requestID int64)
 		return err
 	}
 
-	days := utils.CalculateLeaveDays(leaveReq.FromDate, leaveReq.ToDate)
-
 	err = s.leaveReqRepo.Cancel(ctx, tx, requestID)
 	if err != nil {
 		return err
 	}
 
-	if leaveReq.Status == constants.StatusAutoApproved {
-		err = s.balanceRepo.RestoreLeaveBalance(ctx, tx, userID, days)
+	err = s.chainService.CancelSteps(ctx, tx, "LEAVE", requestID)
+	if err != nil {
+		return err
+	}
+
+	if !leaveReq.BalanceExempt {
+		switch leaveReq.Status {
+		case constants.StatusAutoApproved:
+			err = s.balanceRepo.RestoreLeaveBalance(ctx, tx, userID, leaveReq.BalanceLeaveType, leaveReq.Days, models.BalanceChange{
+				RequestID: &requestID,
+				ActorID:   &userID,
+				Reason:    "Leave cancelled",
+			})
+		case constants.StatusPending:
+			err = s.balanceRepo.ReleaseReservation(ctx, tx, userID, "LEAVE", leaveReq.BalanceLeaveType, leaveReq.Days)
+		}
 		if err != nil {
 			return err
 		}
@@ -182,10 +267,12 @@ func (s *LeaveService) CancelLeave(ctx context.Context, userID, requestID int64)
 
 // handles business logic for leave approval operations
 type LeaveApprovalService struct {
-	leaveReqRepo interfaces.LeaveRequestRepository
-	balanceRepo  interfaces.BalanceRepository
-	userRepo     interfaces.UserRepository
-	db           interfaces.DB
+	leaveReqRepo      interfaces.LeaveRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	userRepo          interfaces.UserRepository
+	db                interfaces.DB
 }
 
 // creates a new instance of LeaveApprovalService
@@ -193,14 +280,18 @@ func NewLeaveApprovalService(
 	ctx context.Context,
 	leaveReqRepo interfaces.LeaveRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
 	userRepo interfaces.UserRepository,
 	db interfaces.DB,
 ) interfaces.LeaveApprovalService {
 	return &LeaveApprovalService{
-		leaveReqRepo: leaveReqRepo,
-		balanceRepo:  balanceRepo,
-		userRepo:     userRepo,
-		db:           db,
+		leaveReqRepo:      leaveReqRepo,
+		balanceRepo:       balanceRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		userRepo:          userRepo,
+		db:                db,
 	}
 }
 
@@ -208,7 +299,12 @@ func NewLeaveApprovalService(
 func (s *LeaveApprovalService) GetPendingLeaveRequests(ctx context.Context, role string, approverID int64, limit, offset int) ([]map[string]interface{}, int, error) {
 	switch role {
 	case constants.RoleManager:
-		return s.leaveReqRepo.GetPendingForManager(ctx, approverID, limit, offset)
+		// delegates also see the queues of the managers they stand in for
+		approverIDs, err := s.delegationService.GetApproverIDs(ctx, approverID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return s.leaveReqRepo.GetPendingForManager(ctx, approverIDs, limit, offset)
 	case constants.RoleAdmin:
 		return s.leaveReqRepo.GetPendingForAdmin(ctx, limit, offset)
 	default:
@@ -258,17 +354,41 @@ func (s *LeaveApprovalService) ApproveLeave(
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
+	// approvers standing in for a delegator decide as the delegator
+	actor, err := s.delegationService.ResolveActor(ctx, tx, role, approverID, leaveReq.EmployeeID, requesterRole, leaveReq.RoutedToRole, leaveReq.RoutedToUserID)
+	if err != nil {
 		return err
 	}
 
-	days := utils.CalculateLeaveDays(leaveReq.FromDate, leaveReq.ToDate)
+	if err := utils.ValidateRequestApprover(actor.Role, actor.UserID, requesterRole, leaveReq.RoutedToRole, leaveReq.RoutedToUserID); err != nil {
+		return err
+	}
 
-	// Deduct leave balance
-	err = s.balanceRepo.DeductLeaveBalance(ctx, tx, leaveReq.EmployeeID, days)
+	// the request stays pending until the last step of its approval chain approves
+	final, err := s.chainService.ApproveStep(ctx, tx, "LEAVE", requestID, approverID, actor.Delegation, approvalComment)
 	if err != nil {
 		return err
 	}
+	if !final {
+		return tx.Commit(ctx)
+	}
+
+	// the days held while the request was pending are consumed
+	if !leaveReq.BalanceExempt {
+		err = s.balanceRepo.ReleaseReservation(ctx, tx, leaveReq.EmployeeID, "LEAVE", leaveReq.BalanceLeaveType, leaveReq.Days)
+		if err != nil {
+			return err
+		}
+
+		err = s.balanceRepo.DeductLeaveBalance(ctx, tx, leaveReq.EmployeeID, leaveReq.BalanceLeaveType, leaveReq.Days, models.BalanceChange{
+			RequestID: &requestID,
+			ActorID:   &approverID,
+			Reason:    "Leave approved",
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	// Default comment if not provided
 	if approvalComment == "" {
@@ -281,6 +401,11 @@ func (s *LeaveApprovalService) ApproveLeave(
 		return err
 	}
 
+	err = s.delegationService.RecordDecision(ctx, tx, "LEAVE", requestID, actor)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -326,7 +451,18 @@ func (s *LeaveApprovalService) RejectLeave(
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
+	// approvers standing in for a delegator decide as the delegator
+	actor, err := s.delegationService.ResolveActor(ctx, tx, role, approverID, leaveReq.EmployeeID, requesterRole, leaveReq.RoutedToRole, leaveReq.RoutedToUserID)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.ValidateRequestApprover(actor.Role, actor.UserID, requesterRole, leaveReq.RoutedToRole, leaveReq.RoutedToUserID); err != nil {
+		return err
+	}
+
+	err = s.chainService.RejectStep(ctx, tx, "LEAVE", requestID, approverID, actor.Delegation, rejectionComment)
+	if err != nil {
 		return err
 	}
 
@@ -336,5 +472,17 @@ func (s *LeaveApprovalService) RejectLeave(
 		return err
 	}
 
+	if !leaveReq.BalanceExempt {
+		err = s.balanceRepo.ReleaseReservation(ctx, tx, leaveReq.EmployeeID, "LEAVE", leaveReq.BalanceLeaveType, leaveReq.Days)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.delegationService.RecordDecision(ctx, tx, "LEAVE", requestID, actor)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
@@ -0,0 +1,94 @@
+package leave_types
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles leave type HTTP requests
+type LeaveTypeHandler struct {
+	leaveTypeService interfaces.LeaveTypeService
+}
+
+// creates a new LeaveTypeHandler instance
+func NewLeaveTypeHandler(ctx context.Context, leaveTypeService interfaces.LeaveTypeService) *LeaveTypeHandler {
+	return &LeaveTypeHandler{leaveTypeService: leaveTypeService}
+}
+
+func (h *LeaveTypeHandler) GetLeaveTypes(c *gin.Context) {
+	ctx := c.Request.Context()
+	leaveTypes, err := h.leaveTypeService.GetLeaveTypes(ctx)
+	if err != nil {
+		handleLeaveTypeError(c, err)
+		return
+	}
+
+	response.Success(c, "leave types fetched successfully", leaveTypes)
+}
+
+func (h *LeaveTypeHandler) SetLeaveType(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+	if role != constants.RoleAdmin {
+		handleLeaveTypeError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	var leaveType models.LeaveType
+	if err := c.ShouldBindJSON(&leaveType); err != nil {
+		handleLeaveTypeError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+	leaveType.Code = strings.ToUpper(c.Param("code"))
+
+	ctx := c.Request.Context()
+	saved, err := h.leaveTypeService.SetLeaveType(ctx, role, adminID, leaveType)
+	if err != nil {
+		handleLeaveTypeError(c, err)
+		return
+	}
+
+	response.Success(c, "leave type saved successfully", saved)
+}
+
+func (h *LeaveTypeHandler) DeleteLeaveType(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleLeaveTypeError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.leaveTypeService.DeleteLeaveType(ctx, role, strings.ToUpper(c.Param("code"))); err != nil {
+		handleLeaveTypeError(c, err)
+		return
+	}
+
+	response.Success(c, "leave type deleted successfully", nil)
+}
+
+func handleLeaveTypeError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrLeaveTypeNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidLeaveTypeCode,
+		apperrors.ErrLeaveTypeNameRequired, apperrors.ErrInvalidLeaveAllocation,
+		apperrors.ErrForeignKeyViolation:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,299 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LeaveTypeRepository is an autogenerated mock type for the LeaveTypeRepository type
+type LeaveTypeRepository struct {
+	mock.Mock
+}
+
+type LeaveTypeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LeaveTypeRepository) EXPECT() *LeaveTypeRepository_Expecter {
+	return &LeaveTypeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Deactivate provides a mock function with given fields: ctx, code
+func (_m *LeaveTypeRepository) Deactivate(ctx context.Context, code string) error {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deactivate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveTypeRepository_Deactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deactivate'
+type LeaveTypeRepository_Deactivate_Call struct {
+	*mock.Call
+}
+
+// Deactivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *LeaveTypeRepository_Expecter) Deactivate(ctx interface{}, code interface{}) *LeaveTypeRepository_Deactivate_Call {
+	return &LeaveTypeRepository_Deactivate_Call{Call: _e.mock.On("Deactivate", ctx, code)}
+}
+
+func (_c *LeaveTypeRepository_Deactivate_Call) Run(run func(ctx context.Context, code string)) *LeaveTypeRepository_Deactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeRepository_Deactivate_Call) Return(_a0 error) *LeaveTypeRepository_Deactivate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveTypeRepository_Deactivate_Call) RunAndReturn(run func(context.Context, string) error) *LeaveTypeRepository_Deactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *LeaveTypeRepository) GetAll(ctx context.Context) ([]models.LeaveType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.LeaveType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.LeaveType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveTypeRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type LeaveTypeRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LeaveTypeRepository_Expecter) GetAll(ctx interface{}) *LeaveTypeRepository_GetAll_Call {
+	return &LeaveTypeRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *LeaveTypeRepository_GetAll_Call) Run(run func(ctx context.Context)) *LeaveTypeRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeRepository_GetAll_Call) Return(_a0 []models.LeaveType, _a1 error) *LeaveTypeRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveTypeRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.LeaveType, error)) *LeaveTypeRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCode provides a mock function with given fields: ctx, code
+func (_m *LeaveTypeRepository) GetByCode(ctx context.Context, code string) (*models.LeaveType, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCode")
+	}
+
+	var r0 *models.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.LeaveType, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.LeaveType); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveTypeRepository_GetByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCode'
+type LeaveTypeRepository_GetByCode_Call struct {
+	*mock.Call
+}
+
+// GetByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *LeaveTypeRepository_Expecter) GetByCode(ctx interface{}, code interface{}) *LeaveTypeRepository_GetByCode_Call {
+	return &LeaveTypeRepository_GetByCode_Call{Call: _e.mock.On("GetByCode", ctx, code)}
+}
+
+func (_c *LeaveTypeRepository_GetByCode_Call) Run(run func(ctx context.Context, code string)) *LeaveTypeRepository_GetByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeRepository_GetByCode_Call) Return(_a0 *models.LeaveType, _a1 error) *LeaveTypeRepository_GetByCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveTypeRepository_GetByCode_Call) RunAndReturn(run func(context.Context, string) (*models.LeaveType, error)) *LeaveTypeRepository_GetByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceAllocations provides a mock function with given fields: ctx, tx, code, allocations
+func (_m *LeaveTypeRepository) ReplaceAllocations(ctx context.Context, tx interfaces.Tx, code string, allocations []models.LeaveTypeAllocation) error {
+	ret := _m.Called(ctx, tx, code, allocations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceAllocations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, []models.LeaveTypeAllocation) error); ok {
+		r0 = rf(ctx, tx, code, allocations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveTypeRepository_ReplaceAllocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceAllocations'
+type LeaveTypeRepository_ReplaceAllocations_Call struct {
+	*mock.Call
+}
+
+// ReplaceAllocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - code string
+//   - allocations []models.LeaveTypeAllocation
+func (_e *LeaveTypeRepository_Expecter) ReplaceAllocations(ctx interface{}, tx interface{}, code interface{}, allocations interface{}) *LeaveTypeRepository_ReplaceAllocations_Call {
+	return &LeaveTypeRepository_ReplaceAllocations_Call{Call: _e.mock.On("ReplaceAllocations", ctx, tx, code, allocations)}
+}
+
+func (_c *LeaveTypeRepository_ReplaceAllocations_Call) Run(run func(ctx context.Context, tx interfaces.Tx, code string, allocations []models.LeaveTypeAllocation)) *LeaveTypeRepository_ReplaceAllocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].([]models.LeaveTypeAllocation))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeRepository_ReplaceAllocations_Call) Return(_a0 error) *LeaveTypeRepository_ReplaceAllocations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveTypeRepository_ReplaceAllocations_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, []models.LeaveTypeAllocation) error) *LeaveTypeRepository_ReplaceAllocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, tx, leaveType
+func (_m *LeaveTypeRepository) Upsert(ctx context.Context, tx interfaces.Tx, leaveType *models.LeaveType) error {
+	ret := _m.Called(ctx, tx, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.LeaveType) error); ok {
+		r0 = rf(ctx, tx, leaveType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveTypeRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type LeaveTypeRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - leaveType *models.LeaveType
+func (_e *LeaveTypeRepository_Expecter) Upsert(ctx interface{}, tx interface{}, leaveType interface{}) *LeaveTypeRepository_Upsert_Call {
+	return &LeaveTypeRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, tx, leaveType)}
+}
+
+func (_c *LeaveTypeRepository_Upsert_Call) Run(run func(ctx context.Context, tx interfaces.Tx, leaveType *models.LeaveType)) *LeaveTypeRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.LeaveType))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeRepository_Upsert_Call) Return(_a0 error) *LeaveTypeRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveTypeRepository_Upsert_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.LeaveType) error) *LeaveTypeRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLeaveTypeRepository creates a new instance of LeaveTypeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLeaveTypeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LeaveTypeRepository {
+	mock := &LeaveTypeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,116 @@
+package leave_types
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+var leaveTypeCodePattern = regexp.MustCompile(`^[A-Z0-9_]{1,20}$`)
+
+// LeaveTypeService manages the leave type catalog
+type LeaveTypeService struct {
+	leaveTypeRepo interfaces.LeaveTypeRepository
+	balanceRepo   interfaces.BalanceRepository
+	db            interfaces.DB
+}
+
+// NewLeaveTypeService creates a new instance of LeaveTypeService
+func NewLeaveTypeService(
+	ctx context.Context,
+	leaveTypeRepo interfaces.LeaveTypeRepository,
+	balanceRepo interfaces.BalanceRepository,
+	db interfaces.DB,
+) interfaces.LeaveTypeService {
+	return &LeaveTypeService{
+		leaveTypeRepo: leaveTypeRepo,
+		balanceRepo:   balanceRepo,
+		db:            db,
+	}
+}
+
+// GetLeaveTypes lists the leave types requests can be made for
+func (s *LeaveTypeService) GetLeaveTypes(ctx context.Context) ([]models.LeaveType, error) {
+	return s.leaveTypeRepo.GetAll(ctx)
+}
+
+// SetLeaveType creates or replaces a leave type with its allocations (admin only). Users
+// of the allocated grades who have no wallet of the type yet get one with the allocation
+// of their grade; existing wallets take a changed allocation at their next reset.
+func (s *LeaveTypeService) SetLeaveType(ctx context.Context, role string, adminID int64, leaveType models.LeaveType) (*models.LeaveType, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if err := validateLeaveType(&leaveType); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	leaveType.UpdatedBy = &adminID
+	if err := s.leaveTypeRepo.Upsert(ctx, tx, &leaveType); err != nil {
+		return nil, err
+	}
+
+	if err := s.leaveTypeRepo.ReplaceAllocations(ctx, tx, leaveType.Code, leaveType.Allocations); err != nil {
+		return nil, err
+	}
+
+	// types that bypass the balance have no wallets
+	if !leaveType.BypassBalance {
+		if _, err := s.balanceRepo.OpenLeaveBalances(ctx, tx, leaveType.Code); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return &leaveType, nil
+}
+
+// DeleteLeaveType deactivates a leave type (admin only). No new requests can be made for
+// it; its wallets and the requests already made are kept.
+func (s *LeaveTypeService) DeleteLeaveType(ctx context.Context, role string, code string) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrUnauthorized
+	}
+	return s.leaveTypeRepo.Deactivate(ctx, code)
+}
+
+// validateLeaveType checks a leave type and its allocations
+func validateLeaveType(leaveType *models.LeaveType) error {
+	if !leaveTypeCodePattern.MatchString(leaveType.Code) {
+		return apperrors.ErrInvalidLeaveTypeCode
+	}
+
+	leaveType.Name = strings.TrimSpace(leaveType.Name)
+	if leaveType.Name == "" {
+		return apperrors.ErrLeaveTypeNameRequired
+	}
+
+	if leaveType.Allocations == nil {
+		leaveType.Allocations = []models.LeaveTypeAllocation{}
+	}
+
+	grades := map[int64]bool{}
+	for _, allocation := range leaveType.Allocations {
+		if allocation.GradeID <= 0 || allocation.AnnualDays < 0 || grades[allocation.GradeID] {
+			return apperrors.ErrInvalidLeaveAllocation
+		}
+		grades[allocation.GradeID] = true
+	}
+
+	return nil
+}
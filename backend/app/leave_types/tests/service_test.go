@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_types"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_types/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaveTypeService_SetLeaveType(t *testing.T) {
+	ctx := context.Background()
+	adminID := int64(1)
+
+	tests := []struct {
+		name        string
+		role        string
+		leaveType   models.LeaveType
+		mockSetup   func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedErr error
+	}{
+		{
+			name: "Opens Wallets",
+			role: constants.RoleAdmin,
+			leaveType: models.LeaveType{Code: "SICK", Name: " Sick Leave ", Allocations: []models.LeaveTypeAllocation{
+				{GradeID: 1, AnnualDays: 6},
+				{GradeID: 2, AnnualDays: 8},
+			}},
+			mockSetup: func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().Upsert(ctx, tx, mock.MatchedBy(func(lt *models.LeaveType) bool {
+					return lt.Code == "SICK" && lt.Name == "Sick Leave" && *lt.UpdatedBy == adminID
+				})).Return(nil)
+				l.EXPECT().ReplaceAllocations(ctx, tx, "SICK", []models.LeaveTypeAllocation{
+					{GradeID: 1, AnnualDays: 6},
+					{GradeID: 2, AnnualDays: 8},
+				}).Return(nil)
+				b.EXPECT().OpenLeaveBalances(ctx, tx, "SICK").Return(int64(12), nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Bypass Type Has No Wallets",
+			role:      constants.RoleAdmin,
+			leaveType: models.LeaveType{Code: "UNPAID", Name: "Unpaid Leave", BypassBalance: true},
+			mockSetup: func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().Upsert(ctx, tx, mock.Anything).Return(nil)
+				l.EXPECT().ReplaceAllocations(ctx, tx, "UNPAID", []models.LeaveTypeAllocation{}).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:      "Unknown Grade",
+			role:      constants.RoleAdmin,
+			leaveType: models.LeaveType{Code: "SICK", Name: "Sick Leave", Allocations: []models.LeaveTypeAllocation{{GradeID: 99, AnnualDays: 6}}},
+			mockSetup: func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().Upsert(ctx, tx, mock.Anything).Return(nil)
+				l.EXPECT().ReplaceAllocations(ctx, tx, "SICK", mock.Anything).Return(apperrors.ErrForeignKeyViolation)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedErr: apperrors.ErrForeignKeyViolation,
+		},
+		{
+			name:        "Invalid Code",
+			role:        constants.RoleAdmin,
+			leaveType:   models.LeaveType{Code: "sick leave", Name: "Sick Leave"},
+			mockSetup:   func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidLeaveTypeCode,
+		},
+		{
+			name:        "Missing Name",
+			role:        constants.RoleAdmin,
+			leaveType:   models.LeaveType{Code: "SICK", Name: "  "},
+			mockSetup:   func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrLeaveTypeNameRequired,
+		},
+		{
+			name: "Duplicate Grade",
+			role: constants.RoleAdmin,
+			leaveType: models.LeaveType{Code: "SICK", Name: "Sick Leave", Allocations: []models.LeaveTypeAllocation{
+				{GradeID: 1, AnnualDays: 6},
+				{GradeID: 1, AnnualDays: 8},
+			}},
+			mockSetup:   func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidLeaveAllocation,
+		},
+		{
+			name:        "Negative Allocation",
+			role:        constants.RoleAdmin,
+			leaveType:   models.LeaveType{Code: "SICK", Name: "Sick Leave", Allocations: []models.LeaveTypeAllocation{{GradeID: 1, AnnualDays: -1}}},
+			mockSetup:   func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrInvalidLeaveAllocation,
+		},
+		{
+			name:        "Not Admin",
+			role:        constants.RoleManager,
+			leaveType:   models.LeaveType{Code: "SICK", Name: "Sick Leave"},
+			mockSetup:   func(l *mocks.LeaveTypeRepository, b *mocks.BalanceRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedErr: apperrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLeaveTypeRepo := mocks.NewLeaveTypeRepository(t)
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockLeaveTypeRepo, mockBalanceRepo, mockDB, mockTx)
+
+			service := leave_types.NewLeaveTypeService(ctx, mockLeaveTypeRepo, mockBalanceRepo, mockDB)
+			leaveType, err := service.SetLeaveType(ctx, tt.role, adminID, tt.leaveType)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.leaveType.Code, leaveType.Code)
+		})
+	}
+}
+
+func TestLeaveTypeService_DeleteLeaveType(t *testing.T) {
+	ctx := context.Background()
+
+	mockLeaveTypeRepo := mocks.NewLeaveTypeRepository(t)
+	service := leave_types.NewLeaveTypeService(ctx, mockLeaveTypeRepo, mocks.NewBalanceRepository(t), mocks.NewDB(t))
+
+	assert.ErrorIs(t, service.DeleteLeaveType(ctx, constants.RoleEmployee, "SICK"), apperrors.ErrUnauthorized)
+
+	mockLeaveTypeRepo.EXPECT().Deactivate(ctx, "MISSING").Return(apperrors.ErrLeaveTypeNotFound)
+	mockLeaveTypeRepo.EXPECT().Deactivate(ctx, "SICK").Return(nil)
+
+	assert.ErrorIs(t, service.DeleteLeaveType(ctx, constants.RoleAdmin, "MISSING"), apperrors.ErrLeaveTypeNotFound)
+	assert.NoError(t, service.DeleteLeaveType(ctx, constants.RoleAdmin, "SICK"))
+}
@@ -3,16 +3,19 @@ package my_requests
 import (
 	"context"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 )
 
 type MyRequestsService struct {
-	myRequestsRepo interfaces.MyRequestsRepository
+	myRequestsRepo    interfaces.MyRequestsRepository
+	delegationService interfaces.DelegationService
 }
 
-func NewMyRequestsService(ctx context.Context, myRequestsRepo interfaces.MyRequestsRepository) interfaces.MyRequestsService {
+func NewMyRequestsService(ctx context.Context, myRequestsRepo interfaces.MyRequestsRepository, delegationService interfaces.DelegationService) interfaces.MyRequestsService {
 	return &MyRequestsService{
-		myRequestsRepo: myRequestsRepo,
+		myRequestsRepo:    myRequestsRepo,
+		delegationService: delegationService,
 	}
 }
 
@@ -45,7 +48,17 @@ func (s *MyRequestsService) GetMyAllRequests(ctx context.Context, userID int64,
 	return result, nil
 }
 func (s *MyRequestsService) GetPendingAllRequests(ctx context.Context, role string, userID int64, limit, offset int) (map[string]interface{}, error) {
-	leaves, expenses, discounts, total, err := s.myRequestsRepo.GetPendingAllRequests(ctx, role, userID, limit, offset)
+	// admins see every pending request; managers also see the queues they cover as delegates
+	var approverIDs []int64
+	if role != constants.RoleAdmin {
+		ids, err := s.delegationService.GetApproverIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		approverIDs = ids
+	}
+
+	leaves, expenses, discounts, total, err := s.myRequestsRepo.GetPendingAllRequests(ctx, role, approverIDs, limit, offset)
 	if err != nil {
 		return nil, err
 	}
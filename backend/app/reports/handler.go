@@ -70,6 +70,23 @@ func (h *ReportHandler) GetRequestsByType(c *gin.Context) {
 	response.Success(c, "requests by type fetched", data)
 }
 
+func (h *ReportHandler) GetLeaveByCalendar(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleReportError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.reportService.GetLeaveByCalendar(ctx, role)
+	if err != nil {
+		handleReportError(c, err)
+		return
+	}
+
+	response.Success(c, "leave by calendar fetched", data)
+}
+
 func handleReportError(c *gin.Context, err error) {
 	status := http.StatusInternalServerError
 
@@ -5,9 +5,8 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ReportRepository is an autogenerated mock type for the ReportRepository type
@@ -23,6 +22,232 @@ func (_m *ReportRepository) EXPECT() *ReportRepository_Expecter {
 	return &ReportRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetLeaveByCalendar provides a mock function with given fields: ctx
+func (_m *ReportRepository) GetLeaveByCalendar(ctx context.Context) ([]models.CalendarLeaveReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveByCalendar")
+	}
+
+	var r0 []models.CalendarLeaveReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.CalendarLeaveReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.CalendarLeaveReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CalendarLeaveReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_GetLeaveByCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveByCalendar'
+type ReportRepository_GetLeaveByCalendar_Call struct {
+	*mock.Call
+}
+
+// GetLeaveByCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportRepository_Expecter) GetLeaveByCalendar(ctx interface{}) *ReportRepository_GetLeaveByCalendar_Call {
+	return &ReportRepository_GetLeaveByCalendar_Call{Call: _e.mock.On("GetLeaveByCalendar", ctx)}
+}
+
+func (_c *ReportRepository_GetLeaveByCalendar_Call) Run(run func(ctx context.Context)) *ReportRepository_GetLeaveByCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_GetLeaveByCalendar_Call) Return(_a0 []models.CalendarLeaveReport, _a1 error) *ReportRepository_GetLeaveByCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_GetLeaveByCalendar_Call) RunAndReturn(run func(context.Context) ([]models.CalendarLeaveReport, error)) *ReportRepository_GetLeaveByCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingDiscountCount provides a mock function with given fields: ctx
+func (_m *ReportRepository) GetPendingDiscountCount(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingDiscountCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_GetPendingDiscountCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingDiscountCount'
+type ReportRepository_GetPendingDiscountCount_Call struct {
+	*mock.Call
+}
+
+// GetPendingDiscountCount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportRepository_Expecter) GetPendingDiscountCount(ctx interface{}) *ReportRepository_GetPendingDiscountCount_Call {
+	return &ReportRepository_GetPendingDiscountCount_Call{Call: _e.mock.On("GetPendingDiscountCount", ctx)}
+}
+
+func (_c *ReportRepository_GetPendingDiscountCount_Call) Run(run func(ctx context.Context)) *ReportRepository_GetPendingDiscountCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_GetPendingDiscountCount_Call) Return(_a0 int, _a1 error) *ReportRepository_GetPendingDiscountCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_GetPendingDiscountCount_Call) RunAndReturn(run func(context.Context) (int, error)) *ReportRepository_GetPendingDiscountCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingExpenseCount provides a mock function with given fields: ctx
+func (_m *ReportRepository) GetPendingExpenseCount(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingExpenseCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_GetPendingExpenseCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingExpenseCount'
+type ReportRepository_GetPendingExpenseCount_Call struct {
+	*mock.Call
+}
+
+// GetPendingExpenseCount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportRepository_Expecter) GetPendingExpenseCount(ctx interface{}) *ReportRepository_GetPendingExpenseCount_Call {
+	return &ReportRepository_GetPendingExpenseCount_Call{Call: _e.mock.On("GetPendingExpenseCount", ctx)}
+}
+
+func (_c *ReportRepository_GetPendingExpenseCount_Call) Run(run func(ctx context.Context)) *ReportRepository_GetPendingExpenseCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_GetPendingExpenseCount_Call) Return(_a0 int, _a1 error) *ReportRepository_GetPendingExpenseCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_GetPendingExpenseCount_Call) RunAndReturn(run func(context.Context) (int, error)) *ReportRepository_GetPendingExpenseCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingLeaveCount provides a mock function with given fields: ctx
+func (_m *ReportRepository) GetPendingLeaveCount(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingLeaveCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_GetPendingLeaveCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingLeaveCount'
+type ReportRepository_GetPendingLeaveCount_Call struct {
+	*mock.Call
+}
+
+// GetPendingLeaveCount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportRepository_Expecter) GetPendingLeaveCount(ctx interface{}) *ReportRepository_GetPendingLeaveCount_Call {
+	return &ReportRepository_GetPendingLeaveCount_Call{Call: _e.mock.On("GetPendingLeaveCount", ctx)}
+}
+
+func (_c *ReportRepository_GetPendingLeaveCount_Call) Run(run func(ctx context.Context)) *ReportRepository_GetPendingLeaveCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_GetPendingLeaveCount_Call) Return(_a0 int, _a1 error) *ReportRepository_GetPendingLeaveCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_GetPendingLeaveCount_Call) RunAndReturn(run func(context.Context) (int, error)) *ReportRepository_GetPendingLeaveCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRequestStatusDistribution provides a mock function with given fields: ctx
 func (_m *ReportRepository) GetRequestStatusDistribution(ctx context.Context) (map[string]int, error) {
 	ret := _m.Called(ctx)
@@ -32,27 +32,16 @@ func (s *ReportService) GetDashboardSummary(ctx context.Context, role string) (m
 		return nil, err
 	}
 
-	// Get pending counts by type
-	pendingLeave, err := s.reportRepo.GetPendingLeaveCount(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	pendingExpense, err := s.reportRepo.GetPendingExpenseCount(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	pendingDiscount, err := s.reportRepo.GetPendingDiscountCount(ctx)
+	pending, err := s.GetPendingCounts(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Build pending distribution by type
 	pendingByType := map[string]interface{}{
-		"leave":    pendingLeave,
-		"expense":  pendingExpense,
-		"discount": pendingDiscount,
+		"leave":    pending["leave"],
+		"expense":  pending["expense"],
+		"discount": pending["discount"],
 	}
 
 	return map[string]interface{}{
@@ -80,3 +69,37 @@ func (s *ReportService) GetRequestStatusDistribution(ctx context.Context) (map[s
 func (s *ReportService) GetRequestsByTypeReport(ctx context.Context) ([]models.RequestTypeReport, error) {
 	return s.reportRepo.GetRequestsByTypeReport(ctx)
 }
+
+// GetPendingCounts counts the requests waiting for a decision, by type
+func (s *ReportService) GetPendingCounts(ctx context.Context) (map[string]int, error) {
+	pendingLeave, err := s.reportRepo.GetPendingLeaveCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingExpense, err := s.reportRepo.GetPendingExpenseCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingDiscount, err := s.reportRepo.GetPendingDiscountCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]int{
+		"leave":    pendingLeave,
+		"expense":  pendingExpense,
+		"discount": pendingDiscount,
+	}, nil
+}
+
+// GetLeaveByCalendar totals the approved leave per work calendar (admin only). Each
+// request counts on the calendar of its requester when it was applied for, which is
+// also the calendar its days were charged on.
+func (s *ReportService) GetLeaveByCalendar(ctx context.Context, role string) ([]models.CalendarLeaveReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+	return s.reportRepo.GetLeaveByCalendar(ctx)
+}
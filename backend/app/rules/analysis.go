@@ -0,0 +1,171 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+var analyzedRequestTypes = []string{"LEAVE", "EXPENSE", "DISCOUNT"}
+
+// AnalyzeRules checks every grade and request type for missing rules, conflicting or
+// unreachable rules and thresholds above the grade limits (admin only)
+func (s *RuleService) AnalyzeRules(ctx context.Context, role string) (*models.RuleAnalysis, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	grades, err := s.gradeRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	modes, err := s.ruleRepo.GetEvaluationModes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	activeLeaveTypes, err := s.leaveTypeRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	leaveTypes := map[string]models.LeaveType{}
+	for _, leaveType := range activeLeaveTypes {
+		leaveTypes[leaveType.Code] = leaveType
+	}
+
+	modeByGroup := map[string]string{}
+	for _, m := range modes {
+		modeByGroup[fmt.Sprintf("%s/%d", m.RequestType, m.GradeID)] = m.Mode
+	}
+
+	// only the rules deciding requests right now, kept in priority order
+	now := time.Now()
+	rulesByGroup := map[string][]models.Rule{}
+	for _, rule := range rules {
+		if !rule.Active || rule.EffectiveFrom.After(now) {
+			continue
+		}
+		if rule.EffectiveTo != nil && !rule.EffectiveTo.After(now) {
+			continue
+		}
+		group := fmt.Sprintf("%s/%d", rule.RequestType, rule.GradeID)
+		rulesByGroup[group] = append(rulesByGroup[group], rule)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	analysis := &models.RuleAnalysis{Issues: []models.RuleIssue{}}
+	for _, grade := range grades {
+		leaveLimit, expenseLimit, discountLimit, err := s.gradeRepo.GetLimits(ctx, tx, grade.ID)
+		if err != nil {
+			return nil, err
+		}
+		limits := map[string]float64{
+			utils.FieldDays:    float64(leaveLimit),
+			utils.FieldAmount:  expenseLimit,
+			utils.FieldPercent: discountLimit,
+		}
+
+		for _, requestType := range analyzedRequestTypes {
+			group := fmt.Sprintf("%s/%d", requestType, grade.ID)
+			groupRules := rulesByGroup[group]
+
+			if len(groupRules) == 0 {
+				analysis.Issues = append(analysis.Issues, models.RuleIssue{
+					Kind:        constants.IssueMissingRules,
+					Severity:    constants.SeverityError,
+					RequestType: requestType,
+					GradeID:     grade.ID,
+					Message:     fmt.Sprintf("grade %s has no active %s rule; its %s requests fail with %q", grade.Name, requestType, requestType, apperrors.ErrRuleNotFound),
+				})
+				continue
+			}
+
+			mode := modeByGroup[group]
+			if mode == "" {
+				mode = constants.RuleModeFirstMatch
+			}
+
+			analysis.Issues = append(analysis.Issues, utils.AnalyzeRuleSet(models.RuleSet{
+				RequestType:    requestType,
+				GradeID:        grade.ID,
+				EvaluationMode: mode,
+				Rules:          groupRules,
+			})...)
+			analysis.Issues = append(analysis.Issues, limitIssues(grade, requestType, groupRules, limits, leaveTypes)...)
+		}
+	}
+
+	for _, issue := range analysis.Issues {
+		if issue.Severity == constants.SeverityError {
+			analysis.Errors++
+		} else {
+			analysis.Warnings++
+		}
+	}
+
+	return analysis, nil
+}
+
+// limitIssues reports condition thresholds above the grade limits; limits can be lowered after a rule was saved.
+// A rule pinned to one leave type is held to that type's allocation for the grade instead of the grade's leave limit.
+func limitIssues(grade models.Grade, requestType string, rules []models.Rule, limits map[string]float64, leaveTypes map[string]models.LeaveType) []models.RuleIssue {
+	var issues []models.RuleIssue
+	for _, rule := range rules {
+		if len(rule.Condition) == 0 {
+			continue
+		}
+
+		cond, err := utils.ParseCondition(requestType, rule.Condition)
+		if err != nil {
+			// already reported as unreachable
+			continue
+		}
+
+		var pinned *models.LeaveType
+		if leaveType, ok := leaveTypes[utils.PinnedLeaveType(cond)]; ok {
+			pinned = &leaveType
+		}
+
+		for _, cmp := range utils.ConditionComparisons(cond) {
+			limit, limited := limits[cmp.Field]
+			limitName := fmt.Sprintf("limit of grade %s", grade.Name)
+			if pinned != nil && cmp.Field == utils.FieldDays {
+				limit, limited = leaveTypeDayLimit(*pinned, grade.ID)
+				limitName = fmt.Sprintf("%s allocation of grade %s", pinned.Code, grade.Name)
+			}
+			if !limited {
+				continue
+			}
+			for _, value := range cmp.Numbers {
+				if value <= limit {
+					continue
+				}
+				issues = append(issues, models.RuleIssue{
+					Kind:        constants.IssueExceedsLimit,
+					Severity:    constants.SeverityError,
+					RequestType: requestType,
+					GradeID:     grade.ID,
+					RuleIDs:     []int64{rule.ID},
+					Message:     fmt.Sprintf("rule %d compares %s with %v, above the %v %s", rule.ID, cmp.Field, value, limit, limitName),
+				})
+			}
+		}
+	}
+	return issues
+}
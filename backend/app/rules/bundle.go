@@ -0,0 +1,561 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// policyState is the current policy of the database, keyed the way bundles key it
+type policyState struct {
+	bundle   models.PolicyBundle
+	gradeIDs map[string]int64
+	// bundles carry the holidays of the default calendar
+	calendarID int64
+	// open rule versions and their bundle form by rule key, in bundle order
+	ruleKeys     []string
+	ruleVersions map[string]models.Rule
+	bundleRules  map[string]models.BundleRule
+}
+
+// ExportBundle returns the grades, holidays, evaluation modes and current rules as a policy bundle (admin only)
+func (s *RuleService) ExportBundle(ctx context.Context, role string) (*models.PolicyBundle, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	state, err := s.loadPolicy(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := state.bundle
+	bundle.Version = constants.PolicyBundleVersion
+	bundle.ExportedAt = time.Now().UTC()
+
+	return &bundle, nil
+}
+
+// ImportBundle makes the database match the bundle in one transaction and returns what changed.
+// Grades missing from the bundle are kept because users reference them; holidays and rules
+// missing from it are removed. With preview set the changes are validated and rolled back (admin only).
+func (s *RuleService) ImportBundle(ctx context.Context, role string, adminID int64, bundle models.PolicyBundle, preview bool) (*models.BundleDiff, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if bundle.Version != constants.PolicyBundleVersion {
+		return nil, apperrors.ErrUnsupportedBundleVersion
+	}
+
+	// YAML numbers decode as integers, conditions are compared and stored in their JSON form
+	for i := range bundle.Rules {
+		cond, err := normalizeCondition(bundle.Rules[i].Condition)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Rules[i].Condition = cond
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	// the diff is taken against the policy as it stands while nothing else can change it
+	if err := s.ruleRepo.LockPolicy(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	state, err := s.loadPolicy(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &models.BundleDiff{
+		Grades:          []models.BundleChange{},
+		Holidays:        []models.BundleChange{},
+		EvaluationModes: []models.BundleChange{},
+		Rules:           []models.BundleChange{},
+	}
+
+	if err := s.importGrades(ctx, tx, state, bundle, diff); err != nil {
+		return nil, err
+	}
+	if err := s.importHolidays(ctx, tx, adminID, state, bundle, diff); err != nil {
+		return nil, err
+	}
+	if err := s.importEvaluationModes(ctx, tx, state, bundle, diff); err != nil {
+		return nil, err
+	}
+	if err := s.importRules(ctx, tx, state, bundle, diff); err != nil {
+		return nil, err
+	}
+
+	if preview {
+		return diff, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	diff.Applied = true
+	return diff, nil
+}
+
+func (s *RuleService) importGrades(ctx context.Context, tx interfaces.Tx, state *policyState, bundle models.PolicyBundle, diff *models.BundleDiff) error {
+	current := map[string]models.BundleGrade{}
+	for _, g := range state.bundle.Grades {
+		current[g.Name] = g
+	}
+
+	seen := map[string]bool{}
+	for _, g := range bundle.Grades {
+		if g.Name == "" {
+			return apperrors.ErrGradeNameRequired
+		}
+		if seen[g.Name] {
+			return apperrors.ErrDuplicateBundleEntry
+		}
+		seen[g.Name] = true
+
+		if g.AnnualLeaveLimit < 0 || g.AnnualExpenseLimit < 0 || g.DiscountLimitPercent < 0 {
+			return apperrors.ErrNegativeValue
+		}
+
+		before, exists := current[g.Name]
+		if exists && before == g {
+			continue
+		}
+
+		grade := models.Grade{
+			Name:                 g.Name,
+			AnnualLeaveLimit:     g.AnnualLeaveLimit,
+			AnnualExpenseLimit:   g.AnnualExpenseLimit,
+			DiscountLimitPercent: g.DiscountLimitPercent,
+		}
+		if err := s.gradeRepo.Upsert(ctx, tx, &grade); err != nil {
+			return err
+		}
+
+		state.gradeIDs[g.Name] = grade.ID
+		diff.Grades = append(diff.Grades, bundleChange(exists, g.Name, before, g))
+	}
+
+	return nil
+}
+
+func (s *RuleService) importHolidays(ctx context.Context, tx interfaces.Tx, adminID int64, state *policyState, bundle models.PolicyBundle, diff *models.BundleDiff) error {
+	current := map[string]models.BundleHoliday{}
+	for _, h := range state.bundle.Holidays {
+		current[h.Date] = h
+	}
+
+	wanted := map[string]bool{}
+	for _, h := range bundle.Holidays {
+		date, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			return apperrors.ErrInvalidDateFormat
+		}
+		if wanted[h.Date] {
+			return apperrors.ErrDuplicateBundleEntry
+		}
+		wanted[h.Date] = true
+
+		before, exists := current[h.Date]
+		if exists && before == h {
+			continue
+		}
+
+		if err := s.holidayRepo.UpsertHoliday(ctx, tx, state.calendarID, date, h.Description, adminID); err != nil {
+			return err
+		}
+		diff.Holidays = append(diff.Holidays, bundleChange(exists, h.Date, before, h))
+	}
+
+	for _, h := range state.bundle.Holidays {
+		if wanted[h.Date] {
+			continue
+		}
+
+		date, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			return apperrors.ErrInvalidDateFormat
+		}
+		if err := s.holidayRepo.DeleteHolidayByDate(ctx, tx, state.calendarID, date); err != nil {
+			return err
+		}
+		diff.Holidays = append(diff.Holidays, models.BundleChange{Change: constants.ChangeRemoved, Key: h.Date, Before: h})
+	}
+
+	return nil
+}
+
+// importEvaluationModes compares effective modes, so a pair missing from either side counts as FIRST_MATCH
+func (s *RuleService) importEvaluationModes(ctx context.Context, tx interfaces.Tx, state *policyState, bundle models.PolicyBundle, diff *models.BundleDiff) error {
+	current := map[string]string{}
+	for _, m := range state.bundle.EvaluationModes {
+		current[m.RequestType+"/"+m.Grade] = m.Mode
+	}
+
+	setMode := func(requestType, grade, before, after string) error {
+		if before == "" {
+			before = constants.RuleModeFirstMatch
+		}
+		if before == after {
+			return nil
+		}
+
+		if err := s.ruleRepo.SetEvaluationMode(ctx, tx, requestType, state.gradeIDs[grade], after); err != nil {
+			return err
+		}
+		diff.EvaluationModes = append(diff.EvaluationModes, models.BundleChange{
+			Change: constants.ChangeChanged,
+			Key:    requestType + "/" + grade,
+			Before: before,
+			After:  after,
+		})
+		return nil
+	}
+
+	wanted := map[string]bool{}
+	for _, m := range bundle.EvaluationModes {
+		if !utils.IsValidRequestType(m.RequestType) {
+			return apperrors.ErrInvalidRequestType
+		}
+		if !utils.IsValidRuleMode(m.Mode) {
+			return apperrors.ErrInvalidRuleMode
+		}
+		if _, ok := state.gradeIDs[m.Grade]; !ok {
+			return apperrors.ErrUnknownBundleGrade
+		}
+
+		key := m.RequestType + "/" + m.Grade
+		if wanted[key] {
+			return apperrors.ErrDuplicateBundleEntry
+		}
+		wanted[key] = true
+
+		if err := setMode(m.RequestType, m.Grade, current[key], m.Mode); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range state.bundle.EvaluationModes {
+		if wanted[m.RequestType+"/"+m.Grade] {
+			continue
+		}
+		if err := setMode(m.RequestType, m.Grade, m.Mode, constants.RuleModeFirstMatch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importRules closes removed rules, stores changed rules as new versions and creates added ones
+func (s *RuleService) importRules(ctx context.Context, tx interfaces.Tx, state *policyState, bundle models.PolicyBundle, diff *models.BundleDiff) error {
+	keys := bundleRuleKeys(bundle.Rules)
+	wanted := map[string]models.Rule{}
+	defaults := map[string]bool{}
+
+	for i, br := range bundle.Rules {
+		rule, err := s.fromBundleRule(ctx, br, state.gradeIDs)
+		if err != nil {
+			return err
+		}
+
+		if rule.IsDefault && rule.Active {
+			group := br.RequestType + "/" + br.Grade
+			if defaults[group] {
+				return apperrors.ErrDefaultRuleExists
+			}
+			defaults[group] = true
+		}
+
+		if err := s.validateRuleInTx(ctx, tx, rule); err != nil {
+			return err
+		}
+
+		wanted[keys[i]] = rule
+	}
+
+	now := time.Now()
+
+	// removals go first so a dropped default rule frees its slot
+	for _, key := range state.ruleKeys {
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+
+		existing := state.ruleVersions[key]
+		if err := s.ruleRepo.CloseOpenVersions(ctx, tx, existing.RuleKey, laterOf(now, existing.EffectiveFrom)); err != nil {
+			return err
+		}
+		diff.Rules = append(diff.Rules, models.BundleChange{Change: constants.ChangeRemoved, Key: key, Before: state.bundleRules[key]})
+	}
+
+	// non-default rules before default ones for the same reason
+	for _, defaultPass := range []bool{false, true} {
+		for i, key := range keys {
+			rule := wanted[key]
+			if rule.IsDefault != defaultPass {
+				continue
+			}
+
+			before, exists := state.bundleRules[key]
+			if exists && reflect.DeepEqual(before, bundle.Rules[i]) {
+				continue
+			}
+
+			if exists {
+				latest, err := s.ruleRepo.GetLatestVersion(ctx, tx, state.ruleVersions[key].ID)
+				if err != nil {
+					return err
+				}
+				rule.EffectiveFrom = laterOf(now, latest.EffectiveFrom)
+				if err := s.replaceVersion(ctx, tx, latest, &rule); err != nil {
+					return err
+				}
+			} else {
+				rule.EffectiveFrom = now
+				err := s.ruleRepo.Create(ctx, tx, &rule)
+				if err == apperrors.ErrDuplicateEntry {
+					return apperrors.ErrDefaultRuleExists
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			diff.Rules = append(diff.Rules, bundleChange(exists, key, before, bundle.Rules[i]))
+		}
+	}
+
+	return nil
+}
+
+// loadPolicy reads the current grades, holidays of the default calendar, evaluation modes
+// and open rule versions as the transaction sees them
+func (s *RuleService) loadPolicy(ctx context.Context, tx interfaces.Tx) (*policyState, error) {
+	state := &policyState{
+		bundle: models.PolicyBundle{
+			Grades:          []models.BundleGrade{},
+			Holidays:        []models.BundleHoliday{},
+			EvaluationModes: []models.BundleEvaluationMode{},
+			Rules:           []models.BundleRule{},
+		},
+		gradeIDs:     map[string]int64{},
+		ruleVersions: map[string]models.Rule{},
+		bundleRules:  map[string]models.BundleRule{},
+	}
+
+	grades, err := s.gradeRepo.GetAllInTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	gradeNames := map[int64]string{}
+	for _, g := range grades {
+		gradeNames[g.ID] = g.Name
+		state.gradeIDs[g.Name] = g.ID
+		state.bundle.Grades = append(state.bundle.Grades, models.BundleGrade{
+			Name:                 g.Name,
+			AnnualLeaveLimit:     g.AnnualLeaveLimit,
+			AnnualExpenseLimit:   g.AnnualExpenseLimit,
+			DiscountLimitPercent: g.DiscountLimitPercent,
+		})
+	}
+
+	calendar, err := s.holidayRepo.GetDefaultCalendarInTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	state.calendarID = calendar.ID
+
+	holidays, err := s.holidayRepo.GetHolidaysInTx(ctx, tx, calendar.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range holidays {
+		date, _ := h["date"].(string)
+		desc, _ := h["description"].(string)
+		state.bundle.Holidays = append(state.bundle.Holidays, models.BundleHoliday{Date: date, Description: desc})
+	}
+
+	modes, err := s.ruleRepo.GetEvaluationModesInTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range modes {
+		state.bundle.EvaluationModes = append(state.bundle.EvaluationModes, models.BundleEvaluationMode{
+			RequestType: m.RequestType,
+			Grade:       gradeNames[m.GradeID],
+			Mode:        m.Mode,
+		})
+	}
+
+	rules, err := s.ruleRepo.GetAllInTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the open version of each rule is its current or scheduled form
+	var open []models.Rule
+	emails := map[int64]string{}
+	for _, rule := range rules {
+		if rule.EffectiveTo != nil {
+			continue
+		}
+
+		br, err := s.toBundleRule(ctx, rule, gradeNames, emails)
+		if err != nil {
+			return nil, err
+		}
+
+		open = append(open, rule)
+		state.bundle.Rules = append(state.bundle.Rules, br)
+	}
+
+	state.ruleKeys = bundleRuleKeys(state.bundle.Rules)
+	for i, key := range state.ruleKeys {
+		state.ruleVersions[key] = open[i]
+		state.bundleRules[key] = state.bundle.Rules[i]
+	}
+
+	return state, nil
+}
+
+func (s *RuleService) toBundleRule(ctx context.Context, rule models.Rule, gradeNames map[int64]string, emails map[int64]string) (models.BundleRule, error) {
+	condition := rule.Condition
+	if condition == nil {
+		condition = map[string]interface{}{}
+	}
+
+	br := models.BundleRule{
+		RequestType: rule.RequestType,
+		Grade:       gradeNames[rule.GradeID],
+		Priority:    rule.Priority,
+		IsDefault:   rule.IsDefault,
+		Active:      rule.Active,
+		Action:      rule.Action,
+		Condition:   condition,
+		Message:     rule.Message,
+		RouteToRole: rule.RouteToRole,
+	}
+
+	if rule.RouteToUserID != nil {
+		email, ok := emails[*rule.RouteToUserID]
+		if !ok {
+			user, err := s.userRepo.GetByID(ctx, *rule.RouteToUserID)
+			if err != nil {
+				return models.BundleRule{}, err
+			}
+			email = user.Email
+			emails[*rule.RouteToUserID] = email
+		}
+		br.RouteToUserEmail = email
+	}
+
+	return br, nil
+}
+
+func (s *RuleService) fromBundleRule(ctx context.Context, br models.BundleRule, gradeIDs map[string]int64) (models.Rule, error) {
+	gradeID, ok := gradeIDs[br.Grade]
+	if !ok {
+		return models.Rule{}, apperrors.ErrUnknownBundleGrade
+	}
+
+	rule := models.Rule{
+		RequestType: br.RequestType,
+		GradeID:     gradeID,
+		Priority:    br.Priority,
+		IsDefault:   br.IsDefault,
+		Active:      br.Active,
+		Action:      br.Action,
+		Condition:   br.Condition,
+		Message:     br.Message,
+		RouteToRole: br.RouteToRole,
+	}
+
+	if br.RouteToUserEmail != "" {
+		user, err := s.userRepo.GetByEmail(ctx, br.RouteToUserEmail)
+		if err != nil {
+			return models.Rule{}, err
+		}
+		rule.RouteToUserID = &user.ID
+	}
+
+	return rule, nil
+}
+
+// bundleRuleKeys names rules by request type, grade and priority, which is what stays
+// stable across environments. Rules sharing a priority are numbered in order.
+func bundleRuleKeys(rules []models.BundleRule) []string {
+	keys := make([]string, len(rules))
+	seen := map[string]int{}
+
+	for i, r := range rules {
+		key := fmt.Sprintf("%s/%s/%d", r.RequestType, r.Grade, r.Priority)
+		if r.IsDefault {
+			key = fmt.Sprintf("%s/%s/default", r.RequestType, r.Grade)
+		}
+
+		seen[key]++
+		if seen[key] > 1 {
+			key = fmt.Sprintf("%s#%d", key, seen[key])
+		}
+		keys[i] = key
+	}
+
+	return keys
+}
+
+func bundleChange(existed bool, key string, before, after interface{}) models.BundleChange {
+	if !existed {
+		return models.BundleChange{Change: constants.ChangeAdded, Key: key, After: after}
+	}
+	return models.BundleChange{Change: constants.ChangeChanged, Key: key, Before: before, After: after}
+}
+
+// normalizeCondition round-trips a condition through JSON so numbers are float64 as the parser expects
+func normalizeCondition(condition map[string]interface{}) (map[string]interface{}, error) {
+	if condition == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	raw, err := json.Marshal(condition)
+	if err != nil {
+		return nil, apperrors.ErrInvalidConditionJSON
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, apperrors.ErrInvalidConditionJSON
+	}
+
+	return normalized, nil
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,11 @@
+package rules
+
+type EvaluationModeRequest struct {
+	RequestType string `json:"request_type"`
+	GradeID     int64  `json:"grade_id"`
+	Mode        string `json:"mode"`
+}
+
+type RollbackRequest struct {
+	Version int `json:"version"`
+}
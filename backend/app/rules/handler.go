@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -116,18 +117,220 @@ func (h *RuleHandler) DeleteRule(c *gin.Context) {
 	response.Success(c, "Rule deleted successfully", nil)
 }
 
+func (h *RuleHandler) SetEvaluationMode(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var req EvaluationModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.ruleService.SetEvaluationMode(ctx, role, req.RequestType, req.GradeID, req.Mode); err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Evaluation mode updated successfully", nil)
+}
+
+func (h *RuleHandler) SimulateRules(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var sim models.RuleSimulation
+	if err := c.ShouldBindJSON(&sim); err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	report, err := h.ruleService.SimulateRules(ctx, role, sim)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule simulation completed", report)
+}
+
+func (h *RuleHandler) GetRuleHistory(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ruleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	history, err := h.ruleService.GetRuleHistory(ctx, role, ruleID)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule history fetched successfully", history)
+}
+
+func (h *RuleHandler) RollbackRule(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ruleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	var req RollbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	rule, err := h.ruleService.RollbackRule(ctx, role, ruleID, req.Version)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule rolled back successfully", rule)
+}
+
+func (h *RuleHandler) AnalyzeRules(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	analysis, err := h.ruleService.AnalyzeRules(ctx, role)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule analysis completed", analysis)
+}
+
+// ExportRules downloads the policy bundle as a plain JSON or YAML document so it can be
+// imported elsewhere unchanged
+func (h *RuleHandler) ExportRules(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "yaml" {
+		handleRuleError(c, apperrors.ErrInvalidBundleFormat, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	bundle, err := h.ruleService.ExportBundle(ctx, role)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="policy-bundle.`+format+`"`)
+	if format == "yaml" {
+		c.YAML(http.StatusOK, bundle)
+		return
+	}
+	c.JSON(http.StatusOK, bundle)
+}
+
+func (h *RuleHandler) ImportRules(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var bundle models.PolicyBundle
+	var err error
+	if strings.Contains(c.ContentType(), "yaml") {
+		err = c.ShouldBindYAML(&bundle)
+	} else {
+		err = c.ShouldBindJSON(&bundle)
+	}
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	preview := c.Query("preview") == "true"
+
+	ctx := c.Request.Context()
+	diff, err := h.ruleService.ImportBundle(ctx, role, adminID, bundle, preview)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	if preview {
+		response.Success(c, "Policy bundle preview generated", diff)
+		return
+	}
+	response.Success(c, "Policy bundle imported successfully", diff)
+}
+
 func handleRuleError(c *gin.Context, err error, detail error) {
 	status := http.StatusInternalServerError
 	switch err {
 	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
 		status = http.StatusForbidden
-	case apperrors.ErrNoRuleFound, apperrors.ErrRuleNotFoundForDelete:
+	case apperrors.ErrNoRuleFound, apperrors.ErrRuleNotFoundForDelete,
+		apperrors.ErrRuleVersionNotFound:
 		status = http.StatusNotFound
 	case apperrors.ErrRequestTypeRequired, apperrors.ErrActionRequired,
 		apperrors.ErrGradeIDRequired, apperrors.ErrConditionRequired,
 		apperrors.ErrInvalidConditionJSON, apperrors.ErrInvalidID,
-		apperrors.ErrInvalidRequestPayload:
+		apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidRequestType,
+		apperrors.ErrInvalidCondition, apperrors.ErrUnknownConditionField,
+		apperrors.ErrUnsupportedConditionOperator, apperrors.ErrConditionTypeMismatch,
+		apperrors.ErrNegativeValue, apperrors.ErrQuotaExceeded,
+		apperrors.ErrInvalidRuleMode, apperrors.ErrInvalidRulePriority,
+		apperrors.ErrInvalidRuleAction, apperrors.ErrRouteTargetRequired,
+		apperrors.ErrRouteTargetNotAllowed, apperrors.ErrInvalidRouteTarget,
+		apperrors.ErrUserNotFound, apperrors.ErrSimulationRules,
+		apperrors.ErrSimulationSource, apperrors.ErrInvalidDateFormat,
+		apperrors.ErrInvalidDateRange, apperrors.ErrInvalidEffectiveDate,
+		apperrors.ErrUnsupportedBundleVersion, apperrors.ErrInvalidBundleFormat,
+		apperrors.ErrGradeNameRequired, apperrors.ErrUnknownBundleGrade,
+		apperrors.ErrDuplicateBundleEntry:
 		status = http.StatusBadRequest
+	case apperrors.ErrDefaultRuleExists:
+		status = http.StatusConflict
 	}
 
 	message := err.Error()
@@ -2,35 +2,94 @@ package rules
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 // RuleService handles business logic for rule management
 type RuleService struct {
-	ruleRepo  interfaces.RuleRepository
-	gradeRepo interfaces.GradeRepository
-	db        interfaces.DB
+	ruleRepo      interfaces.RuleRepository
+	gradeRepo     interfaces.GradeRepository
+	userRepo      interfaces.UserRepository
+	holidayRepo   interfaces.HolidayRepository
+	leaveTypeRepo interfaces.LeaveTypeRepository
+	db            interfaces.DB
 }
 
 // NewRuleService creates a new instance of RuleService
-func NewRuleService(ctx context.Context, ruleRepo interfaces.RuleRepository, gradeRepo interfaces.GradeRepository, db interfaces.DB) interfaces.RuleService {
+func NewRuleService(ctx context.Context, ruleRepo interfaces.RuleRepository, gradeRepo interfaces.GradeRepository, userRepo interfaces.UserRepository, holidayRepo interfaces.HolidayRepository, leaveTypeRepo interfaces.LeaveTypeRepository, db interfaces.DB) interfaces.RuleService {
 	return &RuleService{
-		ruleRepo:  ruleRepo,
-		gradeRepo: gradeRepo,
-		db:        db,
+		ruleRepo:      ruleRepo,
+		gradeRepo:     gradeRepo,
+		userRepo:      userRepo,
+		holidayRepo:   holidayRepo,
+		leaveTypeRepo: leaveTypeRepo,
+		db:            db,
 	}
 }
 
-// GetRule retrieves a rule by request type and grade ID
-func (s *RuleService) GetRule(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error) {
-	return s.ruleRepo.GetByTypeAndGrade(ctx, requestType, gradeID)
+// GetRuleSet retrieves the prioritized active rules and evaluation mode for a request type and grade
+func (s *RuleService) GetRuleSet(ctx context.Context, requestType string, gradeID int64) (*models.RuleSet, error) {
+	rules, err := s.ruleRepo.GetActiveByTypeAndGrade(ctx, requestType, gradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := s.ruleRepo.GetEvaluationMode(ctx, requestType, gradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RuleSet{
+		RequestType:    requestType,
+		GradeID:        gradeID,
+		EvaluationMode: mode,
+		Rules:          rules,
+	}, nil
+}
+
+// SetEvaluationMode configures first-match or all-match evaluation for a request type and grade (admin only)
+func (s *RuleService) SetEvaluationMode(ctx context.Context, role string, requestType string, gradeID int64, mode string) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrUnauthorized
+	}
+
+	if !utils.IsValidRequestType(requestType) {
+		return apperrors.ErrInvalidRequestType
+	}
+
+	if gradeID == 0 {
+		return apperrors.ErrGradeIDRequired
+	}
+
+	if !utils.IsValidRuleMode(mode) {
+		return apperrors.ErrInvalidRuleMode
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.ruleRepo.SetEvaluationMode(ctx, tx, requestType, gradeID, mode); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return apperrors.ErrTransactionCommit
+	}
+
+	return nil
 }
 
-// CreateRule creates or updates a rule (admin only)
+// CreateRule creates a new prioritized rule (admin only)
 func (s *RuleService) CreateRule(ctx context.Context, role string, rule models.Rule) error {
 	if role != constants.RoleAdmin {
 		return apperrors.ErrUnauthorized
@@ -40,11 +99,28 @@ func (s *RuleService) CreateRule(ctx context.Context, role string, rule models.R
 		return err
 	}
 
-	err := s.ruleRepo.Create(ctx, &rule)
+	if rule.Condition == nil {
+		rule.Condition = map[string]interface{}{}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	err = s.ruleRepo.Create(ctx, tx, &rule)
+	if err == apperrors.ErrDuplicateEntry {
+		return apperrors.ErrDefaultRuleExists
+	}
 	if err != nil {
 		return apperrors.ErrDatabase
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return apperrors.ErrTransactionCommit
+	}
+
 	return nil
 }
 
@@ -57,7 +133,8 @@ func (s *RuleService) GetRules(ctx context.Context, role string) ([]models.Rule,
 	return s.ruleRepo.GetAll(ctx)
 }
 
-// updates an existing rule (admin only)
+// UpdateRule closes the current version of a rule and stores the change as a new version
+// that takes over at effective_from, or immediately when it is not set (admin only)
 func (s *RuleService) UpdateRule(ctx context.Context, role string, ruleID int64, rule models.Rule) error {
 	if role != constants.RoleAdmin {
 		return apperrors.ErrUnauthorized
@@ -67,33 +144,384 @@ func (s *RuleService) UpdateRule(ctx context.Context, role string, ruleID int64,
 		return err
 	}
 
-	return s.ruleRepo.Update(ctx, ruleID, &rule)
+	if rule.Condition == nil {
+		rule.Condition = map[string]interface{}{}
+	}
+
+	now := time.Now()
+	if rule.EffectiveFrom.IsZero() {
+		rule.EffectiveFrom = now
+	}
+	if rule.EffectiveFrom.Before(now) {
+		return apperrors.ErrInvalidEffectiveDate
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	latest, err := s.ruleRepo.GetLatestVersion(ctx, tx, ruleID)
+	if err != nil {
+		return err
+	}
+
+	// a deleted rule stays in history but can only come back through a rollback
+	if latest.EffectiveTo != nil && !latest.EffectiveTo.After(now) {
+		return apperrors.ErrNoRuleFound
+	}
+
+	if rule.EffectiveFrom.Before(latest.EffectiveFrom) {
+		return apperrors.ErrInvalidEffectiveDate
+	}
+
+	if err := s.replaceVersion(ctx, tx, latest, &rule); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return apperrors.ErrTransactionCommit
+	}
+
+	return nil
 }
 
-// deletes a rule by ID (admin only)
+// DeleteRule ends the current version of a rule and drops any scheduled one; its history
+// is kept (admin only)
 func (s *RuleService) DeleteRule(ctx context.Context, role string, ruleID int64) error {
 	if role != constants.RoleAdmin {
 		return apperrors.ErrUnauthorized
 	}
 
-	return s.ruleRepo.Delete(ctx, ruleID)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	latest, err := s.ruleRepo.GetLatestVersion(ctx, tx, ruleID)
+	if err == apperrors.ErrNoRuleFound {
+		return apperrors.ErrRuleNotFoundForDelete
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if latest.EffectiveTo != nil && !latest.EffectiveTo.After(now) {
+		return apperrors.ErrRuleNotFoundForDelete
+	}
+
+	if err := s.ruleRepo.CloseOpenVersions(ctx, tx, latest.RuleKey, now); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return apperrors.ErrTransactionCommit
+	}
+
+	return nil
 }
 
-func (s *RuleService) validateRule(ctx context.Context, rule models.Rule) error {
-	if rule.RequestType == "" {
-		return apperrors.ErrRequestTypeRequired
+// GetRuleHistory lists every version of a rule, oldest first (admin only)
+func (s *RuleService) GetRuleHistory(ctx context.Context, role string, ruleID int64) ([]models.Rule, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	return s.ruleRepo.GetHistory(ctx, ruleID)
+}
+
+// RollbackRule re-publishes an earlier version of a rule as its newest version, effective
+// immediately. The rolled back versions stay in history (admin only).
+func (s *RuleService) RollbackRule(ctx context.Context, role string, ruleID int64, version int) (*models.Rule, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
 	}
 
-	if rule.Action != constants.StatusAutoApprove {
-		return apperrors.ErrActionRequired
+	history, err := s.ruleRepo.GetHistory(ctx, ruleID)
+	if err != nil {
+		return nil, err
 	}
 
-	if rule.GradeID == 0 {
-		return apperrors.ErrGradeIDRequired
+	var target *models.Rule
+	for i := range history {
+		if history[i].Version == version {
+			target = &history[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, apperrors.ErrRuleVersionNotFound
+	}
+
+	// grade limits or route targets may have changed since the version was written
+	if err := s.validateRule(ctx, *target); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
 	}
+	defer tx.Rollback(ctx)
 
-	if rule.Condition == nil || len(rule.Condition) == 0 {
-		return apperrors.ErrConditionRequired
+	latest, err := s.ruleRepo.GetLatestVersion(ctx, tx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := *target
+	restored.EffectiveFrom = time.Now()
+	if err := s.replaceVersion(ctx, tx, latest, &restored); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return &restored, nil
+}
+
+// replaceVersion closes every version of the rule still open where next takes over, the
+// current one and any scheduled one, and stores next as the following version
+func (s *RuleService) replaceVersion(ctx context.Context, tx interfaces.Tx, latest *models.Rule, next *models.Rule) error {
+	if err := s.ruleRepo.CloseOpenVersions(ctx, tx, latest.RuleKey, next.EffectiveFrom); err != nil {
+		return err
+	}
+
+	next.RuleKey = latest.RuleKey
+	next.Version = latest.Version + 1
+	next.EffectiveTo = nil
+
+	err := s.ruleRepo.InsertVersion(ctx, tx, next)
+	if err == apperrors.ErrDuplicateEntry {
+		return apperrors.ErrDefaultRuleExists
+	}
+	return err
+}
+
+// SimulateRules replays a draft rule set against a hypothetical request or a date range of
+// historical requests and compares it with the live rules. Nothing is written (admin only).
+func (s *RuleService) SimulateRules(ctx context.Context, role string, sim models.RuleSimulation) (*models.SimulationReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if !utils.IsValidRequestType(sim.RequestType) {
+		return nil, apperrors.ErrInvalidRequestType
+	}
+
+	if sim.GradeID == 0 {
+		return nil, apperrors.ErrGradeIDRequired
+	}
+
+	if sim.EvaluationMode == "" {
+		sim.EvaluationMode = constants.RuleModeFirstMatch
+	}
+	if !utils.IsValidRuleMode(sim.EvaluationMode) {
+		return nil, apperrors.ErrInvalidRuleMode
+	}
+
+	if len(sim.Rules) == 0 {
+		return nil, apperrors.ErrSimulationRules
+	}
+
+	hasRange := sim.From != "" || sim.To != ""
+	if (sim.Request != nil) == hasRange {
+		return nil, apperrors.ErrSimulationSource
+	}
+
+	// every draft rule is validated exactly as it would be on save
+	defaults := 0
+	for i := range sim.Rules {
+		sim.Rules[i].RequestType = sim.RequestType
+		sim.Rules[i].GradeID = sim.GradeID
+		sim.Rules[i].Active = true
+
+		if err := s.validateRule(ctx, sim.Rules[i]); err != nil {
+			return nil, err
+		}
+		if sim.Rules[i].IsDefault {
+			defaults++
+		}
+	}
+	if defaults > 1 {
+		return nil, apperrors.ErrDefaultRuleExists
+	}
+
+	sort.SliceStable(sim.Rules, func(i, j int) bool {
+		return sim.Rules[i].Priority < sim.Rules[j].Priority
+	})
+
+	draft := models.RuleSet{
+		RequestType:    sim.RequestType,
+		GradeID:        sim.GradeID,
+		EvaluationMode: sim.EvaluationMode,
+		Rules:          sim.Rules,
+	}
+
+	// no live rules means every request currently goes to manual review
+	current, err := s.GetRuleSet(ctx, sim.RequestType, sim.GradeID)
+	if err == apperrors.ErrNoRuleFound {
+		current = &models.RuleSet{
+			RequestType:    sim.RequestType,
+			GradeID:        sim.GradeID,
+			EvaluationMode: constants.RuleModeFirstMatch,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	report := &models.SimulationReport{
+		Transitions: map[string]int{},
+		Results:     []models.SimulationResult{},
+	}
+
+	if sim.Request != nil {
+		facts, err := s.hypotheticalFacts(ctx, sim)
+		if err != nil {
+			return nil, err
+		}
+
+		result := compareDecisions(report, *current, draft, facts)
+		report.Results = append(report.Results, result)
+		return report, nil
+	}
+
+	from, err := time.Parse("2006-01-02", sim.From)
+	if err != nil {
+		return nil, apperrors.ErrInvalidDateFormat
+	}
+	to, err := time.Parse("2006-01-02", sim.To)
+	if err != nil {
+		return nil, apperrors.ErrInvalidDateFormat
+	}
+	if from.After(to) {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+
+	samples, err := s.ruleRepo.GetSimulationSamples(ctx, sim.RequestType, sim.GradeID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sample := range samples {
+		facts := sampleFacts(sim.RequestType, sample)
+
+		// only changed historical requests are listed, the counters cover all of them
+		result := compareDecisions(report, *current, draft, facts)
+		if result.Changed {
+			requestID := sample.RequestID
+			result.RequestID = &requestID
+			report.Results = append(report.Results, result)
+		}
+	}
+
+	return report, nil
+}
+
+// hypotheticalFacts builds the facts of a request that was never filed
+func (s *RuleService) hypotheticalFacts(ctx context.Context, sim models.RuleSimulation) (utils.RequestFacts, error) {
+	req := sim.Request
+
+	date := time.Now()
+	if req.Date != "" {
+		parsed, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return utils.RequestFacts{}, apperrors.ErrInvalidDateFormat
+		}
+		date = parsed
+	}
+
+	requester := &models.User{
+		GradeID:   sim.GradeID,
+		Role:      constants.RoleEmployee,
+		CreatedAt: date.AddDate(0, 0, -req.TenureDays),
+	}
+	if req.Role != "" {
+		requester.Role = req.Role
+	}
+
+	if req.UserID != 0 {
+		user, err := s.userRepo.GetByID(ctx, req.UserID)
+		if err != nil {
+			return utils.RequestFacts{}, err
+		}
+		requester.Role = user.Role
+		requester.CreatedAt = user.CreatedAt
+	}
+
+	facts := utils.NewRequestFacts(sim.RequestType, requester, date)
+	facts.Days = req.Days
+	facts.LeaveType = req.LeaveType
+	facts.Amount = req.Amount
+	facts.Category = req.Category
+	facts.Percent = req.Percent
+
+	return facts, nil
+}
+
+// sampleFacts rebuilds the facts a stored request was decided on
+func sampleFacts(requestType string, sample models.SimulationSample) utils.RequestFacts {
+	requester := &models.User{
+		GradeID:   sample.GradeID,
+		Role:      sample.Role,
+		CreatedAt: sample.UserCreatedAt,
+	}
+
+	facts := utils.NewRequestFacts(requestType, requester, sample.CreatedAt)
+	switch requestType {
+	case "LEAVE":
+		facts.Days = sample.Days
+		facts.LeaveType = sample.LeaveType
+		facts.DayOfWeek = sample.FromDate.Weekday()
+	case "EXPENSE":
+		facts.Amount = sample.Amount
+		facts.Category = sample.Category
+	case "DISCOUNT":
+		facts.Percent = sample.Percent
+	}
+
+	return facts
+}
+
+// compareDecisions evaluates both rule sets and records the outcome change in the report
+func compareDecisions(report *models.SimulationReport, current, draft models.RuleSet, facts utils.RequestFacts) models.SimulationResult {
+	before := utils.MakeRuleSetDecision(current, facts)
+	after := utils.MakeRuleSetDecision(draft, facts)
+
+	result := models.SimulationResult{
+		CurrentStatus:   before.Status,
+		SimulatedStatus: after.Status,
+		Changed:         before.Status != after.Status,
+		Trace:           after.Trace,
+	}
+
+	report.Evaluated++
+	if !result.Changed {
+		return result
+	}
+
+	report.Changed++
+	report.Transitions[before.Status+"->"+after.Status]++
+
+	switch {
+	case before.Status == constants.StatusAutoApproved && after.Status == constants.StatusPending:
+		report.AutoApprovedToPending++
+	case before.Status == constants.StatusPending && after.Status == constants.StatusAutoApproved:
+		report.PendingToAutoApproved++
+	}
+
+	return result
+}
+
+func (s *RuleService) validateRule(ctx context.Context, rule models.Rule) error {
+	cond, err := checkRule(rule)
+	if err != nil {
+		return err
 	}
 
 	// Fetch grade limits for validation
@@ -103,37 +531,142 @@ func (s *RuleService) validateRule(ctx context.Context, rule models.Rule) error
 	}
 	defer tx.Rollback(ctx)
 
+	return s.validateRuleLimits(ctx, tx, rule, cond)
+}
+
+// validateRuleInTx is validateRule for callers that already hold a transaction,
+// so grades written earlier in it are taken into account
+func (s *RuleService) validateRuleInTx(ctx context.Context, tx interfaces.Tx, rule models.Rule) error {
+	cond, err := checkRule(rule)
+	if err != nil {
+		return err
+	}
+
+	return s.validateRuleLimits(ctx, tx, rule, cond)
+}
+
+// checkRule runs the checks that need no database and returns the parsed condition, nil
+// for a default rule without one
+func checkRule(rule models.Rule) (utils.Condition, error) {
+	if rule.RequestType == "" {
+		return nil, apperrors.ErrRequestTypeRequired
+	}
+
+	if !utils.IsValidRequestType(rule.RequestType) {
+		return nil, apperrors.ErrInvalidRequestType
+	}
+
+	if rule.Action == "" {
+		return nil, apperrors.ErrActionRequired
+	}
+
+	if !utils.IsValidRuleAction(rule.Action) {
+		return nil, apperrors.ErrInvalidRuleAction
+	}
+
+	hasRoleTarget := rule.RouteToRole != ""
+	hasUserTarget := rule.RouteToUserID != nil
+	if rule.Action == constants.ActionRouteTo && hasRoleTarget == hasUserTarget {
+		return nil, apperrors.ErrRouteTargetRequired
+	}
+	if rule.Action != constants.ActionRouteTo && (hasRoleTarget || hasUserTarget) {
+		return nil, apperrors.ErrRouteTargetNotAllowed
+	}
+	if hasRoleTarget && rule.RouteToRole != constants.RoleManager && rule.RouteToRole != constants.RoleAdmin {
+		return nil, apperrors.ErrInvalidRouteTarget
+	}
+
+	if rule.GradeID == 0 {
+		return nil, apperrors.ErrGradeIDRequired
+	}
+
+	if rule.Priority < 0 {
+		return nil, apperrors.ErrInvalidRulePriority
+	}
+
+	// The default rule is the fallback when nothing else matches, so its condition is optional
+	if !rule.IsDefault && len(rule.Condition) == 0 {
+		return nil, apperrors.ErrConditionRequired
+	}
+
+	if len(rule.Condition) == 0 {
+		return nil, nil
+	}
+
+	// Parse and type-check the condition so a malformed rule is rejected on save
+	return utils.ParseCondition(rule.RequestType, rule.Condition)
+}
+
+// validateRuleLimits checks the route target and the condition thresholds of a rule
+// against the users and grade limits visible in tx. A rule pinned to one leave type
+// compares days with that type's allocation for the grade instead.
+func (s *RuleService) validateRuleLimits(ctx context.Context, tx interfaces.Tx, rule models.Rule, cond utils.Condition) error {
 	leaveLimit, expenseLimit, discountLimit, err := s.gradeRepo.GetLimits(ctx, tx, rule.GradeID)
 	if err != nil {
 		return err
 	}
 
-	// Validate Condition values
-	for key, val := range rule.Condition {
-		numVal, ok := val.(float64)
-		if !ok {
-			continue // Skip non-numeric if any
+	daysLimit, daysLimited := float64(leaveLimit), true
+	if code := utils.PinnedLeaveType(cond); code != "" {
+		leaveType, err := s.leaveTypeRepo.GetByCode(ctx, code)
+		if err != nil && err != apperrors.ErrLeaveTypeNotFound {
+			return err
 		}
+		if leaveType != nil {
+			daysLimit, daysLimited = leaveTypeDayLimit(*leaveType, rule.GradeID)
+		}
+	}
 
-		if numVal < 0 {
-			return apperrors.ErrNegativeValue
+	// A routed approver must be able to approve
+	if rule.RouteToUserID != nil {
+		targetRole, err := s.userRepo.GetRole(ctx, tx, *rule.RouteToUserID)
+		if err != nil {
+			return err
 		}
+		if targetRole == constants.RoleEmployee {
+			return apperrors.ErrInvalidRouteTarget
+		}
+	}
 
-		switch rule.RequestType {
-		case "LEAVE":
-			if key == "max_days" && numVal > float64(leaveLimit) {
-				return apperrors.ErrQuotaExceeded
+	// Validate threshold values against the grade limits
+	for _, cmp := range utils.ConditionComparisons(cond) {
+		for _, numVal := range cmp.Numbers {
+			if numVal < 0 {
+				return apperrors.ErrNegativeValue
 			}
-		case "EXPENSE":
-			if key == "max_amount" && numVal > expenseLimit {
-				return apperrors.ErrQuotaExceeded
-			}
-		case "DISCOUNT":
-			if key == "max_percent" && numVal > discountLimit {
-				return apperrors.ErrQuotaExceeded
+
+			switch cmp.Field {
+			case utils.FieldDays:
+				if daysLimited && numVal > daysLimit {
+					return apperrors.ErrQuotaExceeded
+				}
+			case utils.FieldAmount:
+				if numVal > expenseLimit {
+					return apperrors.ErrQuotaExceeded
+				}
+			case utils.FieldPercent:
+				if numVal > discountLimit {
+					return apperrors.ErrQuotaExceeded
+				}
 			}
 		}
 	}
 
 	return nil
 }
+
+// leaveTypeDayLimit returns the days a leave type allocates to a grade per cycle, none
+// when the grade has no allocation. A type that bypasses the balance has no limit.
+func leaveTypeDayLimit(leaveType models.LeaveType, gradeID int64) (float64, bool) {
+	if leaveType.BypassBalance {
+		return 0, false
+	}
+
+	for _, allocation := range leaveType.Allocations {
+		if allocation.GradeID == gradeID {
+			return float64(allocation.AnnualDays), true
+		}
+	}
+
+	return 0, true
+}
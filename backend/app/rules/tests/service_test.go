@@ -0,0 +1,704 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/rules/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRuleService_SimulateRules(t *testing.T) {
+	ctx := context.Background()
+
+	draftRules := []models.Rule{
+		{
+			Action:    constants.StatusAutoApprove,
+			Priority:  10,
+			Condition: map[string]interface{}{"field": "amount", "op": "lte", "value": 5000.0},
+		},
+	}
+	liveRules := []models.Rule{
+		{
+			ID:          1,
+			RequestType: "EXPENSE",
+			GradeID:     1,
+			Action:      constants.StatusAutoApprove,
+			Condition:   map[string]interface{}{"field": "amount", "op": "lte", "value": 10000.0},
+		},
+	}
+
+	// validateRule checks every draft threshold against the grade limits
+	expectGradeLimits := func(g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+		db.EXPECT().Begin(ctx).Return(tx, nil)
+		g.EXPECT().GetLimits(ctx, tx, int64(1)).Return(20, 50000.0, 30.0, nil)
+		tx.EXPECT().Rollback(ctx).Return(nil)
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		sim           models.RuleSimulation
+		mockSetup     func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx)
+		expected      *models.SimulationReport
+		expectedError error
+	}{
+		{
+			name:          "Non Admin",
+			role:          constants.RoleManager,
+			sim:           models.RuleSimulation{RequestType: "EXPENSE", GradeID: 1, Rules: draftRules},
+			mockSetup:     func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+		{
+			name:          "No Request Or Date Range",
+			role:          constants.RoleAdmin,
+			sim:           models.RuleSimulation{RequestType: "EXPENSE", GradeID: 1, Rules: draftRules},
+			mockSetup:     func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrSimulationSource,
+		},
+		{
+			name: "Malformed Draft Rule",
+			role: constants.RoleAdmin,
+			sim: models.RuleSimulation{
+				RequestType: "EXPENSE",
+				GradeID:     1,
+				Rules: []models.Rule{{
+					Action:    constants.StatusAutoApprove,
+					Condition: map[string]interface{}{"field": "days", "op": "lte", "value": 2.0},
+				}},
+				Request: &models.HypotheticalRequest{Amount: 100},
+			},
+			mockSetup:     func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrUnknownConditionField,
+		},
+		{
+			name: "Hypothetical Request Without Live Rules",
+			role: constants.RoleAdmin,
+			sim: models.RuleSimulation{
+				RequestType: "EXPENSE",
+				GradeID:     1,
+				Rules:       draftRules,
+				Request:     &models.HypotheticalRequest{Amount: 3000, Category: "travel"},
+			},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectGradeLimits(g, db, tx)
+				r.EXPECT().GetActiveByTypeAndGrade(ctx, "EXPENSE", int64(1)).Return(nil, apperrors.ErrNoRuleFound)
+			},
+			expected: &models.SimulationReport{
+				Evaluated:             1,
+				Changed:               1,
+				PendingToAutoApproved: 1,
+				Transitions:           map[string]int{"PENDING->AUTO_APPROVED": 1},
+			},
+		},
+		{
+			name: "Historical Requests",
+			role: constants.RoleAdmin,
+			sim: models.RuleSimulation{
+				RequestType: "EXPENSE",
+				GradeID:     1,
+				Rules:       draftRules,
+				From:        "2026-01-01",
+				To:          "2026-01-31",
+			},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectGradeLimits(g, db, tx)
+				r.EXPECT().GetActiveByTypeAndGrade(ctx, "EXPENSE", int64(1)).Return(liveRules, nil)
+				r.EXPECT().GetEvaluationMode(ctx, "EXPENSE", int64(1)).Return(constants.RuleModeFirstMatch, nil)
+				r.EXPECT().GetSimulationSamples(ctx, "EXPENSE", int64(1),
+					time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+				).Return([]models.SimulationSample{
+					{RequestID: 11, Amount: 1000, Category: "food", Role: constants.RoleEmployee, GradeID: 1},
+					{RequestID: 12, Amount: 9000, Category: "travel", Role: constants.RoleEmployee, GradeID: 1},
+				}, nil)
+			},
+			expected: &models.SimulationReport{
+				Evaluated:             2,
+				Changed:               1,
+				AutoApprovedToPending: 1,
+				Transitions:           map[string]int{"AUTO_APPROVED->PENDING": 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := mocks.NewRuleRepository(t)
+			mockGradeRepo := mocks.NewGradeRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockRuleRepo, mockGradeRepo, mockDB, mockTx)
+
+			service := rules.NewRuleService(ctx, mockRuleRepo, mockGradeRepo, mockUserRepo, mocks.NewHolidayRepository(t), mocks.NewLeaveTypeRepository(t), mockDB)
+			report, err := service.SimulateRules(ctx, tt.role, tt.sim)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.Evaluated, report.Evaluated)
+			assert.Equal(t, tt.expected.Changed, report.Changed)
+			assert.Equal(t, tt.expected.AutoApprovedToPending, report.AutoApprovedToPending)
+			assert.Equal(t, tt.expected.PendingToAutoApproved, report.PendingToAutoApproved)
+			assert.Equal(t, tt.expected.Transitions, report.Transitions)
+			assert.Len(t, report.Results, tt.expected.Changed)
+		})
+	}
+}
+
+func TestRuleService_UpdateRule(t *testing.T) {
+	ctx := context.Background()
+
+	rule := models.Rule{
+		RequestType: "LEAVE",
+		GradeID:     1,
+		Action:      constants.StatusAutoApprove,
+		Active:      true,
+		Condition:   map[string]interface{}{"field": "days", "op": "lte", "value": 3.0},
+	}
+	current := &models.Rule{ID: 7, RuleKey: 3, Version: 2, EffectiveFrom: time.Now().Add(-time.Hour)}
+	closedAt := time.Now().Add(-time.Minute)
+	deleted := &models.Rule{ID: 7, RuleKey: 3, Version: 2, EffectiveFrom: current.EffectiveFrom, EffectiveTo: &closedAt}
+
+	expectGradeLimits := func(g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+		db.EXPECT().Begin(ctx).Return(tx, nil)
+		g.EXPECT().GetLimits(ctx, tx, int64(1)).Return(20, 50000.0, 30.0, nil)
+		tx.EXPECT().Rollback(ctx).Return(nil)
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		rule          models.Rule
+		mockSetup     func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:          "Non Admin",
+			role:          constants.RoleEmployee,
+			rule:          rule,
+			mockSetup:     func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+		{
+			name: "Effective Date In The Past",
+			role: constants.RoleAdmin,
+			rule: func() models.Rule {
+				r := rule
+				r.EffectiveFrom = time.Now().AddDate(0, 0, -1)
+				return r
+			}(),
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectGradeLimits(g, db, tx)
+			},
+			expectedError: apperrors.ErrInvalidEffectiveDate,
+		},
+		{
+			name: "Deleted Rule",
+			role: constants.RoleAdmin,
+			rule: rule,
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectGradeLimits(g, db, tx)
+				r.EXPECT().GetLatestVersion(ctx, tx, int64(7)).Return(deleted, nil)
+			},
+			expectedError: apperrors.ErrNoRuleFound,
+		},
+		{
+			name: "Creates Next Version",
+			role: constants.RoleAdmin,
+			rule: rule,
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectGradeLimits(g, db, tx)
+				r.EXPECT().GetLatestVersion(ctx, tx, int64(7)).Return(current, nil)
+				r.EXPECT().CloseOpenVersions(ctx, tx, int64(3), mock.AnythingOfType("time.Time")).Return(nil)
+				r.EXPECT().InsertVersion(ctx, tx, mock.MatchedBy(func(next *models.Rule) bool {
+					return next.RuleKey == 3 && next.Version == 3 && next.EffectiveTo == nil
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := mocks.NewRuleRepository(t)
+			mockGradeRepo := mocks.NewGradeRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockRuleRepo, mockGradeRepo, mockDB, mockTx)
+
+			service := rules.NewRuleService(ctx, mockRuleRepo, mockGradeRepo, mockUserRepo, mocks.NewHolidayRepository(t), mocks.NewLeaveTypeRepository(t), mockDB)
+			err := service.UpdateRule(ctx, tt.role, 7, tt.rule)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRuleService_CreateRule_LeaveTypeLimits(t *testing.T) {
+	ctx := context.Background()
+
+	sick := &models.LeaveType{Code: "SICK", Allocations: []models.LeaveTypeAllocation{{GradeID: 1, AnnualDays: 30}}}
+	unpaid := &models.LeaveType{Code: "UNPAID", BypassBalance: true}
+	pinned := func(code string, days float64) map[string]interface{} {
+		return map[string]interface{}{"all": []interface{}{
+			map[string]interface{}{"field": "leave_type", "op": "eq", "value": code},
+			map[string]interface{}{"field": "days", "op": "lte", "value": days},
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		condition     map[string]interface{}
+		mockSetup     func(l *mocks.LeaveTypeRepository)
+		expectedError error
+	}{
+		{
+			name:          "Unpinned Days Above Grade Limit",
+			condition:     map[string]interface{}{"field": "days", "op": "lte", "value": 25.0},
+			mockSetup:     func(l *mocks.LeaveTypeRepository) {},
+			expectedError: apperrors.ErrQuotaExceeded,
+		},
+		{
+			name:      "Pinned Days Within Type Allocation",
+			condition: pinned("SICK", 25),
+			mockSetup: func(l *mocks.LeaveTypeRepository) {
+				l.EXPECT().GetByCode(ctx, "SICK").Return(sick, nil)
+			},
+		},
+		{
+			name:      "Pinned Days Above Type Allocation",
+			condition: pinned("sick", 35),
+			mockSetup: func(l *mocks.LeaveTypeRepository) {
+				l.EXPECT().GetByCode(ctx, "SICK").Return(sick, nil)
+			},
+			expectedError: apperrors.ErrQuotaExceeded,
+		},
+		{
+			name:      "Pinned Balance Bypass Type",
+			condition: pinned("UNPAID", 90),
+			mockSetup: func(l *mocks.LeaveTypeRepository) {
+				l.EXPECT().GetByCode(ctx, "UNPAID").Return(unpaid, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := mocks.NewRuleRepository(t)
+			mockGradeRepo := mocks.NewGradeRepository(t)
+			mockLeaveTypeRepo := mocks.NewLeaveTypeRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+			mockGradeRepo.EXPECT().GetLimits(ctx, mockTx, int64(1)).Return(20, 50000.0, 30.0, nil)
+			mockTx.EXPECT().Rollback(ctx).Return(nil)
+			tt.mockSetup(mockLeaveTypeRepo)
+			if tt.expectedError == nil {
+				mockRuleRepo.EXPECT().Create(ctx, mockTx, mock.Anything).Return(nil)
+				mockTx.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			service := rules.NewRuleService(ctx, mockRuleRepo, mockGradeRepo, mocks.NewUserRepository(t), mocks.NewHolidayRepository(t), mockLeaveTypeRepo, mockDB)
+			err := service.CreateRule(ctx, constants.RoleAdmin, models.Rule{
+				RequestType: "LEAVE",
+				GradeID:     1,
+				Action:      constants.StatusAutoApprove,
+				Condition:   tt.condition,
+			})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRuleService_AnalyzeRules_LeaveTypeLimits(t *testing.T) {
+	ctx := context.Background()
+
+	leaveRule := func(id int64, code string, days float64) models.Rule {
+		return models.Rule{
+			ID:            id,
+			RequestType:   "LEAVE",
+			GradeID:       1,
+			Priority:      int(id),
+			Action:        constants.StatusAutoApprove,
+			Active:        true,
+			EffectiveFrom: time.Now().Add(-time.Hour),
+			Condition: map[string]interface{}{"all": []interface{}{
+				map[string]interface{}{"field": "leave_type", "op": "eq", "value": code},
+				map[string]interface{}{"field": "days", "op": "lte", "value": days},
+			}},
+		}
+	}
+
+	mockRuleRepo := mocks.NewRuleRepository(t)
+	mockGradeRepo := mocks.NewGradeRepository(t)
+	mockLeaveTypeRepo := mocks.NewLeaveTypeRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockGradeRepo.EXPECT().GetAll(ctx).Return([]models.Grade{{ID: 1, Name: "G1"}}, nil)
+	mockRuleRepo.EXPECT().GetAll(ctx).Return([]models.Rule{
+		leaveRule(1, "SICK", 12),
+		leaveRule(2, "CASUAL", 8),
+		leaveRule(3, "UNPAID", 90),
+	}, nil)
+	mockRuleRepo.EXPECT().GetEvaluationModes(ctx).Return(nil, nil)
+	mockLeaveTypeRepo.EXPECT().GetAll(ctx).Return([]models.LeaveType{
+		{Code: "SICK", Allocations: []models.LeaveTypeAllocation{{GradeID: 1, AnnualDays: 10}}},
+		{Code: "CASUAL", Allocations: []models.LeaveTypeAllocation{{GradeID: 1, AnnualDays: 8}}},
+		{Code: "UNPAID", BypassBalance: true},
+	}, nil)
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockGradeRepo.EXPECT().GetLimits(ctx, mockTx, int64(1)).Return(20, 50000.0, 30.0, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := rules.NewRuleService(ctx, mockRuleRepo, mockGradeRepo, mocks.NewUserRepository(t), mocks.NewHolidayRepository(t), mockLeaveTypeRepo, mockDB)
+	analysis, err := service.AnalyzeRules(ctx, constants.RoleAdmin)
+
+	assert.NoError(t, err)
+	var exceeded []models.RuleIssue
+	for _, issue := range analysis.Issues {
+		if issue.Kind == constants.IssueExceedsLimit {
+			exceeded = append(exceeded, issue)
+		}
+	}
+	if assert.Len(t, exceeded, 1) {
+		assert.Equal(t, []int64{1}, exceeded[0].RuleIDs)
+		assert.Equal(t, "rule 1 compares days with 12, above the 10 SICK allocation of grade G1", exceeded[0].Message)
+	}
+}
+
+func TestRuleService_DeleteRule(t *testing.T) {
+	ctx := context.Background()
+
+	// version 2 is current until version 3 takes over tomorrow; deleting the rule ends
+	// both now
+	scheduled := &models.Rule{ID: 8, RuleKey: 3, Version: 3, EffectiveFrom: time.Now().AddDate(0, 0, 1)}
+
+	mockRuleRepo := mocks.NewRuleRepository(t)
+	mockDB := mocks.NewDB(t)
+	mockTx := mocks.NewTx(t)
+
+	mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+	mockRuleRepo.EXPECT().GetLatestVersion(ctx, mockTx, int64(7)).Return(scheduled, nil)
+	mockRuleRepo.EXPECT().CloseOpenVersions(ctx, mockTx, int64(3), mock.MatchedBy(func(at time.Time) bool {
+		return !at.After(time.Now())
+	})).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	service := rules.NewRuleService(ctx, mockRuleRepo, mocks.NewGradeRepository(t), mocks.NewUserRepository(t), mocks.NewHolidayRepository(t), mocks.NewLeaveTypeRepository(t), mockDB)
+
+	assert.NoError(t, service.DeleteRule(ctx, constants.RoleAdmin, 7))
+	assert.ErrorIs(t, service.DeleteRule(ctx, constants.RoleManager, 7), apperrors.ErrUnauthorized)
+}
+
+func TestRuleService_RollbackRule(t *testing.T) {
+	ctx := context.Background()
+
+	history := []models.Rule{
+		{
+			ID:          3,
+			RuleKey:     3,
+			Version:     1,
+			RequestType: "LEAVE",
+			GradeID:     1,
+			Action:      constants.StatusAutoApprove,
+			Active:      true,
+			Condition:   map[string]interface{}{"field": "days", "op": "lte", "value": 2.0},
+		},
+		{
+			ID:          7,
+			RuleKey:     3,
+			Version:     2,
+			RequestType: "LEAVE",
+			GradeID:     1,
+			Action:      constants.StatusAutoApprove,
+			Active:      true,
+			Condition:   map[string]interface{}{"field": "days", "op": "lte", "value": 5.0},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		version         int
+		mockSetup       func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedVersion int
+		expectedError   error
+	}{
+		{
+			name:    "Unknown Version",
+			version: 9,
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				r.EXPECT().GetHistory(ctx, int64(7)).Return(history, nil)
+			},
+			expectedError: apperrors.ErrRuleVersionNotFound,
+		},
+		{
+			name:    "Republishes Earlier Version",
+			version: 1,
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				r.EXPECT().GetHistory(ctx, int64(7)).Return(history, nil)
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetLimits(ctx, tx, int64(1)).Return(20, 50000.0, 30.0, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				latest := history[1]
+				r.EXPECT().GetLatestVersion(ctx, tx, int64(7)).Return(&latest, nil)
+				r.EXPECT().CloseOpenVersions(ctx, tx, int64(3), mock.AnythingOfType("time.Time")).Return(nil)
+				r.EXPECT().InsertVersion(ctx, tx, mock.AnythingOfType("*models.Rule")).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+			expectedVersion: 3,
+		},
+		{
+			// version 2 is current until version 3 takes over tomorrow; the rollback
+			// ends both now
+			name:    "Scheduled Version Pending",
+			version: 1,
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, db *mocks.DB, tx *mocks.Tx) {
+				r.EXPECT().GetHistory(ctx, int64(7)).Return(history, nil)
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetLimits(ctx, tx, int64(1)).Return(20, 50000.0, 30.0, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				scheduled := history[1]
+				scheduled.ID, scheduled.Version, scheduled.EffectiveFrom = 8, 3, time.Now().AddDate(0, 0, 1)
+				r.EXPECT().GetLatestVersion(ctx, tx, int64(7)).Return(&scheduled, nil)
+				r.EXPECT().CloseOpenVersions(ctx, tx, int64(3), mock.MatchedBy(func(at time.Time) bool {
+					return !at.After(time.Now())
+				})).Return(nil)
+				r.EXPECT().InsertVersion(ctx, tx, mock.MatchedBy(func(next *models.Rule) bool {
+					return next.Version == 4 && !next.EffectiveFrom.After(time.Now())
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+			expectedVersion: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := mocks.NewRuleRepository(t)
+			mockGradeRepo := mocks.NewGradeRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockRuleRepo, mockGradeRepo, mockDB, mockTx)
+
+			service := rules.NewRuleService(ctx, mockRuleRepo, mockGradeRepo, mockUserRepo, mocks.NewHolidayRepository(t), mocks.NewLeaveTypeRepository(t), mockDB)
+			restored, err := service.RollbackRule(ctx, constants.RoleAdmin, 7, tt.version)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedVersion, restored.Version)
+			assert.Equal(t, 2.0, restored.Condition["value"])
+		})
+	}
+}
+
+func TestRuleService_ImportBundle(t *testing.T) {
+	ctx := context.Background()
+
+	grades := []models.Grade{{ID: 1, Name: "STANDARD", AnnualLeaveLimit: 20, AnnualExpenseLimit: 50000, DiscountLimitPercent: 30}}
+	liveRule := models.Rule{
+		ID:          5,
+		RuleKey:     5,
+		Version:     1,
+		RequestType: "LEAVE",
+		GradeID:     1,
+		Active:      true,
+		Priority:    10,
+		Action:      constants.StatusAutoApprove,
+		Condition:   map[string]interface{}{"field": "days", "op": "lte", "value": 2.0},
+	}
+	bundleRule := models.BundleRule{
+		RequestType: "LEAVE",
+		Grade:       "STANDARD",
+		Active:      true,
+		Priority:    10,
+		Action:      constants.StatusAutoApprove,
+		// YAML decodes integers, the import compares and stores them as JSON numbers
+		Condition: map[string]interface{}{"field": "days", "op": "lte", "value": uint64(2)},
+	}
+	bundleGrades := []models.BundleGrade{{Name: "STANDARD", AnnualLeaveLimit: 20, AnnualExpenseLimit: 50000, DiscountLimitPercent: 30}}
+
+	// the current policy is read inside the import transaction, once it holds the policy lock
+	expectCurrentPolicy := func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+		db.EXPECT().Begin(ctx).Return(tx, nil)
+		tx.EXPECT().Rollback(ctx).Return(nil)
+		r.EXPECT().LockPolicy(ctx, tx).Return(nil)
+		g.EXPECT().GetAllInTx(ctx, tx).Return(grades, nil)
+		h.EXPECT().GetDefaultCalendarInTx(ctx, tx).Return(&models.Calendar{ID: 1, Name: "Default", IsDefault: true}, nil)
+		h.EXPECT().GetHolidaysInTx(ctx, tx, int64(1)).Return([]map[string]interface{}{
+			{"id": int64(1), "date": "2026-12-25", "description": "Christmas"},
+		}, nil)
+		r.EXPECT().GetEvaluationModesInTx(ctx, tx).Return(nil, nil)
+		r.EXPECT().GetAllInTx(ctx, tx).Return([]models.Rule{liveRule}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		bundle        models.PolicyBundle
+		preview       bool
+		mockSetup     func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx)
+		expected      *models.BundleDiff
+		expectedError error
+	}{
+		{
+			name:   "Non Admin",
+			role:   constants.RoleManager,
+			bundle: models.PolicyBundle{Version: constants.PolicyBundleVersion},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+		{
+			name:   "Unsupported Version",
+			role:   constants.RoleAdmin,
+			bundle: models.PolicyBundle{Version: 99},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrUnsupportedBundleVersion,
+		},
+		{
+			name:   "Policy Lock Fails",
+			role:   constants.RoleAdmin,
+			bundle: models.PolicyBundle{Version: constants.PolicyBundleVersion},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				r.EXPECT().LockPolicy(ctx, tx).Return(apperrors.ErrDatabase)
+			},
+			expectedError: apperrors.ErrDatabase,
+		},
+		{
+			name: "Unknown Grade",
+			role: constants.RoleAdmin,
+			bundle: models.PolicyBundle{
+				Version: constants.PolicyBundleVersion,
+				Rules:   []models.BundleRule{{RequestType: "LEAVE", Grade: "GOLD", Action: constants.StatusAutoApprove}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectCurrentPolicy(r, g, h, db, tx)
+				h.EXPECT().DeleteHolidayByDate(ctx, tx, int64(1), time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)).Return(nil)
+			},
+			expectedError: apperrors.ErrUnknownBundleGrade,
+		},
+		{
+			name: "Preview Lists Changes Without Committing",
+			role: constants.RoleAdmin,
+			bundle: models.PolicyBundle{
+				Version:  constants.PolicyBundleVersion,
+				Grades:   bundleGrades,
+				Holidays: []models.BundleHoliday{{Date: "2026-12-25", Description: "Christmas Day"}},
+				Rules: []models.BundleRule{
+					bundleRule,
+					{
+						RequestType: "LEAVE",
+						Grade:       "STANDARD",
+						Active:      true,
+						IsDefault:   true,
+						Action:      constants.ActionManual,
+					},
+				},
+			},
+			preview: true,
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectCurrentPolicy(r, g, h, db, tx)
+				h.EXPECT().UpsertHoliday(ctx, tx, int64(1), time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), "Christmas Day", int64(1)).Return(nil)
+				g.EXPECT().GetLimits(ctx, tx, int64(1)).Return(20, 50000.0, 30.0, nil)
+				r.EXPECT().Create(ctx, tx, mock.MatchedBy(func(rule *models.Rule) bool {
+					return rule.IsDefault && rule.GradeID == 1
+				})).Return(nil)
+			},
+			expected: &models.BundleDiff{
+				Applied:  false,
+				Holidays: []models.BundleChange{{Change: constants.ChangeChanged, Key: "2026-12-25"}},
+				Rules:    []models.BundleChange{{Change: constants.ChangeAdded, Key: "LEAVE/STANDARD/default"}},
+			},
+		},
+		{
+			name: "Removes Rules Missing From Bundle",
+			role: constants.RoleAdmin,
+			bundle: models.PolicyBundle{
+				Version:  constants.PolicyBundleVersion,
+				Grades:   bundleGrades,
+				Holidays: []models.BundleHoliday{{Date: "2026-12-25", Description: "Christmas"}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, g *mocks.GradeRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+				expectCurrentPolicy(r, g, h, db, tx)
+				r.EXPECT().CloseOpenVersions(ctx, tx, int64(5), mock.AnythingOfType("time.Time")).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+			expected: &models.BundleDiff{
+				Applied: true,
+				Rules:   []models.BundleChange{{Change: constants.ChangeRemoved, Key: "LEAVE/STANDARD/10"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := mocks.NewRuleRepository(t)
+			mockGradeRepo := mocks.NewGradeRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockHolidayRepo := mocks.NewHolidayRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockRuleRepo, mockGradeRepo, mockHolidayRepo, mockDB, mockTx)
+
+			service := rules.NewRuleService(ctx, mockRuleRepo, mockGradeRepo, mockUserRepo, mockHolidayRepo, mocks.NewLeaveTypeRepository(t), mockDB)
+			diff, err := service.ImportBundle(ctx, tt.role, 1, tt.bundle, tt.preview)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.Applied, diff.Applied)
+			assert.Empty(t, diff.Grades)
+			assert.Len(t, diff.Holidays, len(tt.expected.Holidays))
+			for i, change := range tt.expected.Holidays {
+				assert.Equal(t, change.Change, diff.Holidays[i].Change)
+				assert.Equal(t, change.Key, diff.Holidays[i].Key)
+			}
+			assert.Len(t, diff.Rules, len(tt.expected.Rules))
+			for i, change := range tt.expected.Rules {
+				assert.Equal(t, change.Change, diff.Rules[i].Change)
+				assert.Equal(t, change.Key, diff.Rules[i].Key)
+			}
+		})
+	}
+}
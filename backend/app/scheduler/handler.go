@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles scheduled job HTTP requests
+type SchedulerHandler struct {
+	schedulerService interfaces.SchedulerService
+}
+
+// creates a new SchedulerHandler instance
+func NewSchedulerHandler(ctx context.Context, schedulerService interfaces.SchedulerService) *SchedulerHandler {
+	return &SchedulerHandler{schedulerService: schedulerService}
+}
+
+func (h *SchedulerHandler) GetJobs(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleSchedulerError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	jobs, err := h.schedulerService.GetJobs(ctx, role)
+	if err != nil {
+		handleSchedulerError(c, err)
+		return
+	}
+
+	response.Success(c, "jobs fetched successfully", jobs)
+}
+
+func (h *SchedulerHandler) PauseJob(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleSchedulerError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	adminID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+	if err := h.schedulerService.PauseJob(ctx, role, adminID, c.Param("name")); err != nil {
+		handleSchedulerError(c, err)
+		return
+	}
+
+	response.Success(c, "job paused successfully", nil)
+}
+
+func (h *SchedulerHandler) ResumeJob(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleSchedulerError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	adminID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+	if err := h.schedulerService.ResumeJob(ctx, role, adminID, c.Param("name")); err != nil {
+		handleSchedulerError(c, err)
+		return
+	}
+
+	response.Success(c, "job resumed successfully", nil)
+}
+
+func (h *SchedulerHandler) TriggerJob(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleSchedulerError(c, apperrors.ErrAdminOnly)
+		return
+	}
+
+	adminID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+	run, err := h.schedulerService.TriggerJob(ctx, role, adminID, c.Param("name"))
+	if err != nil {
+		handleSchedulerError(c, err)
+		return
+	}
+
+	response.Success(c, "job triggered successfully", run)
+}
+
+func handleSchedulerError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrJobNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrJobAlreadyRunning:
+		status = http.StatusConflict
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,214 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+
+	"github.com/robfig/cron/v3"
+)
+
+type scheduledJob struct {
+	name     string
+	spec     string
+	timezone string
+	schedule cron.Schedule
+	run      func(ctx context.Context) (map[string]int, error)
+}
+
+// SchedulerService runs the registered background jobs on their cron schedules. Every
+// instance schedules every job; the job run lock lets one of them run it.
+type SchedulerService struct {
+	ctx           context.Context
+	jobRunService interfaces.JobRunService
+	jobRunRepo    interfaces.JobRunRepository
+	cron          *cron.Cron
+
+	mu   sync.RWMutex
+	jobs map[string]*scheduledJob
+	// registration order, so jobs are listed the same way every time
+	names []string
+}
+
+// NewSchedulerService creates a new instance of SchedulerService
+func NewSchedulerService(ctx context.Context, jobRunService interfaces.JobRunService, jobRunRepo interfaces.JobRunRepository) interfaces.SchedulerService {
+	return &SchedulerService{
+		ctx:           ctx,
+		jobRunService: jobRunService,
+		jobRunRepo:    jobRunRepo,
+		cron:          cron.New(),
+		jobs:          map[string]*scheduledJob{},
+	}
+}
+
+// Register adds a job that runs on a standard five-field cron schedule in the given
+// timezone. An empty timezone means UTC.
+func (s *SchedulerService) Register(name, schedule, timezone string, job func(ctx context.Context) (map[string]int, error)) error {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return apperrors.ErrInvalidTimezone
+	}
+
+	parsed, err := cron.ParseStandard("CRON_TZ=" + loc.String() + " " + schedule)
+	if err != nil {
+		return apperrors.ErrInvalidJobSchedule
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[name]; exists {
+		return apperrors.ErrJobExists
+	}
+
+	scheduled := &scheduledJob{
+		name:     name,
+		spec:     schedule,
+		timezone: loc.String(),
+		schedule: parsed,
+		run:      job,
+	}
+	s.jobs[name] = scheduled
+	s.names = append(s.names, name)
+
+	s.cron.Schedule(parsed, cron.FuncJob(func() {
+		s.runScheduled(scheduled)
+	}))
+
+	return nil
+}
+
+func (s *SchedulerService) Start() {
+	s.cron.Start()
+}
+
+// Stop stops scheduling jobs and waits for the running ones to finish
+func (s *SchedulerService) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+// runScheduled runs a job on its schedule unless it is paused
+func (s *SchedulerService) runScheduled(job *scheduledJob) {
+	paused, err := s.jobRunRepo.GetPausedJobs(s.ctx)
+	if err != nil {
+		log.Printf("Job %s skipped: could not read paused jobs: %v", job.name, err)
+		return
+	}
+	if paused[job.name] {
+		log.Printf("Job %s skipped: paused", job.name)
+		return
+	}
+
+	log.Printf("Running job %s...", job.name)
+
+	run, err := s.jobRunService.Execute(s.ctx, job.name, nil, job.run)
+
+	switch {
+	case err == apperrors.ErrJobAlreadyRunning:
+		log.Printf("Job %s skipped: a run is already in progress", job.name)
+	case err != nil:
+		log.Printf("Error in job %s: %v", job.name, err)
+	case run.Error != "":
+		log.Printf("Error in job %s: %s", job.name, run.Error)
+	}
+}
+
+func (s *SchedulerService) getJob(name string) (*scheduledJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, ok := s.jobs[name]
+	if !ok {
+		return nil, apperrors.ErrJobNotFound
+	}
+	return job, nil
+}
+
+// GetJobs lists the registered jobs with their paused state, next and last run (admin only)
+func (s *SchedulerService) GetJobs(ctx context.Context, role string) ([]models.ScheduledJob, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	paused, err := s.jobRunRepo.GetPausedJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	registered := make([]*scheduledJob, 0, len(s.names))
+	for _, name := range s.names {
+		registered = append(registered, s.jobs[name])
+	}
+	s.mu.RUnlock()
+
+	now := time.Now()
+	jobs := make([]models.ScheduledJob, 0, len(registered))
+	for _, job := range registered {
+		scheduled := models.ScheduledJob{
+			Name:     job.name,
+			Schedule: job.spec,
+			Timezone: job.timezone,
+			Paused:   paused[job.name],
+		}
+
+		if !scheduled.Paused {
+			next := job.schedule.Next(now)
+			scheduled.NextRun = &next
+		}
+
+		runs, err := s.jobRunRepo.GetRecent(ctx, job.name, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) > 0 {
+			scheduled.LastRun = &runs[0]
+		}
+
+		jobs = append(jobs, scheduled)
+	}
+
+	return jobs, nil
+}
+
+// PauseJob stops a job from running on schedule on every instance (admin only)
+func (s *SchedulerService) PauseJob(ctx context.Context, role string, adminID int64, name string) error {
+	return s.setPaused(ctx, role, adminID, name, true)
+}
+
+// ResumeJob puts a paused job back on its schedule (admin only)
+func (s *SchedulerService) ResumeJob(ctx context.Context, role string, adminID int64, name string) error {
+	return s.setPaused(ctx, role, adminID, name, false)
+}
+
+func (s *SchedulerService) setPaused(ctx context.Context, role string, adminID int64, name string, paused bool) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrUnauthorized
+	}
+
+	if _, err := s.getJob(name); err != nil {
+		return err
+	}
+
+	return s.jobRunRepo.SetPaused(ctx, name, paused, adminID)
+}
+
+// TriggerJob runs a job now, even when it is paused, and returns the recorded run (admin only)
+func (s *SchedulerService) TriggerJob(ctx context.Context, role string, adminID int64, name string) (*models.JobRun, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	job, err := s.getJob(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.jobRunService.Execute(ctx, job.name, &adminID, job.run)
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/scheduler"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/scheduler/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func noopJob(ctx context.Context) (map[string]int, error) {
+	return nil, nil
+}
+
+func TestSchedulerService_Register(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		schedule    string
+		timezone    string
+		expectedErr error
+	}{
+		{name: "Valid", schedule: "0 0 * * *", timezone: "Asia/Kolkata"},
+		{name: "Empty Timezone Is UTC", schedule: "*/15 * * * *", timezone: ""},
+		{name: "Invalid Timezone", schedule: "0 0 * * *", timezone: "Mars/Olympus", expectedErr: apperrors.ErrInvalidTimezone},
+		{name: "Invalid Schedule", schedule: "every day", timezone: "UTC", expectedErr: apperrors.ErrInvalidJobSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := scheduler.NewSchedulerService(ctx, mocks.NewJobRunService(t), mocks.NewJobRunRepository(t))
+
+			err := service.Register(constants.JobAutoReject, tt.schedule, tt.timezone, noopJob)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("Duplicate Name", func(t *testing.T) {
+		service := scheduler.NewSchedulerService(ctx, mocks.NewJobRunService(t), mocks.NewJobRunRepository(t))
+
+		assert.NoError(t, service.Register(constants.JobAutoReject, "0 0 * * *", "UTC", noopJob))
+		assert.ErrorIs(t, service.Register(constants.JobAutoReject, "0 1 * * *", "UTC", noopJob), apperrors.ErrJobExists)
+	})
+}
+
+func TestSchedulerService_GetJobs(t *testing.T) {
+	ctx := context.Background()
+
+	mockRunService := mocks.NewJobRunService(t)
+	mockRepo := mocks.NewJobRunRepository(t)
+	service := scheduler.NewSchedulerService(ctx, mockRunService, mockRepo)
+	assert.NoError(t, service.Register(constants.JobAutoReject, "0 0 * * *", "Asia/Kolkata", noopJob))
+	assert.NoError(t, service.Register("report", "0 6 * * 1", "UTC", noopJob))
+
+	_, err := service.GetJobs(ctx, constants.RoleEmployee)
+	assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+
+	mockRepo.EXPECT().GetPausedJobs(ctx).Return(map[string]bool{"report": true}, nil)
+	mockRepo.EXPECT().GetRecent(ctx, constants.JobAutoReject, 1).Return([]models.JobRun{{ID: 7, Status: constants.JobStatusSucceeded}}, nil)
+	mockRepo.EXPECT().GetRecent(ctx, "report", 1).Return([]models.JobRun{}, nil)
+
+	jobs, err := service.GetJobs(ctx, constants.RoleAdmin)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+
+	assert.Equal(t, constants.JobAutoReject, jobs[0].Name)
+	assert.Equal(t, "Asia/Kolkata", jobs[0].Timezone)
+	assert.False(t, jobs[0].Paused)
+	assert.NotNil(t, jobs[0].NextRun)
+	assert.Equal(t, int64(7), jobs[0].LastRun.ID)
+
+	assert.Equal(t, "report", jobs[1].Name)
+	assert.True(t, jobs[1].Paused)
+	assert.Nil(t, jobs[1].NextRun)
+	assert.Nil(t, jobs[1].LastRun)
+}
+
+func TestSchedulerService_PauseResumeJob(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := mocks.NewJobRunRepository(t)
+	service := scheduler.NewSchedulerService(ctx, mocks.NewJobRunService(t), mockRepo)
+	assert.NoError(t, service.Register(constants.JobAutoReject, "0 0 * * *", "UTC", noopJob))
+
+	assert.ErrorIs(t, service.PauseJob(ctx, constants.RoleManager, 2, constants.JobAutoReject), apperrors.ErrUnauthorized)
+	assert.ErrorIs(t, service.PauseJob(ctx, constants.RoleAdmin, 1, "missing"), apperrors.ErrJobNotFound)
+
+	mockRepo.EXPECT().SetPaused(ctx, constants.JobAutoReject, true, int64(1)).Return(nil)
+	mockRepo.EXPECT().SetPaused(ctx, constants.JobAutoReject, false, int64(1)).Return(nil)
+
+	assert.NoError(t, service.PauseJob(ctx, constants.RoleAdmin, 1, constants.JobAutoReject))
+	assert.NoError(t, service.ResumeJob(ctx, constants.RoleAdmin, 1, constants.JobAutoReject))
+}
+
+func TestSchedulerService_TriggerJob(t *testing.T) {
+	ctx := context.Background()
+
+	mockRunService := mocks.NewJobRunService(t)
+	service := scheduler.NewSchedulerService(ctx, mockRunService, mocks.NewJobRunRepository(t))
+	assert.NoError(t, service.Register(constants.JobAutoReject, "0 0 * * *", "UTC", noopJob))
+
+	_, err := service.TriggerJob(ctx, constants.RoleAdmin, 1, "missing")
+	assert.ErrorIs(t, err, apperrors.ErrJobNotFound)
+
+	mockRunService.EXPECT().Execute(ctx, constants.JobAutoReject, mock.MatchedBy(func(triggeredBy *int64) bool {
+		return triggeredBy != nil && *triggeredBy == 1
+	}), mock.Anything).Return(&models.JobRun{Status: constants.JobStatusSucceeded}, nil)
+
+	run, err := service.TriggerJob(ctx, constants.RoleAdmin, 1, constants.JobAutoReject)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.JobStatusSucceeded, run.Status)
+}
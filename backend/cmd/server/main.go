@@ -6,16 +6,25 @@ import (
 	"os"
 	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auth"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/balance_policies"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/escalations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/feeds"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/job_runs"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_types"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/my_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/reports"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/scheduler"
 	"github.com/ankita-advitot/rule_based_approval_engine/config"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	jobs "github.com/ankita-advitot/rule_based_approval_engine/cron-jobs"
 	"github.com/ankita-advitot/rule_based_approval_engine/database"
 	"github.com/ankita-advitot/rule_based_approval_engine/repositories"
@@ -24,7 +33,6 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/robfig/cron/v3"
 )
 
 func main() {
@@ -53,31 +61,72 @@ func main() {
 	reportRepo := repositories.NewReportRepository(ctx, database.DB)
 	gradeRepo := repositories.NewGradeRepository(ctx, database.DB)
 	myRequestsRepo := repositories.NewAggregatedRepository(ctx, database.DB)
+	chainRepo := repositories.NewApprovalChainRepository(ctx, database.DB)
+	delegationRepo := repositories.NewDelegationRepository(ctx, database.DB)
+	escalationRepo := repositories.NewEscalationRepository(ctx, database.DB)
+	jobRunRepo := repositories.NewJobRunRepository(ctx, database.DB)
+	balancePolicyRepo := repositories.NewBalancePolicyRepository(ctx, database.DB)
+	leaveTypeRepo := repositories.NewLeaveTypeRepository(ctx, database.DB)
+	feedRepo := repositories.NewFeedRepository(ctx, database.DB)
 
 	// 2. Services
 	authService := auth.NewAuthService(ctx, userRepo, balanceRepo, database.DB)
-	ruleService := rules.NewRuleService(ctx, ruleRepo, gradeRepo, database.DB)
+	ruleService := rules.NewRuleService(ctx, ruleRepo, gradeRepo, userRepo, holidayRepo, leaveTypeRepo, database.DB)
+	chainService := approval_chains.NewApprovalChainService(ctx, chainRepo, userRepo, database.DB)
+	delegationService := delegations.NewDelegationService(ctx, delegationRepo, userRepo)
+	escalationService := escalations.NewEscalationService(ctx, escalationRepo, chainRepo)
 	leaveService := leave_service.NewLeaveService(
-		ctx, leaveRepo, balanceRepo, ruleService, userRepo, database.DB,
+		ctx, leaveRepo, balanceRepo, leaveTypeRepo, holidayRepo, ruleService, chainService, userRepo, database.DB,
 	)
 	leaveApprovalService := leave_service.NewLeaveApprovalService(
-		ctx, leaveRepo, balanceRepo, userRepo, database.DB,
+		ctx, leaveRepo, balanceRepo, chainService, delegationService, userRepo, database.DB,
 	)
 	expenseService := expense_service.NewExpenseService(
-		ctx, expenseRepo, balanceRepo, ruleService, userRepo, database.DB,
+		ctx, expenseRepo, balanceRepo, ruleService, chainService, userRepo, database.DB,
 	)
 	expenseApprovalService := expense_service.NewExpenseApprovalService(
-		ctx, expenseRepo, balanceRepo, userRepo, database.DB,
+		ctx, expenseRepo, balanceRepo, chainService, delegationService, userRepo, database.DB,
 	)
-	holidayService := holidays.NewHolidayService(ctx, holidayRepo)
+	holidayService := holidays.NewHolidayService(ctx, holidayRepo, database.DB)
 	reportService := reports.NewReportService(ctx, reportRepo)
-	balanceService := domain_service.NewBalanceService(ctx, balanceRepo, database.DB)
-	discountService := domain_service.NewDiscountService(ctx, discountRepo, balanceRepo, ruleService, userRepo, database.DB)
-	discountApprovalService := domain_service.NewDiscountApprovalService(ctx, discountRepo, balanceRepo, userRepo, database.DB)
+	balanceService := domain_service.NewBalanceService(ctx, balanceRepo, userRepo, database.DB)
+	discountService := domain_service.NewDiscountService(ctx, discountRepo, balanceRepo, ruleService, chainService, userRepo, database.DB)
+	discountApprovalService := domain_service.NewDiscountApprovalService(ctx, discountRepo, balanceRepo, chainService, delegationService, userRepo, database.DB)
+	jobRunService := job_runs.NewJobRunService(ctx, jobRunRepo, database.DB)
 	autoRejectService := auto_reject.NewAutoRejectService(
-		ctx, leaveRepo, expenseRepo, discountRepo, holidayRepo, database.DB,
+		ctx, holidayRepo, escalationRepo, chainRepo, balanceRepo, jobRunService, database.DB,
 	)
-	myRequestsService := my_requests.NewMyRequestsService(ctx, myRequestsRepo)
+	myRequestsService := my_requests.NewMyRequestsService(ctx, myRequestsRepo, delegationService)
+	schedulerService := scheduler.NewSchedulerService(ctx, jobRunService, jobRunRepo)
+	balancePolicyService := balance_policies.NewBalancePolicyService(ctx, balancePolicyRepo, balanceRepo, database.DB)
+	leaveTypeService := leave_types.NewLeaveTypeService(ctx, leaveTypeRepo, balanceRepo, database.DB)
+	feedService := feeds.NewFeedService(ctx, feedRepo, holidayRepo)
+
+	// Background jobs, scheduled from config and registered in this order
+	jobFuncs := []struct {
+		name string
+		job  func(ctx context.Context) (map[string]int, error)
+	}{
+		{constants.JobAutoReject, jobs.AutoRejectJob(autoRejectService)},
+		{constants.JobBalancePolicies, jobs.BalancePolicyJob(balancePolicyService)},
+		{constants.JobReminderDigest, jobs.ReminderDigestJob(reportService)},
+		{constants.JobRequestReport, jobs.RequestReportJob(reportService)},
+	}
+	for _, jf := range jobFuncs {
+		jobCfg := cfg.Scheduler.Jobs[jf.name]
+		if err := schedulerService.Register(jf.name, jobCfg.Schedule, jobCfg.Timezone, jf.job); err != nil {
+			log.Fatalf("Failed to register job %s: %v", jf.name, err)
+		}
+	}
+
+	// Surface rule gaps and conflicts at startup instead of when requests start failing
+	if analysis, err := ruleService.AnalyzeRules(ctx, constants.RoleAdmin); err != nil {
+		log.Println("Rule analysis failed:", err)
+	} else {
+		for _, issue := range analysis.Issues {
+			log.Printf("Rule analysis %s %s (%s, grade %d): %s", issue.Severity, issue.Kind, issue.RequestType, issue.GradeID, issue.Message)
+		}
+	}
 
 	// 3. Router & CORS
 	router := gin.Default()
@@ -106,15 +155,19 @@ func main() {
 		autoRejectService,
 		discountService,
 		discountApprovalService,
+		chainService,
+		delegationService,
+		escalationService,
+		jobRunService,
+		schedulerService,
+		balancePolicyService,
+		leaveTypeService,
+		feedService,
 	)
 
-	// 5. Cron Jobs
-	loc, _ := time.LoadLocation("Asia/Kolkata")
-	c := cron.New(cron.WithLocation(loc))
-	c.AddFunc("0 0 * * *", func() {
-		jobs.RunAutoRejectJob(ctx, autoRejectService)
-	})
-	c.Start()
+	// 5. Scheduled Jobs (every instance schedules them; the job lock lets one of them run)
+	schedulerService.Start()
+	defer schedulerService.Stop()
 
 	log.Println(" Server started on port", cfg.AppPort)
 	router.Run(":" + cfg.AppPort)
@@ -4,12 +4,15 @@ import (
 	"log"
 	"os"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	AppPort string
-	DB      DBConfig
+	AppPort   string
+	DB        DBConfig
+	Scheduler SchedulerConfig
 }
 
 type DBConfig struct {
@@ -21,6 +24,16 @@ type DBConfig struct {
 	SSLMode  string
 }
 
+// SchedulerConfig holds the cron schedule and timezone of each background job by name
+type SchedulerConfig struct {
+	Jobs map[string]JobConfig
+}
+
+type JobConfig struct {
+	Schedule string
+	Timezone string
+}
+
 func Load() *Config {
 	// Try to load .env from current or parent directories
 	err := godotenv.Load()
@@ -34,6 +47,8 @@ func Load() *Config {
 		log.Println("Warning: Error loading .env file, using defaults")
 	}
 
+	timezone := getEnv("SCHEDULER_TIMEZONE", "Asia/Kolkata")
+
 	return &Config{
 		AppPort: getEnv("APP_PORT", "8080"),
 		DB: DBConfig{
@@ -44,6 +59,26 @@ func Load() *Config {
 			Name:     getEnv("DB_NAME", "approval_engine"),
 			SSLMode:  getEnv("DB_SSLMODE", "require"),
 		},
+		Scheduler: SchedulerConfig{
+			Jobs: map[string]JobConfig{
+				constants.JobAutoReject: {
+					Schedule: getEnv("JOB_AUTO_REJECT_SCHEDULE", "0 0 * * *"),
+					Timezone: getEnv("JOB_AUTO_REJECT_TIMEZONE", timezone),
+				},
+				constants.JobBalancePolicies: {
+					Schedule: getEnv("JOB_BALANCE_POLICIES_SCHEDULE", "30 0 * * *"),
+					Timezone: getEnv("JOB_BALANCE_POLICIES_TIMEZONE", timezone),
+				},
+				constants.JobReminderDigest: {
+					Schedule: getEnv("JOB_REMINDER_DIGEST_SCHEDULE", "0 9 * * 1-5"),
+					Timezone: getEnv("JOB_REMINDER_DIGEST_TIMEZONE", timezone),
+				},
+				constants.JobRequestReport: {
+					Schedule: getEnv("JOB_REQUEST_REPORT_SCHEDULE", "0 1 * * *"),
+					Timezone: getEnv("JOB_REQUEST_REPORT_TIMEZONE", timezone),
+				},
+			},
+		},
 	}
 }
 
@@ -13,6 +13,70 @@ const (
 	StatusAutoApproved = "AUTO_APPROVED"
 	StatusAutoApprove  = "AUTO_APPROVE"
 
+	ActionManual     = "MANUAL"
+	ActionAutoReject = "AUTO_REJECT"
+	ActionRouteTo    = "ROUTE_TO"
+
+	RuleModeFirstMatch = "FIRST_MATCH"
+	RuleModeAllMatch   = "ALL_MATCH"
+
+	IssueMissingRules  = "MISSING_RULES"
+	IssueOverlap       = "OVERLAP"
+	IssueContradiction = "CONTRADICTION"
+	IssueUnreachable   = "UNREACHABLE"
+	IssueExceedsLimit  = "EXCEEDS_LIMIT"
+	SeverityError      = "ERROR"
+	SeverityWarning    = "WARNING"
+
+	PolicyBundleVersion = 1
+	ChangeAdded         = "ADDED"
+	ChangeChanged       = "CHANGED"
+	ChangeRemoved       = "REMOVED"
+
+	ApproverReportingManager = "REPORTING_MANAGER"
+	ApproverSkipLevelManager = "SKIP_LEVEL_MANAGER"
+	ApproverRole             = "ROLE"
+	ApproverUser             = "USER"
+	StepStatusSkipped        = "SKIPPED"
+
+	EscalationReminder     = "REMINDER"
+	EscalationEscalated    = "ESCALATED"
+	EscalationAutoRejected = "AUTO_REJECTED"
+
+	JobAutoReject       = "auto_reject"
+	JobBalancePolicies  = "balance_policies"
+	JobReminderDigest   = "reminder_digest"
+	JobRequestReport    = "request_report"
+	JobStatusRunning    = "RUNNING"
+	JobStatusSucceeded  = "SUCCEEDED"
+	JobStatusFailed     = "FAILED"
+	DefaultJobRunsLimit = 50
+
+	LedgerOpening      = "OPENING"
+	LedgerAllocation   = "ALLOCATION"
+	LedgerDeduction    = "DEDUCTION"
+	LedgerRestoration  = "RESTORATION"
+	LedgerAccrual      = "ACCRUAL"
+	LedgerAdjustment   = "ADJUSTMENT"
+	LedgerReset        = "RESET"
+	LedgerExpiry       = "EXPIRY"
+	DefaultLedgerLimit = 50
+
+	SessionFull       = "FULL"
+	SessionFirstHalf  = "FIRST_HALF"
+	SessionSecondHalf = "SECOND_HALF"
+	LeaveHoursPerDay  = 8
+	DayOffWeekend     = "WEEKEND"
+	DayOffHoliday     = "HOLIDAY"
+
+	FeedScopeSelf = "SELF"
+	FeedScopeTeam = "TEAM"
+
+	ResetCalendar  = "CALENDAR"
+	ResetFiscal    = "FISCAL"
+	AccrualAnnual  = "ANNUAL"
+	AccrualMonthly = "MONTHLY"
+
 	AdminEmail   = "admin@company.com"
 	ManagerEmail = "manager@company.com"
 )
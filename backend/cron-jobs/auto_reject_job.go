@@ -2,15 +2,15 @@ package jobs
 
 import (
 	"context"
-	"log"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
-func RunAutoRejectJob(ctx context.Context, service interfaces.AutoRejectService) {
-	log.Println("Running Auto Reject Cron Job...")
-
-	if err := service.AutoRejectExpiredRequests(ctx); err != nil {
-		log.Printf("Error in Auto Reject Job: %v", err)
+// AutoRejectJob returns the auto-reject job for the scheduler
+func AutoRejectJob(service interfaces.AutoRejectService) func(ctx context.Context) (map[string]int, error) {
+	return func(ctx context.Context) (map[string]int, error) {
+		report, err := service.AutoRejectExpiredRequests(ctx)
+		return utils.AutoRejectCounts(report), err
 	}
 }
@@ -0,0 +1,12 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+)
+
+// BalancePolicyJob returns the job that applies the balance policies for the scheduler
+func BalancePolicyJob(service interfaces.BalancePolicyService) func(ctx context.Context) (map[string]int, error) {
+	return service.ApplyPolicies
+}
@@ -0,0 +1,25 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+)
+
+// ReminderDigestJob returns the job that sums up the requests still waiting for approvers
+// for the scheduler; the counts are kept with its run
+func ReminderDigestJob(service interfaces.ReportService) func(ctx context.Context) (map[string]int, error) {
+	return func(ctx context.Context) (map[string]int, error) {
+		pending, err := service.GetPendingCounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if pending["leave"]+pending["expense"]+pending["discount"] > 0 {
+			log.Printf("Pending approvals: %d leave, %d expense, %d discount",
+				pending["leave"], pending["expense"], pending["discount"])
+		}
+		return pending, nil
+	}
+}
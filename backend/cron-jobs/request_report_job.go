@@ -0,0 +1,13 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+)
+
+// RequestReportJob returns the job that records how many requests are in each status for
+// the scheduler; the run history keeps a daily snapshot of the counts
+func RequestReportJob(service interfaces.ReportService) func(ctx context.Context) (map[string]int, error) {
+	return service.GetRequestStatusDistribution
+}
@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
@@ -45,28 +46,64 @@ type UserRepository interface {
 
 // BalanceRepository definitions
 type BalanceRepository interface {
-	GetLeaveBalance(ctx context.Context, tx Tx, userID int64) (int, error)
-	GetLeaveFullBalance(ctx context.Context, tx Tx, userID int64) (total int, remaining int, err error)
+	GetLeaveBalance(ctx context.Context, tx Tx, userID int64, leaveType string) (float64, error)
+	GetLeaveFullBalance(ctx context.Context, tx Tx, userID int64, leaveType string) (models.Balance, error)
+	GetLeaveBalances(ctx context.Context, tx Tx, userID int64) ([]models.Balance, error)
 	GetExpenseBalance(ctx context.Context, tx Tx, userID int64) (float64, error)
-	GetExpenseFullBalance(ctx context.Context, tx Tx, userID int64) (total float64, remaining float64, err error)
+	GetExpenseFullBalance(ctx context.Context, tx Tx, userID int64) (models.Balance, error)
 	GetDiscountBalance(ctx context.Context, tx Tx, userID int64) (float64, error)
-	GetDiscountFullBalance(ctx context.Context, tx Tx, userID int64) (total float64, remaining float64, err error)
-	DeductLeaveBalance(ctx context.Context, tx Tx, userID int64, days int) error
-	DeductExpenseBalance(ctx context.Context, tx Tx, userID int64, amount float64) error
-	DeductDiscountBalance(ctx context.Context, tx Tx, userID int64, percent float64) error
-	RestoreLeaveBalance(ctx context.Context, tx Tx, userID int64, days int) error
-	RestoreExpenseBalance(ctx context.Context, tx Tx, userID int64, amount float64) error
-	RestoreDiscountBalance(ctx context.Context, tx Tx, userID int64, percent float64) error
+	GetDiscountFullBalance(ctx context.Context, tx Tx, userID int64) (models.Balance, error)
+	DeductLeaveBalance(ctx context.Context, tx Tx, userID int64, leaveType string, days float64, change models.BalanceChange) error
+	DeductExpenseBalance(ctx context.Context, tx Tx, userID int64, amount float64, change models.BalanceChange) error
+	DeductDiscountBalance(ctx context.Context, tx Tx, userID int64, percent float64, change models.BalanceChange) error
+	RestoreLeaveBalance(ctx context.Context, tx Tx, userID int64, leaveType string, days float64, change models.BalanceChange) error
+	RestoreExpenseBalance(ctx context.Context, tx Tx, userID int64, amount float64, change models.BalanceChange) error
+	RestoreDiscountBalance(ctx context.Context, tx Tx, userID int64, percent float64, change models.BalanceChange) error
 	InitializeBalances(ctx context.Context, tx Tx, userID int64, gradeID int64) error
+	OpenLeaveBalances(ctx context.Context, tx Tx, leaveType string) (int64, error)
+	GetLedger(ctx context.Context, userID int64, balanceType string, limit, offset int) ([]models.BalanceLedgerEntry, error)
+	AdjustBalance(ctx context.Context, tx Tx, userID int64, balanceType, leaveType, entryType string, amount float64, change models.BalanceChange) error
+	SetBalanceTotal(ctx context.Context, tx Tx, userID int64, balanceType, leaveType string, total float64) error
+	ReserveBalance(ctx context.Context, tx Tx, userID int64, balanceType, leaveType string, amount float64) error
+	ReleaseReservation(ctx context.Context, tx Tx, userID int64, balanceType, leaveType string, amount float64) error
+	ReleaseReservations(ctx context.Context, tx Tx, requestType string, requestIDs []int64) error
+}
+
+// LeaveTypeRepository handles the leave type catalog and the allocation of each type per grade
+type LeaveTypeRepository interface {
+	GetAll(ctx context.Context) ([]models.LeaveType, error)
+	GetByCode(ctx context.Context, code string) (*models.LeaveType, error)
+	Upsert(ctx context.Context, tx Tx, leaveType *models.LeaveType) error
+	ReplaceAllocations(ctx context.Context, tx Tx, code string, allocations []models.LeaveTypeAllocation) error
+	Deactivate(ctx context.Context, code string) error
+}
+
+// BalancePolicyRepository handles balance policies and the cycle state of each wallet
+type BalancePolicyRepository interface {
+	GetPolicies(ctx context.Context) ([]models.BalancePolicy, error)
+	ReplacePolicy(ctx context.Context, tx Tx, policy *models.BalancePolicy) error
+	DeactivatePolicy(ctx context.Context, balanceType string) error
+	GetAccounts(ctx context.Context, balanceType string) ([]models.BalanceAccount, error)
+	LockAccount(ctx context.Context, tx Tx, balanceType string, userID int64, leaveType string) (*models.BalanceAccount, error)
+	SaveCycle(ctx context.Context, tx Tx, cycle models.BalanceCycle) error
 }
 
 // RuleRepository definitions
 type RuleRepository interface {
-	GetByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error)
-	Create(ctx context.Context, rule *models.Rule) error
+	GetActiveByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) ([]models.Rule, error)
+	GetEvaluationMode(ctx context.Context, requestType string, gradeID int64) (string, error)
+	GetEvaluationModes(ctx context.Context) ([]models.EvaluationMode, error)
+	GetEvaluationModesInTx(ctx context.Context, tx Tx) ([]models.EvaluationMode, error)
+	SetEvaluationMode(ctx context.Context, tx Tx, requestType string, gradeID int64, mode string) error
+	Create(ctx context.Context, tx Tx, rule *models.Rule) error
+	InsertVersion(ctx context.Context, tx Tx, rule *models.Rule) error
+	GetLatestVersion(ctx context.Context, tx Tx, ruleID int64) (*models.Rule, error)
+	CloseOpenVersions(ctx context.Context, tx Tx, ruleKey int64, effectiveTo time.Time) error
+	GetHistory(ctx context.Context, ruleID int64) ([]models.Rule, error)
 	GetAll(ctx context.Context) ([]models.Rule, error)
-	Update(ctx context.Context, ruleID int64, rule *models.Rule) error
-	Delete(ctx context.Context, ruleID int64) error
+	GetAllInTx(ctx context.Context, tx Tx) ([]models.Rule, error)
+	LockPolicy(ctx context.Context, tx Tx) error
+	GetSimulationSamples(ctx context.Context, requestType string, gradeID int64, from, to time.Time) ([]models.SimulationSample, error)
 }
 
 // LeaveRequestRepository definitions
@@ -74,9 +111,9 @@ type LeaveRequestRepository interface {
 	Create(ctx context.Context, tx Tx, req *models.LeaveRequest) error
 	GetByID(ctx context.Context, tx Tx, requestID int64) (*models.LeaveRequest, error)
 	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
-	GetPendingForManager(ctx context.Context, managerID int64, limit, offset int) ([]map[string]interface{}, int, error)
+	GetPendingForManager(ctx context.Context, managerIDs []int64, limit, offset int) ([]map[string]interface{}, int, error)
 	GetPendingForAdmin(ctx context.Context, limit, offset int) ([]map[string]interface{}, int, error)
-	CheckOverlap(ctx context.Context, userID int64, fromDate, toDate time.Time) (bool, error)
+	CheckOverlap(ctx context.Context, userID int64, period models.LeavePeriod) (bool, error)
 	Cancel(ctx context.Context, tx Tx, requestID int64) error
 	GetPendingRequests(ctx context.Context) ([]struct {
 		ID        int64
@@ -89,7 +126,7 @@ type ExpenseRequestRepository interface {
 	Create(ctx context.Context, tx Tx, req *models.ExpenseRequest) error
 	GetByID(ctx context.Context, tx Tx, requestID int64) (*models.ExpenseRequest, error)
 	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
-	GetPendingForManager(ctx context.Context, managerID int64, limit, offset int) ([]map[string]interface{}, int, error)
+	GetPendingForManager(ctx context.Context, managerIDs []int64, limit, offset int) ([]map[string]interface{}, int, error)
 	GetPendingForAdmin(ctx context.Context, limit, offset int) ([]map[string]interface{}, int, error)
 	Cancel(ctx context.Context, tx Tx, requestID int64) error
 	GetPendingRequests(ctx context.Context) ([]struct {
@@ -103,7 +140,7 @@ type DiscountRequestRepository interface {
 	Create(ctx context.Context, tx Tx, req *models.DiscountRequest) error
 	GetByID(ctx context.Context, tx Tx, requestID int64) (*models.DiscountRequest, error)
 	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
-	GetPendingForManager(ctx context.Context, managerID int64, limit, offset int) ([]map[string]interface{}, int, error)
+	GetPendingForManager(ctx context.Context, managerIDs []int64, limit, offset int) ([]map[string]interface{}, int, error)
 	GetPendingForAdmin(ctx context.Context, limit, offset int) ([]map[string]interface{}, int, error)
 	Cancel(ctx context.Context, tx Tx, requestID int64) error
 	GetPendingRequests(ctx context.Context) ([]struct {
@@ -115,14 +152,89 @@ type DiscountRequestRepository interface {
 // GradeRepository handles grade data access operations
 type GradeRepository interface {
 	GetLimits(ctx context.Context, tx Tx, gradeID int64) (leaveLimit int, expenseLimit float64, discountLimit float64, err error)
+	GetAll(ctx context.Context) ([]models.Grade, error)
+	GetAllInTx(ctx context.Context, tx Tx) ([]models.Grade, error)
+	Upsert(ctx context.Context, tx Tx, grade *models.Grade) error
 }
 
-// HolidayRepository handles holiday data access
+// HolidayRepository handles work calendars: their holidays, working weeks and the users
+// following them
 type HolidayRepository interface {
-	AddHoliday(ctx context.Context, date time.Time, desc string, adminID int64) error
-	GetHolidays(ctx context.Context) ([]map[string]interface{}, error)
+	AddHoliday(ctx context.Context, calendarID int64, date time.Time, desc string, adminID int64) error
+	GetHolidays(ctx context.Context, calendarID int64) ([]map[string]interface{}, error)
+	GetHolidaysInTx(ctx context.Context, tx Tx, calendarID int64) ([]map[string]interface{}, error)
 	DeleteHoliday(ctx context.Context, holidayID int64) error
-	IsHoliday(ctx context.Context, date time.Time) (bool, error)
+	IsHoliday(ctx context.Context, calendarID int64, date time.Time) (bool, error)
+	UpsertHoliday(ctx context.Context, tx Tx, calendarID int64, date time.Time, desc string, adminID int64) error
+	DeleteHolidayByDate(ctx context.Context, tx Tx, calendarID int64, date time.Time) error
+	GetCalendars(ctx context.Context) ([]models.Calendar, error)
+	GetCalendar(ctx context.Context, calendarID int64) (*models.Calendar, error)
+	GetDefaultCalendar(ctx context.Context) (*models.Calendar, error)
+	GetDefaultCalendarInTx(ctx context.Context, tx Tx) (*models.Calendar, error)
+	CreateCalendar(ctx context.Context, tx Tx, calendar *models.Calendar) error
+	UpdateCalendar(ctx context.Context, tx Tx, calendar *models.Calendar) error
+	DeleteCalendar(ctx context.Context, calendarID int64) error
+	SetWorkWeek(ctx context.Context, tx Tx, calendarID int64, workingDays []time.Weekday, adminID int64) error
+	AssignCalendar(ctx context.Context, calendarID int64, userIDs []int64) (int64, error)
+	GetUserWorkCalendar(ctx context.Context, userID int64, from, to time.Time) (models.WorkCalendar, error)
+	GetWorkCalendars(ctx context.Context) (map[int64]models.WorkCalendar, error)
+}
+
+// ApprovalChainRepository handles approval chains and the steps of requests going through them
+type ApprovalChainRepository interface {
+	Create(ctx context.Context, tx Tx, chain *models.ApprovalChain) error
+	GetAll(ctx context.Context) ([]models.ApprovalChain, error)
+	GetActiveForAmount(ctx context.Context, tx Tx, requestType string, amount float64) (*models.ApprovalChain, error)
+	LockActiveByType(ctx context.Context, tx Tx, requestType string) ([]models.ApprovalChain, error)
+	Deactivate(ctx context.Context, chainID int64) error
+	CreateRequestSteps(ctx context.Context, tx Tx, steps []models.RequestApprovalStep) error
+	GetRequestSteps(ctx context.Context, requestType string, requestID int64) ([]models.RequestApprovalStep, error)
+	GetPendingRequestSteps(ctx context.Context, tx Tx, requestType string, requestID int64) ([]models.RequestApprovalStep, error)
+	HasRequestSteps(ctx context.Context, tx Tx, requestType string, requestID int64) (bool, error)
+	GetStepApprovers(ctx context.Context, tx Tx, requestType string, requestID int64) ([]int64, error)
+	DecideStep(ctx context.Context, tx Tx, stepID int64, status string, decidedBy int64, delegation *models.ApprovalDelegation, comment string) error
+	SkipPendingSteps(ctx context.Context, tx Tx, requestType string, requestID int64) error
+	SkipPendingStepsForRequests(ctx context.Context, tx Tx, requestType string, requestIDs []int64) error
+	RouteRequest(ctx context.Context, tx Tx, requestType string, requestID int64, role string, userID *int64) error
+	GetRequestOwner(ctx context.Context, requestType string, requestID int64) (int64, error)
+}
+
+// EscalationRepository handles SLA policies and the escalation steps of requests
+type EscalationRepository interface {
+	GetPolicies(ctx context.Context) ([]models.SLAPolicy, error)
+	GetPolicyByID(ctx context.Context, policyID int64) (*models.SLAPolicy, error)
+	CreatePolicy(ctx context.Context, policy *models.SLAPolicy) error
+	UpdatePolicy(ctx context.Context, policy *models.SLAPolicy) error
+	DeactivatePolicy(ctx context.Context, policyID int64) error
+	GetCandidates(ctx context.Context, requestType string) ([]models.EscalationCandidate, error)
+	RecordStep(ctx context.Context, tx Tx, escalation *models.RequestEscalation) (bool, error)
+	AutoRejectRequests(ctx context.Context, tx Tx, requestType string, requestIDs []int64, policyID int64, comment string) ([]int64, error)
+	RecordAutoRejections(ctx context.Context, tx Tx, requestType string, items []models.AutoRejectItem) error
+	GetRequestEscalations(ctx context.Context, requestType string, requestID int64) ([]models.RequestEscalation, error)
+}
+
+// DelegationRepository handles approval delegations between users
+type DelegationRepository interface {
+	Create(ctx context.Context, delegation *models.ApprovalDelegation) error
+	GetByID(ctx context.Context, delegationID int64) (*models.ApprovalDelegation, error)
+	GetForUser(ctx context.Context, userID int64) ([]models.ApprovalDelegation, error)
+	GetAll(ctx context.Context) ([]models.ApprovalDelegation, error)
+	Revoke(ctx context.Context, delegationID int64) error
+	GetInEffect(ctx context.Context, tx Tx, delegatorID, delegateID int64) (*models.ApprovalDelegation, error)
+	GetDelegators(ctx context.Context, delegateID int64) ([]int64, error)
+	RecordDecision(ctx context.Context, tx Tx, requestType string, requestID int64, delegation *models.ApprovalDelegation) error
+}
+
+// JobRunRepository handles the run history of background jobs, their paused state and
+// the lock that keeps a job to one instance at a time
+type JobRunRepository interface {
+	TryLock(ctx context.Context, tx Tx, jobName string) (bool, error)
+	FailStaleRuns(ctx context.Context, jobName string) error
+	Start(ctx context.Context, run *models.JobRun) error
+	Finish(ctx context.Context, run *models.JobRun) error
+	GetRecent(ctx context.Context, jobName string, limit int) ([]models.JobRun, error)
+	GetPausedJobs(ctx context.Context) (map[string]bool, error)
+	SetPaused(ctx context.Context, jobName string, paused bool, adminID int64) error
 }
 
 // MyRequestsRepository handles read-only queries for a user's own requests
@@ -131,7 +243,7 @@ type MyRequestsRepository interface {
 	GetMyExpenseRequests(ctx context.Context, userID int64, limit, offset int) ([]map[string]interface{}, int, error)
 	GetMyDiscountRequests(ctx context.Context, userID int64, limit, offset int) ([]map[string]interface{}, int, error)
 	GetMyAllRequests(ctx context.Context, userID int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error)
-	GetPendingAllRequests(ctx context.Context, role string, approverID int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error)
+	GetPendingAllRequests(ctx context.Context, role string, approverIDs []int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error)
 }
 
 // ReportRepository handles analytical and statistical queries
@@ -141,6 +253,17 @@ type ReportRepository interface {
 	GetPendingLeaveCount(ctx context.Context) (int, error)
 	GetPendingExpenseCount(ctx context.Context) (int, error)
 	GetPendingDiscountCount(ctx context.Context) (int, error)
+	GetLeaveByCalendar(ctx context.Context) ([]models.CalendarLeaveReport, error)
+}
+
+// FeedRepository handles calendar feed tokens and the approved leave their feeds show
+type FeedRepository interface {
+	Create(ctx context.Context, token *models.FeedToken) error
+	GetByID(ctx context.Context, tokenID int64) (*models.FeedToken, error)
+	GetForUser(ctx context.Context, userID int64) ([]models.FeedToken, error)
+	Revoke(ctx context.Context, tokenID int64) error
+	Use(ctx context.Context, tokenHash string) (*models.FeedToken, error)
+	GetApprovedLeaves(ctx context.Context, userID int64, team bool, since time.Time) ([]models.FeedLeave, error)
 }
 
 // Service interfaces
@@ -152,7 +275,7 @@ type AuthService interface {
 }
 
 type LeaveService interface {
-	ApplyLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string) (string, string, error)
+	ApplyLeave(ctx context.Context, userID int64, period models.LeavePeriod, leaveType string, reason string) (string, string, models.LeavePeriod, error)
 	CancelLeave(ctx context.Context, userID, requestID int64) error
 }
 
@@ -174,11 +297,18 @@ type ExpenseApprovalService interface {
 }
 
 type RuleService interface {
-	GetRule(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error)
+	GetRuleSet(ctx context.Context, requestType string, gradeID int64) (*models.RuleSet, error)
+	SetEvaluationMode(ctx context.Context, role string, requestType string, gradeID int64, mode string) error
 	CreateRule(ctx context.Context, role string, rule models.Rule) error
 	GetRules(ctx context.Context, role string) ([]models.Rule, error)
 	UpdateRule(ctx context.Context, role string, ruleID int64, rule models.Rule) error
 	DeleteRule(ctx context.Context, role string, ruleID int64) error
+	GetRuleHistory(ctx context.Context, role string, ruleID int64) ([]models.Rule, error)
+	RollbackRule(ctx context.Context, role string, ruleID int64, version int) (*models.Rule, error)
+	ExportBundle(ctx context.Context, role string) (*models.PolicyBundle, error)
+	AnalyzeRules(ctx context.Context, role string) (*models.RuleAnalysis, error)
+	ImportBundle(ctx context.Context, role string, adminID int64, bundle models.PolicyBundle, preview bool) (*models.BundleDiff, error)
+	SimulateRules(ctx context.Context, role string, sim models.RuleSimulation) (*models.SimulationReport, error)
 }
 
 type DiscountService interface {
@@ -194,18 +324,32 @@ type DiscountApprovalService interface {
 
 type BalanceService interface {
 	GetBalances(ctx context.Context, userID int64) (map[string]interface{}, error)
+	GetBalanceHistory(ctx context.Context, userID int64, balanceType string, limit, offset int) ([]models.BalanceLedgerEntry, error)
+	AdjustBalance(ctx context.Context, role string, adminID int64, adjustment models.BalanceAdjustment) (*models.BalanceAdjustment, error)
+	BulkAdjustBalances(ctx context.Context, role string, adminID int64, file io.Reader, preview bool) (*models.BulkBalanceAdjustment, error)
 }
 
 type HolidayService interface {
-	AddHoliday(ctx context.Context, role string, adminID int64, date time.Time, desc string) error
-	GetHolidays(ctx context.Context, role string) ([]map[string]interface{}, error)
+	AddHoliday(ctx context.Context, role string, adminID, calendarID int64, date time.Time, desc string) error
+	GetHolidays(ctx context.Context, role string, calendarID int64) ([]map[string]interface{}, error)
 	DeleteHoliday(ctx context.Context, role string, holidayID int64) error
+	GetWorkWeek(ctx context.Context, calendarID int64) ([]string, error)
+	SetWorkWeek(ctx context.Context, role string, adminID, calendarID int64, workingDays []string) ([]string, error)
+	GetCalendars(ctx context.Context, role string) ([]models.Calendar, error)
+	CreateCalendar(ctx context.Context, role string, adminID int64, name string, workingDays []string) (*models.Calendar, error)
+	UpdateCalendar(ctx context.Context, role string, adminID, calendarID int64, name string, workingDays []string) (*models.Calendar, error)
+	DeleteCalendar(ctx context.Context, role string, calendarID int64) error
+	AssignCalendar(ctx context.Context, role string, calendarID int64, userIDs []int64) (int64, error)
+	ImportHolidays(ctx context.Context, role string, adminID, calendarID int64, file io.Reader, preview bool) (*models.HolidayImport, error)
+	ExportHolidays(ctx context.Context, role string, calendarID int64) ([]byte, error)
 }
 
 type ReportService interface {
 	GetDashboardSummary(ctx context.Context, role string) (map[string]interface{}, error)
 	GetRequestStatusDistribution(ctx context.Context) (map[string]int, error)
 	GetRequestsByTypeReport(ctx context.Context) ([]models.RequestTypeReport, error)
+	GetPendingCounts(ctx context.Context) (map[string]int, error)
+	GetLeaveByCalendar(ctx context.Context, role string) ([]models.CalendarLeaveReport, error)
 }
 
 type MyRequestsService interface {
@@ -214,6 +358,72 @@ type MyRequestsService interface {
 	GetPendingAllRequests(ctx context.Context, role string, userID int64, limit, offset int) (map[string]interface{}, error)
 }
 
+type ApprovalChainService interface {
+	CreateChain(ctx context.Context, role string, chain models.ApprovalChain) (*models.ApprovalChain, error)
+	GetChains(ctx context.Context, role string) ([]models.ApprovalChain, error)
+	DeleteChain(ctx context.Context, role string, chainID int64) error
+	StartChain(ctx context.Context, tx Tx, requestType string, requestID int64, requester *models.User, amount float64) error
+	ApproveStep(ctx context.Context, tx Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string) (bool, error)
+	RejectStep(ctx context.Context, tx Tx, requestType string, requestID int64, approverID int64, delegation *models.ApprovalDelegation, comment string) error
+	CancelSteps(ctx context.Context, tx Tx, requestType string, requestID int64) error
+	GetRequestSteps(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestApprovalStep, error)
+}
+
+type DelegationService interface {
+	CreateDelegation(ctx context.Context, role string, userID int64, delegation models.ApprovalDelegation) (*models.ApprovalDelegation, error)
+	GetDelegations(ctx context.Context, role string, userID int64) ([]models.ApprovalDelegation, error)
+	RevokeDelegation(ctx context.Context, role string, userID, delegationID int64) error
+	GetApproverIDs(ctx context.Context, approverID int64) ([]int64, error)
+	ResolveActor(ctx context.Context, tx Tx, role string, approverID, requesterID int64, requesterRole, routedToRole string, routedToUserID *int64) (*models.ApprovalActor, error)
+	RecordDecision(ctx context.Context, tx Tx, requestType string, requestID int64, actor *models.ApprovalActor) error
+}
+
+type EscalationService interface {
+	GetPolicies(ctx context.Context, role string) ([]models.SLAPolicy, error)
+	CreatePolicy(ctx context.Context, role string, adminID int64, policy models.SLAPolicy) (*models.SLAPolicy, error)
+	UpdatePolicy(ctx context.Context, role string, adminID int64, policy models.SLAPolicy) (*models.SLAPolicy, error)
+	DeletePolicy(ctx context.Context, role string, policyID int64) error
+	GetRequestEscalations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestEscalation, error)
+}
+
+type JobRunService interface {
+	Execute(ctx context.Context, jobName string, triggeredBy *int64, job func(ctx context.Context) (map[string]int, error)) (*models.JobRun, error)
+	GetRuns(ctx context.Context, role string, jobName string, limit int) ([]models.JobRun, error)
+}
+
+type SchedulerService interface {
+	Register(name, schedule, timezone string, job func(ctx context.Context) (map[string]int, error)) error
+	Start()
+	Stop()
+	GetJobs(ctx context.Context, role string) ([]models.ScheduledJob, error)
+	PauseJob(ctx context.Context, role string, adminID int64, name string) error
+	ResumeJob(ctx context.Context, role string, adminID int64, name string) error
+	TriggerJob(ctx context.Context, role string, adminID int64, name string) (*models.JobRun, error)
+}
+
+type BalancePolicyService interface {
+	GetPolicies(ctx context.Context, role string) ([]models.BalancePolicy, error)
+	SetPolicy(ctx context.Context, role string, adminID int64, policy models.BalancePolicy) (*models.BalancePolicy, error)
+	DeletePolicy(ctx context.Context, role string, balanceType string) error
+	ApplyPolicies(ctx context.Context) (map[string]int, error)
+}
+
+type LeaveTypeService interface {
+	GetLeaveTypes(ctx context.Context) ([]models.LeaveType, error)
+	SetLeaveType(ctx context.Context, role string, adminID int64, leaveType models.LeaveType) (*models.LeaveType, error)
+	DeleteLeaveType(ctx context.Context, role string, code string) error
+}
+
 type AutoRejectService interface {
-	AutoRejectExpiredRequests(ctx context.Context) error
+	AutoRejectExpiredRequests(ctx context.Context) (*models.AutoRejectReport, error)
+	PreviewAutoReject(ctx context.Context, role string) (*models.AutoRejectReport, error)
+	RunAutoReject(ctx context.Context, role string, adminID int64) (*models.AutoRejectReport, error)
+}
+
+type FeedService interface {
+	CreateFeedToken(ctx context.Context, role string, userID int64, scope string) (*models.FeedToken, error)
+	GetFeedTokens(ctx context.Context, userID int64) ([]models.FeedToken, error)
+	RevokeFeedToken(ctx context.Context, role string, userID, tokenID int64) error
+	GetLeaveFeed(ctx context.Context, token string) ([]byte, error)
+	GetHolidayFeed(ctx context.Context, token string) ([]byte, error)
 }
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS rule_evaluation_modes;
+
+DROP INDEX IF EXISTS idx_rules_type_grade_priority;
+DROP INDEX IF EXISTS idx_rules_single_default;
+
+ALTER TABLE rules
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS is_default,
+    DROP COLUMN IF EXISTS priority;
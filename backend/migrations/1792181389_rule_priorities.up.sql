@@ -0,0 +1,29 @@
+-- =====================================================
+-- Multiple prioritized rules per request type and grade
+-- =====================================================
+
+-- A (request_type, grade_id) pair may now hold several ordered rules
+ALTER TABLE rules DROP CONSTRAINT IF EXISTS rules_request_type_grade_id_key;
+
+ALTER TABLE rules
+    ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 100,
+    ADD COLUMN IF NOT EXISTS is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- At most one active fallback rule per request type and grade
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rules_single_default
+    ON rules(request_type, grade_id)
+    WHERE is_default AND active;
+
+CREATE INDEX IF NOT EXISTS idx_rules_type_grade_priority
+    ON rules(request_type, grade_id, priority);
+
+-- How the rules of a request type and grade are combined
+CREATE TABLE IF NOT EXISTS rule_evaluation_modes (
+    request_type request_type_enum NOT NULL,
+    grade_id BIGINT NOT NULL REFERENCES grades(id),
+    mode VARCHAR(20) NOT NULL DEFAULT 'FIRST_MATCH'
+        CHECK (mode IN ('FIRST_MATCH', 'ALL_MATCH')),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (request_type, grade_id)
+);
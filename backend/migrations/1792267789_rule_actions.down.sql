@@ -0,0 +1,28 @@
+ALTER TABLE discount_requests
+    DROP COLUMN IF EXISTS routed_to_user_id,
+    DROP COLUMN IF EXISTS routed_to_role,
+    DROP COLUMN IF EXISTS decision_action;
+
+ALTER TABLE expense_requests
+    DROP COLUMN IF EXISTS routed_to_user_id,
+    DROP COLUMN IF EXISTS routed_to_role,
+    DROP COLUMN IF EXISTS decision_action;
+
+ALTER TABLE leave_requests
+    DROP COLUMN IF EXISTS routed_to_user_id,
+    DROP COLUMN IF EXISTS routed_to_role,
+    DROP COLUMN IF EXISTS decision_action;
+
+-- Rules using the new actions cannot be represented once the enum values are gone
+DELETE FROM rules WHERE action::TEXT IN ('AUTO_REJECT', 'ROUTE_TO');
+
+ALTER TABLE rules
+    DROP COLUMN IF EXISTS route_to_user_id,
+    DROP COLUMN IF EXISTS route_to_role,
+    DROP COLUMN IF EXISTS message;
+
+-- Postgres cannot drop enum values, so rebuild the type
+ALTER TYPE rule_action_enum RENAME TO rule_action_enum_old;
+CREATE TYPE rule_action_enum AS ENUM ('AUTO_APPROVE', 'MANUAL');
+ALTER TABLE rules ALTER COLUMN action TYPE rule_action_enum USING action::TEXT::rule_action_enum;
+DROP TYPE rule_action_enum_old;
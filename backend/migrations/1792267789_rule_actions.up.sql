@@ -0,0 +1,28 @@
+-- =====================================================
+-- AUTO_REJECT and ROUTE_TO rule actions
+-- =====================================================
+
+ALTER TYPE rule_action_enum ADD VALUE IF NOT EXISTS 'AUTO_REJECT';
+ALTER TYPE rule_action_enum ADD VALUE IF NOT EXISTS 'ROUTE_TO';
+
+-- Policy message shown on auto-rejection and routing target for ROUTE_TO
+ALTER TABLE rules
+    ADD COLUMN IF NOT EXISTS message TEXT,
+    ADD COLUMN IF NOT EXISTS route_to_role user_role,
+    ADD COLUMN IF NOT EXISTS route_to_user_id BIGINT REFERENCES users(id);
+
+-- Action chosen by the rule engine and the approver it routed the request to
+ALTER TABLE leave_requests
+    ADD COLUMN IF NOT EXISTS decision_action VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS routed_to_role user_role,
+    ADD COLUMN IF NOT EXISTS routed_to_user_id BIGINT REFERENCES users(id);
+
+ALTER TABLE expense_requests
+    ADD COLUMN IF NOT EXISTS decision_action VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS routed_to_role user_role,
+    ADD COLUMN IF NOT EXISTS routed_to_user_id BIGINT REFERENCES users(id);
+
+ALTER TABLE discount_requests
+    ADD COLUMN IF NOT EXISTS decision_action VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS routed_to_role user_role,
+    ADD COLUMN IF NOT EXISTS routed_to_user_id BIGINT REFERENCES users(id);
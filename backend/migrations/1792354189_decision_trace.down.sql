@@ -0,0 +1,3 @@
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS decision_trace;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS decision_trace;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS decision_trace;
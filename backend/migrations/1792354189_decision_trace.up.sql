@@ -0,0 +1,7 @@
+-- =====================================================
+-- Rule engine decision trace on every request
+-- =====================================================
+
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS decision_trace JSONB;
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS decision_trace JSONB;
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS decision_trace JSONB;
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_rules_effective;
+DROP INDEX IF EXISTS idx_rules_key_version;
+
+-- Keep only the open version of each rule
+DELETE FROM rules WHERE effective_to IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_rules_single_default;
+CREATE UNIQUE INDEX idx_rules_single_default
+    ON rules(request_type, grade_id)
+    WHERE is_default AND active;
+
+ALTER TABLE rules
+    DROP COLUMN IF EXISTS effective_to,
+    DROP COLUMN IF EXISTS effective_from,
+    DROP COLUMN IF EXISTS version,
+    DROP COLUMN IF EXISTS rule_key;
@@ -0,0 +1,32 @@
+-- =====================================================
+-- Immutable rule versions with effective dates
+-- =====================================================
+
+-- Every row is one version; rule_key groups the versions of a logical rule
+ALTER TABLE rules
+    ADD COLUMN IF NOT EXISTS rule_key BIGINT,
+    ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS effective_from TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS effective_to TIMESTAMP;
+
+UPDATE rules
+SET rule_key = id,
+    effective_from = COALESCE(created_at, NOW())
+WHERE rule_key IS NULL;
+
+ALTER TABLE rules
+    ALTER COLUMN rule_key SET NOT NULL,
+    ALTER COLUMN effective_from SET NOT NULL,
+    ALTER COLUMN effective_from SET DEFAULT NOW();
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rules_key_version
+    ON rules(rule_key, version);
+
+-- Only the open version of a rule can be the active default
+DROP INDEX IF EXISTS idx_rules_single_default;
+CREATE UNIQUE INDEX idx_rules_single_default
+    ON rules(request_type, grade_id)
+    WHERE is_default AND active AND effective_to IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_rules_effective
+    ON rules(request_type, grade_id, effective_from, effective_to);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS request_approval_steps;
+DROP TABLE IF EXISTS approval_chain_steps;
+DROP TABLE IF EXISTS approval_chains;
@@ -0,0 +1,48 @@
+-- =====================================================
+-- Multi-level approval chains
+-- =====================================================
+
+-- A chain applies to pending requests of its type whose amount falls in
+-- [min_amount, max_amount): the expense amount, leave days or discount percent
+CREATE TABLE IF NOT EXISTS approval_chains (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    request_type request_type_enum NOT NULL,
+    min_amount NUMERIC NOT NULL DEFAULT 0 CHECK (min_amount >= 0),
+    max_amount NUMERIC CHECK (max_amount IS NULL OR max_amount > min_amount),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_chains_type
+    ON approval_chains(request_type)
+    WHERE active;
+
+CREATE TABLE IF NOT EXISTS approval_chain_steps (
+    chain_id BIGINT NOT NULL REFERENCES approval_chains(id) ON DELETE CASCADE,
+    step_order INT NOT NULL CHECK (step_order > 0),
+    approver_type TEXT NOT NULL
+        CHECK (approver_type IN ('REPORTING_MANAGER', 'SKIP_LEVEL_MANAGER', 'ROLE', 'USER')),
+    approver_role user_role,
+    approver_user_id BIGINT REFERENCES users(id),
+    PRIMARY KEY (chain_id, step_order)
+);
+
+-- Steps are copied onto the request when it is created so later chain
+-- changes never affect requests already in flight
+CREATE TABLE IF NOT EXISTS request_approval_steps (
+    id BIGSERIAL PRIMARY KEY,
+    request_type request_type_enum NOT NULL,
+    request_id BIGINT NOT NULL,
+    chain_id BIGINT REFERENCES approval_chains(id),
+    step_order INT NOT NULL,
+    approver_type TEXT NOT NULL,
+    approver_role user_role,
+    approver_user_id BIGINT REFERENCES users(id),
+    status TEXT NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED', 'SKIPPED')),
+    decided_by BIGINT REFERENCES users(id),
+    comment TEXT,
+    decided_at TIMESTAMP,
+    UNIQUE (request_type, request_id, step_order)
+);
@@ -0,0 +1,14 @@
+ALTER TABLE request_approval_steps
+    DROP COLUMN IF EXISTS delegation_id,
+    DROP COLUMN IF EXISTS on_behalf_of_id;
+ALTER TABLE discount_requests
+    DROP COLUMN IF EXISTS delegation_id,
+    DROP COLUMN IF EXISTS on_behalf_of_id;
+ALTER TABLE expense_requests
+    DROP COLUMN IF EXISTS delegation_id,
+    DROP COLUMN IF EXISTS on_behalf_of_id;
+ALTER TABLE leave_requests
+    DROP COLUMN IF EXISTS delegation_id,
+    DROP COLUMN IF EXISTS on_behalf_of_id;
+
+DROP TABLE IF EXISTS approval_delegations;
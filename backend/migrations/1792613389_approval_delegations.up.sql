@@ -0,0 +1,37 @@
+-- =====================================================
+-- Approval delegation and out-of-office substitutes
+-- =====================================================
+
+-- The delegate approves for the delegator from start_date to end_date (open-ended when NULL).
+-- on_leave_only delegations apply only on days the delegator has approved leave.
+CREATE TABLE IF NOT EXISTS approval_delegations (
+    id BIGSERIAL PRIMARY KEY,
+    delegator_id BIGINT NOT NULL REFERENCES users(id),
+    delegate_id BIGINT NOT NULL REFERENCES users(id),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    on_leave_only BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (delegator_id <> delegate_id),
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegate
+    ON approval_delegations(delegate_id)
+    WHERE active;
+
+-- Decisions a delegate made record whom they stood in for
+ALTER TABLE leave_requests
+    ADD COLUMN IF NOT EXISTS on_behalf_of_id BIGINT REFERENCES users(id),
+    ADD COLUMN IF NOT EXISTS delegation_id BIGINT REFERENCES approval_delegations(id);
+ALTER TABLE expense_requests
+    ADD COLUMN IF NOT EXISTS on_behalf_of_id BIGINT REFERENCES users(id),
+    ADD COLUMN IF NOT EXISTS delegation_id BIGINT REFERENCES approval_delegations(id);
+ALTER TABLE discount_requests
+    ADD COLUMN IF NOT EXISTS on_behalf_of_id BIGINT REFERENCES users(id),
+    ADD COLUMN IF NOT EXISTS delegation_id BIGINT REFERENCES approval_delegations(id);
+ALTER TABLE request_approval_steps
+    ADD COLUMN IF NOT EXISTS on_behalf_of_id BIGINT REFERENCES users(id),
+    ADD COLUMN IF NOT EXISTS delegation_id BIGINT REFERENCES approval_delegations(id);
@@ -0,0 +1,39 @@
+-- =====================================================
+-- Escalation of stale pending requests
+-- =====================================================
+
+-- Working-day thresholds for pending requests of each type: remind the approver,
+-- escalate to the approver's manager (or admins), and finally auto-reject.
+-- A NULL reminder or escalation threshold skips that step.
+CREATE TABLE IF NOT EXISTS escalation_policies (
+    request_type request_type_enum PRIMARY KEY,
+    reminder_after_days INT CHECK (reminder_after_days > 0),
+    escalate_after_days INT CHECK (escalate_after_days > 0),
+    reject_after_days INT NOT NULL CHECK (reject_after_days > 0),
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (reminder_after_days IS NULL OR escalate_after_days IS NULL OR reminder_after_days < escalate_after_days),
+    CHECK (reminder_after_days IS NULL OR reminder_after_days < reject_after_days),
+    CHECK (escalate_after_days IS NULL OR escalate_after_days < reject_after_days)
+);
+
+-- Auto-rejection keeps its previous 7 working day deadline
+INSERT INTO escalation_policies (request_type, reminder_after_days, escalate_after_days, reject_after_days)
+VALUES
+    ('LEAVE', 3, 5, 7),
+    ('EXPENSE', 3, 5, 7),
+    ('DISCOUNT', 3, 5, 7)
+ON CONFLICT (request_type) DO NOTHING;
+
+-- Every escalation step taken on a request; each step happens at most once per request
+CREATE TABLE IF NOT EXISTS request_escalations (
+    id BIGSERIAL PRIMARY KEY,
+    request_type request_type_enum NOT NULL,
+    request_id BIGINT NOT NULL,
+    step TEXT NOT NULL CHECK (step IN ('REMINDER', 'ESCALATED', 'AUTO_REJECTED')),
+    working_days INT NOT NULL,
+    escalated_to_role user_role,
+    escalated_to_user_id BIGINT REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (request_type, request_id, step)
+);
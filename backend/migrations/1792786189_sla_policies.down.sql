@@ -0,0 +1,14 @@
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS sla_policy_id;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS sla_policy_id;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS sla_policy_id;
+ALTER TABLE request_escalations DROP COLUMN IF EXISTS sla_policy_id;
+
+-- Only the active type-wide policies fit the per-type table
+DELETE FROM sla_policies WHERE grade_id IS NOT NULL OR NOT active;
+DROP INDEX IF EXISTS uq_sla_policies_scope;
+ALTER TABLE sla_policies
+    DROP COLUMN IF EXISTS active,
+    DROP COLUMN IF EXISTS grade_id,
+    DROP COLUMN IF EXISTS id;
+ALTER TABLE sla_policies ADD PRIMARY KEY (request_type);
+ALTER TABLE sla_policies RENAME TO escalation_policies;
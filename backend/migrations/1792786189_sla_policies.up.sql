@@ -0,0 +1,27 @@
+-- =====================================================
+-- SLA policies per request type and grade
+-- =====================================================
+
+-- Escalation thresholds become SLA policies: one type-wide policy per request type,
+-- optionally overridden for a grade. Replaced policies are deactivated rather than
+-- deleted so requests keep pointing at the policy that applied to them.
+ALTER TABLE escalation_policies RENAME TO sla_policies;
+ALTER TABLE sla_policies DROP CONSTRAINT IF EXISTS escalation_policies_pkey;
+ALTER TABLE sla_policies
+    ADD COLUMN IF NOT EXISTS id BIGSERIAL PRIMARY KEY,
+    ADD COLUMN IF NOT EXISTS grade_id BIGINT REFERENCES grades(id),
+    ADD COLUMN IF NOT EXISTS active BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_sla_policies_scope
+    ON sla_policies(request_type, COALESCE(grade_id, 0))
+    WHERE active;
+
+-- The policy each escalation step and auto-rejection was taken under
+ALTER TABLE request_escalations
+    ADD COLUMN IF NOT EXISTS sla_policy_id BIGINT REFERENCES sla_policies(id);
+ALTER TABLE leave_requests
+    ADD COLUMN IF NOT EXISTS sla_policy_id BIGINT REFERENCES sla_policies(id);
+ALTER TABLE expense_requests
+    ADD COLUMN IF NOT EXISTS sla_policy_id BIGINT REFERENCES sla_policies(id);
+ALTER TABLE discount_requests
+    ADD COLUMN IF NOT EXISTS sla_policy_id BIGINT REFERENCES sla_policies(id);
@@ -0,0 +1,19 @@
+-- =====================================================
+-- Background job run history
+-- =====================================================
+
+-- One row per run of a background job on any instance. Counts hold what the run
+-- processed, keyed by the job (e.g. reminded, escalated, auto_rejected).
+CREATE TABLE IF NOT EXISTS job_runs (
+    id BIGSERIAL PRIMARY KEY,
+    job_name TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('RUNNING', 'SUCCEEDED', 'FAILED')),
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP,
+    error TEXT,
+    counts JSONB NOT NULL DEFAULT '{}',
+    triggered_by BIGINT REFERENCES users(id),
+    instance TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_started ON job_runs (job_name, started_at DESC);
@@ -0,0 +1,12 @@
+-- =====================================================
+-- Paused state of scheduled jobs
+-- =====================================================
+
+-- Shared by every instance so pausing a job stops it everywhere. Jobs without a row
+-- run on schedule.
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    job_name TEXT PRIMARY KEY,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
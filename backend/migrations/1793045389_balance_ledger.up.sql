@@ -0,0 +1,38 @@
+-- =====================================================
+-- Balance ledger
+-- =====================================================
+
+-- Append-only trail of every change to a leave, expense or discount balance. Amount is
+-- signed (negative for deductions) and balance_after is the remaining balance once the
+-- entry applied, so the running sum of amount per user and balance type gives the
+-- remaining balance. actor_id is NULL for changes the system made on its own.
+CREATE TABLE IF NOT EXISTS balance_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    balance_type request_type_enum NOT NULL,
+    entry_type TEXT NOT NULL CHECK (entry_type IN ('OPENING', 'ALLOCATION', 'DEDUCTION', 'RESTORATION', 'ACCRUAL', 'ADJUSTMENT')),
+    amount NUMERIC(10,2) NOT NULL,
+    balance_after NUMERIC(10,2) NOT NULL,
+    request_id BIGINT,
+    actor_id BIGINT REFERENCES users(id),
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_ledger_user ON balance_ledger (user_id, balance_type, created_at DESC, id DESC);
+
+-- Existing balances open the ledger so it adds up to them
+INSERT INTO balance_ledger (user_id, balance_type, entry_type, amount, balance_after, reason)
+SELECT user_id, 'LEAVE', 'OPENING', remaining_count, remaining_count, 'Opening balance'
+FROM leaves
+WHERE NOT EXISTS (SELECT 1 FROM balance_ledger b WHERE b.user_id = leaves.user_id AND b.balance_type = 'LEAVE');
+
+INSERT INTO balance_ledger (user_id, balance_type, entry_type, amount, balance_after, reason)
+SELECT user_id, 'EXPENSE', 'OPENING', remaining_amount, remaining_amount, 'Opening balance'
+FROM expense
+WHERE NOT EXISTS (SELECT 1 FROM balance_ledger b WHERE b.user_id = expense.user_id AND b.balance_type = 'EXPENSE');
+
+INSERT INTO balance_ledger (user_id, balance_type, entry_type, amount, balance_after, reason)
+SELECT user_id, 'DISCOUNT', 'OPENING', remaining_discount, remaining_discount, 'Opening balance'
+FROM discount
+WHERE NOT EXISTS (SELECT 1 FROM balance_ledger b WHERE b.user_id = discount.user_id AND b.balance_type = 'DISCOUNT');
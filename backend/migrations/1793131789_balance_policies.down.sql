@@ -0,0 +1,6 @@
+ALTER TABLE balance_ledger DROP CONSTRAINT IF EXISTS balance_ledger_entry_type_check;
+ALTER TABLE balance_ledger ADD CONSTRAINT balance_ledger_entry_type_check
+    CHECK (entry_type IN ('OPENING', 'ALLOCATION', 'DEDUCTION', 'RESTORATION', 'ACCRUAL', 'ADJUSTMENT'));
+
+DROP TABLE IF EXISTS balance_cycles;
+DROP TABLE IF EXISTS balance_policies;
@@ -0,0 +1,42 @@
+-- =====================================================
+-- Balance reset, accrual and carry-forward policies
+-- =====================================================
+
+-- One active policy per balance type. The grade limit is the allocation for a full
+-- cycle: granted at the start of the cycle (ANNUAL) or a twelfth each month (MONTHLY).
+-- A NULL carry_forward_cap carries the whole remaining balance into the next cycle;
+-- carried balance left unused carry_forward_expiry_months into the cycle expires.
+CREATE TABLE IF NOT EXISTS balance_policies (
+    id BIGSERIAL PRIMARY KEY,
+    balance_type request_type_enum NOT NULL,
+    reset_cycle TEXT NOT NULL CHECK (reset_cycle IN ('CALENDAR', 'FISCAL')),
+    fiscal_start_month INT NOT NULL DEFAULT 1 CHECK (fiscal_start_month BETWEEN 1 AND 12),
+    accrual TEXT NOT NULL CHECK (accrual IN ('ANNUAL', 'MONTHLY')),
+    carry_forward_cap NUMERIC(10,2) CHECK (carry_forward_cap >= 0),
+    carry_forward_expiry_months INT CHECK (carry_forward_expiry_months BETWEEN 1 AND 12),
+    prorate_joiners BOOLEAN NOT NULL DEFAULT TRUE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_balance_policies_active
+    ON balance_policies (balance_type)
+    WHERE active;
+
+-- Where each wallet stands in the current cycle of its policy
+CREATE TABLE IF NOT EXISTS balance_cycles (
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    balance_type request_type_enum NOT NULL,
+    cycle_start DATE NOT NULL,
+    allocated NUMERIC(10,2) NOT NULL DEFAULT 0,
+    carried_over NUMERIC(10,2) NOT NULL DEFAULT 0,
+    carry_expires_on DATE,
+    carry_expired BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, balance_type)
+);
+
+ALTER TABLE balance_ledger DROP CONSTRAINT IF EXISTS balance_ledger_entry_type_check;
+ALTER TABLE balance_ledger ADD CONSTRAINT balance_ledger_entry_type_check
+    CHECK (entry_type IN ('OPENING', 'ALLOCATION', 'DEDUCTION', 'RESTORATION', 'ACCRUAL', 'ADJUSTMENT', 'RESET', 'EXPIRY'));
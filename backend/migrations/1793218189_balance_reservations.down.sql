@@ -0,0 +1,3 @@
+ALTER TABLE discount DROP COLUMN IF EXISTS reserved_discount;
+ALTER TABLE expense DROP COLUMN IF EXISTS reserved_amount;
+ALTER TABLE leaves DROP COLUMN IF EXISTS reserved_count;
@@ -0,0 +1,29 @@
+-- =====================================================
+-- Balance reservations for pending requests
+-- =====================================================
+
+-- Pending requests reserve their amount until they are decided. The remaining balance
+-- is what approved requests have not consumed; what can still be applied for is the
+-- remaining balance less the reservations.
+ALTER TABLE leaves ADD COLUMN IF NOT EXISTS reserved_count INT NOT NULL DEFAULT 0 CHECK (reserved_count >= 0);
+ALTER TABLE expense ADD COLUMN IF NOT EXISTS reserved_amount DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (reserved_amount >= 0);
+ALTER TABLE discount ADD COLUMN IF NOT EXISTS reserved_discount DECIMAL(5,2) NOT NULL DEFAULT 0 CHECK (reserved_discount >= 0);
+
+-- Requests pending today reserve their amount
+UPDATE leaves l
+SET reserved_count = r.days
+FROM (SELECT employee_id, SUM(to_date - from_date + 1) AS days
+      FROM leave_requests WHERE status = 'PENDING' GROUP BY employee_id) r
+WHERE l.user_id = r.employee_id;
+
+UPDATE expense e
+SET reserved_amount = r.amount
+FROM (SELECT employee_id, SUM(amount) AS amount
+      FROM expense_requests WHERE status = 'PENDING' GROUP BY employee_id) r
+WHERE e.user_id = r.employee_id;
+
+UPDATE discount d
+SET reserved_discount = LEAST(r.percent, 999.99)
+FROM (SELECT employee_id, SUM(discount_percentage) AS percent
+      FROM discount_requests WHERE status = 'PENDING' GROUP BY employee_id) r
+WHERE d.user_id = r.employee_id;
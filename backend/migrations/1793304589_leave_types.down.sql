@@ -0,0 +1,19 @@
+DELETE FROM balance_cycles WHERE balance_type = 'LEAVE' AND leave_type <> 'EARN';
+ALTER TABLE balance_cycles DROP CONSTRAINT IF EXISTS balance_cycles_pkey;
+ALTER TABLE balance_cycles ADD PRIMARY KEY (user_id, balance_type);
+ALTER TABLE balance_cycles DROP COLUMN IF EXISTS leave_type;
+
+ALTER TABLE balance_ledger DROP COLUMN IF EXISTS leave_type;
+
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS balance_leave_type;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS balance_exempt;
+ALTER TABLE leave_requests DROP CONSTRAINT IF EXISTS leave_requests_leave_type_fkey;
+
+DELETE FROM leaves WHERE leave_type <> 'EARN';
+ALTER TABLE leaves DROP CONSTRAINT IF EXISTS leaves_leave_type_fkey;
+ALTER TABLE leaves DROP CONSTRAINT IF EXISTS leaves_user_id_leave_type_key;
+ALTER TABLE leaves ADD CONSTRAINT leaves_user_id_key UNIQUE (user_id);
+ALTER TABLE leaves ALTER COLUMN leave_type DROP NOT NULL;
+
+DROP TABLE IF EXISTS leave_type_allocations;
+DROP TABLE IF EXISTS leave_types;
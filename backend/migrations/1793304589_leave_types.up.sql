@@ -0,0 +1,61 @@
+-- =====================================================
+-- Leave type catalog with a balance per leave type
+-- =====================================================
+
+-- Leave types a request can be made for. Types that bypass the balance, such as unpaid
+-- leave, have no wallet and are never checked against one.
+CREATE TABLE IF NOT EXISTS leave_types (
+    code VARCHAR(20) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    bypass_balance BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Days a full cycle allocates to the wallet of a leave type, per grade
+CREATE TABLE IF NOT EXISTS leave_type_allocations (
+    leave_type VARCHAR(20) NOT NULL REFERENCES leave_types(code),
+    grade_id BIGINT NOT NULL REFERENCES grades(id),
+    annual_days INT NOT NULL CHECK (annual_days >= 0),
+    PRIMARY KEY (leave_type, grade_id)
+);
+
+-- The single leave pool becomes the earned leave wallet
+INSERT INTO leave_types (code, name) VALUES ('EARN', 'Earned leave') ON CONFLICT (code) DO NOTHING;
+INSERT INTO leave_types (code, name, bypass_balance) VALUES ('UNPAID', 'Unpaid leave', TRUE) ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO leave_type_allocations (leave_type, grade_id, annual_days)
+SELECT 'EARN', id, annual_leave_limit FROM grades
+ON CONFLICT (leave_type, grade_id) DO NOTHING;
+
+UPDATE leaves SET leave_type = 'EARN';
+ALTER TABLE leaves ALTER COLUMN leave_type SET NOT NULL;
+ALTER TABLE leaves DROP CONSTRAINT IF EXISTS leaves_user_id_key;
+ALTER TABLE leaves ADD CONSTRAINT leaves_user_id_leave_type_key UNIQUE (user_id, leave_type);
+ALTER TABLE leaves ADD CONSTRAINT leaves_leave_type_fkey FOREIGN KEY (leave_type) REFERENCES leave_types(code);
+
+-- Leave types requested before the catalog stay in it, inactive, so no request loses its
+-- type; they have no wallet and no allocation until an admin sets them up
+INSERT INTO leave_types (code, name, active)
+SELECT DISTINCT leave_type, INITCAP(leave_type) || ' leave', FALSE FROM leave_requests
+ON CONFLICT (code) DO NOTHING;
+ALTER TABLE leave_requests ADD CONSTRAINT leave_requests_leave_type_fkey FOREIGN KEY (leave_type) REFERENCES leave_types(code);
+
+-- balance_exempt keeps whether a request drew from a wallet, whatever happens to its leave
+-- type later. Requests made before the catalog all drew from the single pool, now the
+-- earned leave wallet: balance_leave_type names the wallet of a request drawing from
+-- another than that of its own type.
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS balance_exempt BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS balance_leave_type VARCHAR(20) REFERENCES leave_types(code);
+UPDATE leave_requests SET balance_leave_type = 'EARN' WHERE leave_type <> 'EARN';
+
+-- Leave entries of the ledger and leave cycles are kept per leave type; the other
+-- balance types have an empty leave type
+ALTER TABLE balance_ledger ADD COLUMN IF NOT EXISTS leave_type VARCHAR(20) NOT NULL DEFAULT '';
+UPDATE balance_ledger SET leave_type = 'EARN' WHERE balance_type = 'LEAVE';
+
+ALTER TABLE balance_cycles ADD COLUMN IF NOT EXISTS leave_type VARCHAR(20) NOT NULL DEFAULT '';
+UPDATE balance_cycles SET leave_type = 'EARN' WHERE balance_type = 'LEAVE';
+ALTER TABLE balance_cycles DROP CONSTRAINT IF EXISTS balance_cycles_pkey;
+ALTER TABLE balance_cycles ADD PRIMARY KEY (user_id, balance_type, leave_type);
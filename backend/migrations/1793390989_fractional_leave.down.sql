@@ -0,0 +1,16 @@
+ALTER TABLE leave_requests DROP CONSTRAINT IF EXISTS leave_requests_days_check;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS days;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS hours;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS to_session;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS from_session;
+
+ALTER TABLE balance_cycles ALTER COLUMN carried_over TYPE NUMERIC(10,2) USING ROUND(carried_over, 2);
+ALTER TABLE balance_cycles ALTER COLUMN allocated TYPE NUMERIC(10,2) USING ROUND(allocated, 2);
+
+ALTER TABLE balance_ledger ALTER COLUMN balance_after TYPE NUMERIC(10,2) USING ROUND(balance_after, 2);
+ALTER TABLE balance_ledger ALTER COLUMN amount TYPE NUMERIC(10,2) USING ROUND(amount, 2);
+
+-- fractional leave balances round to whole days, never in the user's favour
+ALTER TABLE leaves ALTER COLUMN reserved_count TYPE INT USING CEIL(reserved_count);
+ALTER TABLE leaves ALTER COLUMN remaining_count TYPE INT USING FLOOR(remaining_count);
+ALTER TABLE leaves ALTER COLUMN total_allocated TYPE INT USING FLOOR(total_allocated);
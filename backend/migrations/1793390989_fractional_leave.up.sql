@@ -0,0 +1,30 @@
+-- =====================================================
+-- Half-day and hourly leave
+-- =====================================================
+
+-- Leave is taken in half days and in hours of an 8-hour working day, so leave balances
+-- and the ledger keep three decimals (an hour is 0.125 days)
+ALTER TABLE leaves ALTER COLUMN total_allocated TYPE NUMERIC(8,3);
+ALTER TABLE leaves ALTER COLUMN remaining_count TYPE NUMERIC(8,3);
+ALTER TABLE leaves ALTER COLUMN reserved_count TYPE NUMERIC(8,3);
+
+ALTER TABLE balance_ledger ALTER COLUMN amount TYPE NUMERIC(12,3);
+ALTER TABLE balance_ledger ALTER COLUMN balance_after TYPE NUMERIC(12,3);
+
+ALTER TABLE balance_cycles ALTER COLUMN allocated TYPE NUMERIC(12,3);
+ALTER TABLE balance_cycles ALTER COLUMN carried_over TYPE NUMERIC(12,3);
+
+-- A request can start on the SECOND_HALF of its first date and end on the FIRST_HALF of
+-- its last date; a single date takes one session in both columns, or hours of a FULL
+-- day. days is what the request costs, so it is released and restored as it was taken.
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS from_session VARCHAR(20) NOT NULL DEFAULT 'FULL'
+    CHECK (from_session IN ('FULL', 'FIRST_HALF', 'SECOND_HALF'));
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS to_session VARCHAR(20) NOT NULL DEFAULT 'FULL'
+    CHECK (to_session IN ('FULL', 'FIRST_HALF', 'SECOND_HALF'));
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS hours INT NOT NULL DEFAULT 0
+    CHECK (hours >= 0 AND hours < 8);
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS days NUMERIC(8,3);
+
+UPDATE leave_requests SET days = to_date - from_date + 1 WHERE days IS NULL;
+ALTER TABLE leave_requests ALTER COLUMN days SET NOT NULL;
+ALTER TABLE leave_requests ADD CONSTRAINT leave_requests_days_check CHECK (days > 0);
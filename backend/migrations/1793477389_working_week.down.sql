@@ -0,0 +1,7 @@
+-- =====================================================
+-- Rollback: Working week and sandwich rule
+-- =====================================================
+
+ALTER TABLE leave_types DROP COLUMN IF EXISTS sandwich_rule;
+
+DROP TABLE IF EXISTS work_week;
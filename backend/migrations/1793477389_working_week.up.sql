@@ -0,0 +1,17 @@
+-- =====================================================
+-- Working week and sandwich rule
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS work_week (
+    weekday INT PRIMARY KEY CHECK (weekday BETWEEN 0 AND 6),
+    working BOOLEAN NOT NULL,
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Sunday is 0; Monday to Friday are working days by default
+INSERT INTO work_week (weekday, working)
+VALUES (0, FALSE), (1, TRUE), (2, TRUE), (3, TRUE), (4, TRUE), (5, TRUE), (6, FALSE)
+ON CONFLICT (weekday) DO NOTHING;
+
+ALTER TABLE leave_types ADD COLUMN IF NOT EXISTS sandwich_rule BOOLEAN NOT NULL DEFAULT FALSE;
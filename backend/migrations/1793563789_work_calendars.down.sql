@@ -0,0 +1,19 @@
+-- =====================================================
+-- Rollback: Named work calendars
+-- =====================================================
+
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS calendar_id;
+ALTER TABLE users DROP COLUMN IF EXISTS calendar_id;
+
+-- only the default calendar's holidays and work week are kept
+DELETE FROM holidays WHERE calendar_id <> (SELECT id FROM work_calendars WHERE is_default);
+ALTER TABLE holidays DROP CONSTRAINT IF EXISTS holidays_calendar_date_key;
+ALTER TABLE holidays DROP COLUMN IF EXISTS calendar_id;
+ALTER TABLE holidays ADD CONSTRAINT holidays_holiday_date_key UNIQUE (holiday_date);
+
+DELETE FROM work_week WHERE calendar_id <> (SELECT id FROM work_calendars WHERE is_default);
+ALTER TABLE work_week DROP CONSTRAINT IF EXISTS work_week_pkey;
+ALTER TABLE work_week DROP COLUMN IF EXISTS calendar_id;
+ALTER TABLE work_week ADD PRIMARY KEY (weekday);
+
+DROP TABLE IF EXISTS work_calendars;
@@ -0,0 +1,38 @@
+-- =====================================================
+-- Named work calendars
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS work_calendars (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- one calendar is the default; users without a calendar follow it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_work_calendars_default ON work_calendars(is_default) WHERE is_default;
+
+INSERT INTO work_calendars (name, is_default)
+VALUES ('Default', TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+-- the organisation-wide work week becomes the default calendar's
+ALTER TABLE work_week ADD COLUMN IF NOT EXISTS calendar_id BIGINT REFERENCES work_calendars(id) ON DELETE CASCADE;
+UPDATE work_week SET calendar_id = (SELECT id FROM work_calendars WHERE is_default) WHERE calendar_id IS NULL;
+ALTER TABLE work_week ALTER COLUMN calendar_id SET NOT NULL;
+ALTER TABLE work_week DROP CONSTRAINT IF EXISTS work_week_pkey;
+ALTER TABLE work_week ADD PRIMARY KEY (calendar_id, weekday);
+
+-- holidays belong to a calendar; a date is unique within its calendar only
+ALTER TABLE holidays ADD COLUMN IF NOT EXISTS calendar_id BIGINT REFERENCES work_calendars(id) ON DELETE CASCADE;
+UPDATE holidays SET calendar_id = (SELECT id FROM work_calendars WHERE is_default) WHERE calendar_id IS NULL;
+ALTER TABLE holidays ALTER COLUMN calendar_id SET NOT NULL;
+ALTER TABLE holidays DROP CONSTRAINT IF EXISTS holidays_holiday_date_key;
+ALTER TABLE holidays ADD CONSTRAINT holidays_calendar_date_key UNIQUE (calendar_id, holiday_date);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS calendar_id BIGINT REFERENCES work_calendars(id) ON DELETE SET NULL;
+
+-- the calendar a leave request was charged on
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS calendar_id BIGINT REFERENCES work_calendars(id) ON DELETE SET NULL;
+UPDATE leave_requests SET calendar_id = (SELECT id FROM work_calendars WHERE is_default) WHERE calendar_id IS NULL;
@@ -0,0 +1,5 @@
+-- =====================================================
+-- Rollback: Calendar feed tokens
+-- =====================================================
+
+DROP TABLE IF EXISTS calendar_feed_tokens;
@@ -0,0 +1,16 @@
+-- =====================================================
+-- Calendar feed tokens
+-- =====================================================
+
+-- tokens calendar apps subscribe to the iCalendar feeds with; only their hash is kept
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) UNIQUE NOT NULL,
+    scope VARCHAR(10) NOT NULL DEFAULT 'SELF' CHECK (scope IN ('SELF', 'TEAM')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_feed_tokens_user ON calendar_feed_tokens(user_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_job_runs_job_slot;
+ALTER TABLE job_runs DROP COLUMN IF EXISTS scheduled_for;
@@ -0,0 +1,9 @@
+-- =====================================================
+-- Job runs claim the slot they are scheduled for
+-- =====================================================
+
+-- Every instance fires every schedule; the first to record a run for the job and the
+-- minute it is scheduled for runs it, the others find the slot taken. Runs recorded
+-- before slots have none and never conflict.
+ALTER TABLE job_runs ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMP;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_job_runs_job_slot ON job_runs (job_name, scheduled_for);
@@ -0,0 +1,6 @@
+-- =====================================================
+-- Rollback: Job runs are history only
+-- =====================================================
+
+ALTER TABLE job_runs ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMP;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_job_runs_job_slot ON job_runs (job_name, scheduled_for);
@@ -0,0 +1,8 @@
+-- =====================================================
+-- Job runs are history only
+-- =====================================================
+
+-- The advisory lock of a job keeps it to one run at a time across instances, so runs no
+-- longer claim the minute they are scheduled for. Runs are history; their slots go.
+DROP INDEX IF EXISTS idx_job_runs_job_slot;
+ALTER TABLE job_runs DROP COLUMN IF EXISTS scheduled_for;
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,21 +23,33 @@ func (_m *AutoRejectService) EXPECT() *AutoRejectService_Expecter {
 }
 
 // AutoRejectExpiredRequests provides a mock function with given fields: ctx
-func (_m *AutoRejectService) AutoRejectExpiredRequests(ctx context.Context) error {
+func (_m *AutoRejectService) AutoRejectExpiredRequests(ctx context.Context) (*models.AutoRejectReport, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AutoRejectExpiredRequests")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 *models.AutoRejectReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.AutoRejectReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.AutoRejectReport); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AutoRejectReport)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // AutoRejectService_AutoRejectExpiredRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoRejectExpiredRequests'
@@ -57,12 +70,131 @@ func (_c *AutoRejectService_AutoRejectExpiredRequests_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *AutoRejectService_AutoRejectExpiredRequests_Call) Return(_a0 error) *AutoRejectService_AutoRejectExpiredRequests_Call {
-	_c.Call.Return(_a0)
+func (_c *AutoRejectService_AutoRejectExpiredRequests_Call) Return(_a0 *models.AutoRejectReport, _a1 error) *AutoRejectService_AutoRejectExpiredRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AutoRejectService_AutoRejectExpiredRequests_Call) RunAndReturn(run func(context.Context) (*models.AutoRejectReport, error)) *AutoRejectService_AutoRejectExpiredRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewAutoReject provides a mock function with given fields: ctx, role
+func (_m *AutoRejectService) PreviewAutoReject(ctx context.Context, role string) (*models.AutoRejectReport, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewAutoReject")
+	}
+
+	var r0 *models.AutoRejectReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.AutoRejectReport, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.AutoRejectReport); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AutoRejectReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AutoRejectService_PreviewAutoReject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewAutoReject'
+type AutoRejectService_PreviewAutoReject_Call struct {
+	*mock.Call
+}
+
+// PreviewAutoReject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *AutoRejectService_Expecter) PreviewAutoReject(ctx interface{}, role interface{}) *AutoRejectService_PreviewAutoReject_Call {
+	return &AutoRejectService_PreviewAutoReject_Call{Call: _e.mock.On("PreviewAutoReject", ctx, role)}
+}
+
+func (_c *AutoRejectService_PreviewAutoReject_Call) Run(run func(ctx context.Context, role string)) *AutoRejectService_PreviewAutoReject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AutoRejectService_PreviewAutoReject_Call) Return(_a0 *models.AutoRejectReport, _a1 error) *AutoRejectService_PreviewAutoReject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AutoRejectService_PreviewAutoReject_Call) RunAndReturn(run func(context.Context, string) (*models.AutoRejectReport, error)) *AutoRejectService_PreviewAutoReject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunAutoReject provides a mock function with given fields: ctx, role, adminID
+func (_m *AutoRejectService) RunAutoReject(ctx context.Context, role string, adminID int64) (*models.AutoRejectReport, error) {
+	ret := _m.Called(ctx, role, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunAutoReject")
+	}
+
+	var r0 *models.AutoRejectReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*models.AutoRejectReport, error)); ok {
+		return rf(ctx, role, adminID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *models.AutoRejectReport); ok {
+		r0 = rf(ctx, role, adminID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AutoRejectReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, role, adminID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AutoRejectService_RunAutoReject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunAutoReject'
+type AutoRejectService_RunAutoReject_Call struct {
+	*mock.Call
+}
+
+// RunAutoReject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+func (_e *AutoRejectService_Expecter) RunAutoReject(ctx interface{}, role interface{}, adminID interface{}) *AutoRejectService_RunAutoReject_Call {
+	return &AutoRejectService_RunAutoReject_Call{Call: _e.mock.On("RunAutoReject", ctx, role, adminID)}
+}
+
+func (_c *AutoRejectService_RunAutoReject_Call) Run(run func(ctx context.Context, role string, adminID int64)) *AutoRejectService_RunAutoReject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *AutoRejectService_RunAutoReject_Call) Return(_a0 *models.AutoRejectReport, _a1 error) *AutoRejectService_RunAutoReject_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AutoRejectService_AutoRejectExpiredRequests_Call) RunAndReturn(run func(context.Context) error) *AutoRejectService_AutoRejectExpiredRequests_Call {
+func (_c *AutoRejectService_RunAutoReject_Call) RunAndReturn(run func(context.Context, string, int64) (*models.AutoRejectReport, error)) *AutoRejectService_RunAutoReject_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,479 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// DelegationRepository is an autogenerated mock type for the DelegationRepository type
+type DelegationRepository struct {
+	mock.Mock
+}
+
+type DelegationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DelegationRepository) EXPECT() *DelegationRepository_Expecter {
+	return &DelegationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, delegation
+func (_m *DelegationRepository) Create(ctx context.Context, delegation *models.ApprovalDelegation) error {
+	ret := _m.Called(ctx, delegation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ApprovalDelegation) error); ok {
+		r0 = rf(ctx, delegation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type DelegationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegation *models.ApprovalDelegation
+func (_e *DelegationRepository_Expecter) Create(ctx interface{}, delegation interface{}) *DelegationRepository_Create_Call {
+	return &DelegationRepository_Create_Call{Call: _e.mock.On("Create", ctx, delegation)}
+}
+
+func (_c *DelegationRepository_Create_Call) Run(run func(ctx context.Context, delegation *models.ApprovalDelegation)) *DelegationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ApprovalDelegation))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_Create_Call) Return(_a0 error) *DelegationRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationRepository_Create_Call) RunAndReturn(run func(context.Context, *models.ApprovalDelegation) error) *DelegationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *DelegationRepository) GetAll(ctx context.Context) ([]models.ApprovalDelegation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ApprovalDelegation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ApprovalDelegation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type DelegationRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DelegationRepository_Expecter) GetAll(ctx interface{}) *DelegationRepository_GetAll_Call {
+	return &DelegationRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *DelegationRepository_GetAll_Call) Run(run func(ctx context.Context)) *DelegationRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetAll_Call) Return(_a0 []models.ApprovalDelegation, _a1 error) *DelegationRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.ApprovalDelegation, error)) *DelegationRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, delegationID
+func (_m *DelegationRepository) GetByID(ctx context.Context, delegationID int64) (*models.ApprovalDelegation, error) {
+	ret := _m.Called(ctx, delegationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.ApprovalDelegation, error)); ok {
+		return rf(ctx, delegationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.ApprovalDelegation); ok {
+		r0 = rf(ctx, delegationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, delegationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type DelegationRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegationID int64
+func (_e *DelegationRepository_Expecter) GetByID(ctx interface{}, delegationID interface{}) *DelegationRepository_GetByID_Call {
+	return &DelegationRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, delegationID)}
+}
+
+func (_c *DelegationRepository_GetByID_Call) Run(run func(ctx context.Context, delegationID int64)) *DelegationRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetByID_Call) Return(_a0 *models.ApprovalDelegation, _a1 error) *DelegationRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64) (*models.ApprovalDelegation, error)) *DelegationRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelegators provides a mock function with given fields: ctx, delegateID
+func (_m *DelegationRepository) GetDelegators(ctx context.Context, delegateID int64) ([]int64, error) {
+	ret := _m.Called(ctx, delegateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegators")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, delegateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, delegateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, delegateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetDelegators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelegators'
+type DelegationRepository_GetDelegators_Call struct {
+	*mock.Call
+}
+
+// GetDelegators is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegateID int64
+func (_e *DelegationRepository_Expecter) GetDelegators(ctx interface{}, delegateID interface{}) *DelegationRepository_GetDelegators_Call {
+	return &DelegationRepository_GetDelegators_Call{Call: _e.mock.On("GetDelegators", ctx, delegateID)}
+}
+
+func (_c *DelegationRepository_GetDelegators_Call) Run(run func(ctx context.Context, delegateID int64)) *DelegationRepository_GetDelegators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetDelegators_Call) Return(_a0 []int64, _a1 error) *DelegationRepository_GetDelegators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetDelegators_Call) RunAndReturn(run func(context.Context, int64) ([]int64, error)) *DelegationRepository_GetDelegators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUser provides a mock function with given fields: ctx, userID
+func (_m *DelegationRepository) GetForUser(ctx context.Context, userID int64) ([]models.ApprovalDelegation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUser")
+	}
+
+	var r0 []models.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.ApprovalDelegation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.ApprovalDelegation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUser'
+type DelegationRepository_GetForUser_Call struct {
+	*mock.Call
+}
+
+// GetForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *DelegationRepository_Expecter) GetForUser(ctx interface{}, userID interface{}) *DelegationRepository_GetForUser_Call {
+	return &DelegationRepository_GetForUser_Call{Call: _e.mock.On("GetForUser", ctx, userID)}
+}
+
+func (_c *DelegationRepository_GetForUser_Call) Run(run func(ctx context.Context, userID int64)) *DelegationRepository_GetForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetForUser_Call) Return(_a0 []models.ApprovalDelegation, _a1 error) *DelegationRepository_GetForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetForUser_Call) RunAndReturn(run func(context.Context, int64) ([]models.ApprovalDelegation, error)) *DelegationRepository_GetForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInEffect provides a mock function with given fields: ctx, tx, delegatorID, delegateID
+func (_m *DelegationRepository) GetInEffect(ctx context.Context, tx interfaces.Tx, delegatorID int64, delegateID int64) (*models.ApprovalDelegation, error) {
+	ret := _m.Called(ctx, tx, delegatorID, delegateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInEffect")
+	}
+
+	var r0 *models.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) (*models.ApprovalDelegation, error)); ok {
+		return rf(ctx, tx, delegatorID, delegateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) *models.ApprovalDelegation); ok {
+		r0 = rf(ctx, tx, delegatorID, delegateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r1 = rf(ctx, tx, delegatorID, delegateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetInEffect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInEffect'
+type DelegationRepository_GetInEffect_Call struct {
+	*mock.Call
+}
+
+// GetInEffect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - delegatorID int64
+//   - delegateID int64
+func (_e *DelegationRepository_Expecter) GetInEffect(ctx interface{}, tx interface{}, delegatorID interface{}, delegateID interface{}) *DelegationRepository_GetInEffect_Call {
+	return &DelegationRepository_GetInEffect_Call{Call: _e.mock.On("GetInEffect", ctx, tx, delegatorID, delegateID)}
+}
+
+func (_c *DelegationRepository_GetInEffect_Call) Run(run func(ctx context.Context, tx interfaces.Tx, delegatorID int64, delegateID int64)) *DelegationRepository_GetInEffect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetInEffect_Call) Return(_a0 *models.ApprovalDelegation, _a1 error) *DelegationRepository_GetInEffect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetInEffect_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) (*models.ApprovalDelegation, error)) *DelegationRepository_GetInEffect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDecision provides a mock function with given fields: ctx, tx, requestType, requestID, delegation
+func (_m *DelegationRepository) RecordDecision(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, delegation *models.ApprovalDelegation) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, delegation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, *models.ApprovalDelegation) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, delegation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationRepository_RecordDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDecision'
+type DelegationRepository_RecordDecision_Call struct {
+	*mock.Call
+}
+
+// RecordDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - delegation *models.ApprovalDelegation
+func (_e *DelegationRepository_Expecter) RecordDecision(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, delegation interface{}) *DelegationRepository_RecordDecision_Call {
+	return &DelegationRepository_RecordDecision_Call{Call: _e.mock.On("RecordDecision", ctx, tx, requestType, requestID, delegation)}
+}
+
+func (_c *DelegationRepository_RecordDecision_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, delegation *models.ApprovalDelegation)) *DelegationRepository_RecordDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(*models.ApprovalDelegation))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_RecordDecision_Call) Return(_a0 error) *DelegationRepository_RecordDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationRepository_RecordDecision_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, *models.ApprovalDelegation) error) *DelegationRepository_RecordDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, delegationID
+func (_m *DelegationRepository) Revoke(ctx context.Context, delegationID int64) error {
+	ret := _m.Called(ctx, delegationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, delegationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type DelegationRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegationID int64
+func (_e *DelegationRepository_Expecter) Revoke(ctx interface{}, delegationID interface{}) *DelegationRepository_Revoke_Call {
+	return &DelegationRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, delegationID)}
+}
+
+func (_c *DelegationRepository_Revoke_Call) Run(run func(ctx context.Context, delegationID int64)) *DelegationRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_Revoke_Call) Return(_a0 error) *DelegationRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationRepository_Revoke_Call) RunAndReturn(run func(context.Context, int64) error) *DelegationRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDelegationRepository creates a new instance of DelegationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDelegationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DelegationRepository {
+	mock := &DelegationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -249,9 +249,9 @@ func (_c *DiscountRequestRepository_GetPendingForAdmin_Call) RunAndReturn(run fu
 	return _c
 }
 
-// GetPendingForManager provides a mock function with given fields: ctx, managerID, limit, offset
-func (_m *DiscountRequestRepository) GetPendingForManager(ctx context.Context, managerID int64, limit int, offset int) ([]map[string]interface{}, int, error) {
-	ret := _m.Called(ctx, managerID, limit, offset)
+// GetPendingForManager provides a mock function with given fields: ctx, managerIDs, limit, offset
+func (_m *DiscountRequestRepository) GetPendingForManager(ctx context.Context, managerIDs []int64, limit int, offset int) ([]map[string]interface{}, int, error) {
+	ret := _m.Called(ctx, managerIDs, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingForManager")
@@ -260,25 +260,25 @@ func (_m *DiscountRequestRepository) GetPendingForManager(ctx context.Context, m
 	var r0 []map[string]interface{}
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]map[string]interface{}, int, error)); ok {
-		return rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int, int) ([]map[string]interface{}, int, error)); ok {
+		return rf(ctx, managerIDs, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) []map[string]interface{}); ok {
-		r0 = rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int, int) []map[string]interface{}); ok {
+		r0 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
-		r1 = rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, int, int) int); ok {
+		r1 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(2).(func(context.Context, []int64, int, int) error); ok {
+		r2 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -293,16 +293,16 @@ type DiscountRequestRepository_GetPendingForManager_Call struct {
 
 // GetPendingForManager is a helper method to define mock.On call
 //   - ctx context.Context
-//   - managerID int64
+//   - managerIDs []int64
 //   - limit int
 //   - offset int
-func (_e *DiscountRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerID interface{}, limit interface{}, offset interface{}) *DiscountRequestRepository_GetPendingForManager_Call {
-	return &DiscountRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerID, limit, offset)}
+func (_e *DiscountRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerIDs interface{}, limit interface{}, offset interface{}) *DiscountRequestRepository_GetPendingForManager_Call {
+	return &DiscountRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerIDs, limit, offset)}
 }
 
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerID int64, limit int, offset int)) *DiscountRequestRepository_GetPendingForManager_Call {
+func (_c *DiscountRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerIDs []int64, limit int, offset int)) *DiscountRequestRepository_GetPendingForManager_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].([]int64), args[2].(int), args[3].(int))
 	})
 	return _c
 }
@@ -312,7 +312,7 @@ func (_c *DiscountRequestRepository_GetPendingForManager_Call) Return(_a0 []map[
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, int64, int, int) ([]map[string]interface{}, int, error)) *DiscountRequestRepository_GetPendingForManager_Call {
+func (_c *DiscountRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, []int64, int, int) ([]map[string]interface{}, int, error)) *DiscountRequestRepository_GetPendingForManager_Call {
 	_c.Call.Return(run)
 	return _c
 }
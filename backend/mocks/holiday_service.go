@@ -5,9 +5,11 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
+	io "io"
 	time "time"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // HolidayService is an autogenerated mock type for the HolidayService type
@@ -23,17 +25,17 @@ func (_m *HolidayService) EXPECT() *HolidayService_Expecter {
 	return &HolidayService_Expecter{mock: &_m.Mock}
 }
 
-// AddHoliday provides a mock function with given fields: ctx, role, adminID, date, desc
-func (_m *HolidayService) AddHoliday(ctx context.Context, role string, adminID int64, date time.Time, desc string) error {
-	ret := _m.Called(ctx, role, adminID, date, desc)
+// AddHoliday provides a mock function with given fields: ctx, role, adminID, calendarID, date, desc
+func (_m *HolidayService) AddHoliday(ctx context.Context, role string, adminID int64, calendarID int64, date time.Time, desc string) error {
+	ret := _m.Called(ctx, role, adminID, calendarID, date, desc)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddHoliday")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time, string) error); ok {
-		r0 = rf(ctx, role, adminID, date, desc)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, time.Time, string) error); ok {
+		r0 = rf(ctx, role, adminID, calendarID, date, desc)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -50,15 +52,16 @@ type HolidayService_AddHoliday_Call struct {
 //   - ctx context.Context
 //   - role string
 //   - adminID int64
+//   - calendarID int64
 //   - date time.Time
 //   - desc string
-func (_e *HolidayService_Expecter) AddHoliday(ctx interface{}, role interface{}, adminID interface{}, date interface{}, desc interface{}) *HolidayService_AddHoliday_Call {
-	return &HolidayService_AddHoliday_Call{Call: _e.mock.On("AddHoliday", ctx, role, adminID, date, desc)}
+func (_e *HolidayService_Expecter) AddHoliday(ctx interface{}, role interface{}, adminID interface{}, calendarID interface{}, date interface{}, desc interface{}) *HolidayService_AddHoliday_Call {
+	return &HolidayService_AddHoliday_Call{Call: _e.mock.On("AddHoliday", ctx, role, adminID, calendarID, date, desc)}
 }
 
-func (_c *HolidayService_AddHoliday_Call) Run(run func(ctx context.Context, role string, adminID int64, date time.Time, desc string)) *HolidayService_AddHoliday_Call {
+func (_c *HolidayService_AddHoliday_Call) Run(run func(ctx context.Context, role string, adminID int64, calendarID int64, date time.Time, desc string)) *HolidayService_AddHoliday_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(time.Time), args[4].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(time.Time), args[5].(string))
 	})
 	return _c
 }
@@ -68,7 +71,176 @@ func (_c *HolidayService_AddHoliday_Call) Return(_a0 error) *HolidayService_AddH
 	return _c
 }
 
-func (_c *HolidayService_AddHoliday_Call) RunAndReturn(run func(context.Context, string, int64, time.Time, string) error) *HolidayService_AddHoliday_Call {
+func (_c *HolidayService_AddHoliday_Call) RunAndReturn(run func(context.Context, string, int64, int64, time.Time, string) error) *HolidayService_AddHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignCalendar provides a mock function with given fields: ctx, role, calendarID, userIDs
+func (_m *HolidayService) AssignCalendar(ctx context.Context, role string, calendarID int64, userIDs []int64) (int64, error) {
+	ret := _m.Called(ctx, role, calendarID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCalendar")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64) (int64, error)); ok {
+		return rf(ctx, role, calendarID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64) int64); ok {
+		r0 = rf(ctx, role, calendarID, userIDs)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64) error); ok {
+		r1 = rf(ctx, role, calendarID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_AssignCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCalendar'
+type HolidayService_AssignCalendar_Call struct {
+	*mock.Call
+}
+
+// AssignCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - calendarID int64
+//   - userIDs []int64
+func (_e *HolidayService_Expecter) AssignCalendar(ctx interface{}, role interface{}, calendarID interface{}, userIDs interface{}) *HolidayService_AssignCalendar_Call {
+	return &HolidayService_AssignCalendar_Call{Call: _e.mock.On("AssignCalendar", ctx, role, calendarID, userIDs)}
+}
+
+func (_c *HolidayService_AssignCalendar_Call) Run(run func(ctx context.Context, role string, calendarID int64, userIDs []int64)) *HolidayService_AssignCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *HolidayService_AssignCalendar_Call) Return(_a0 int64, _a1 error) *HolidayService_AssignCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_AssignCalendar_Call) RunAndReturn(run func(context.Context, string, int64, []int64) (int64, error)) *HolidayService_AssignCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCalendar provides a mock function with given fields: ctx, role, adminID, name, workingDays
+func (_m *HolidayService) CreateCalendar(ctx context.Context, role string, adminID int64, name string, workingDays []string) (*models.Calendar, error) {
+	ret := _m.Called(ctx, role, adminID, name, workingDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCalendar")
+	}
+
+	var r0 *models.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, []string) (*models.Calendar, error)); ok {
+		return rf(ctx, role, adminID, name, workingDays)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, []string) *models.Calendar); ok {
+		r0 = rf(ctx, role, adminID, name, workingDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, []string) error); ok {
+		r1 = rf(ctx, role, adminID, name, workingDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_CreateCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCalendar'
+type HolidayService_CreateCalendar_Call struct {
+	*mock.Call
+}
+
+// CreateCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - name string
+//   - workingDays []string
+func (_e *HolidayService_Expecter) CreateCalendar(ctx interface{}, role interface{}, adminID interface{}, name interface{}, workingDays interface{}) *HolidayService_CreateCalendar_Call {
+	return &HolidayService_CreateCalendar_Call{Call: _e.mock.On("CreateCalendar", ctx, role, adminID, name, workingDays)}
+}
+
+func (_c *HolidayService_CreateCalendar_Call) Run(run func(ctx context.Context, role string, adminID int64, name string, workingDays []string)) *HolidayService_CreateCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *HolidayService_CreateCalendar_Call) Return(_a0 *models.Calendar, _a1 error) *HolidayService_CreateCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_CreateCalendar_Call) RunAndReturn(run func(context.Context, string, int64, string, []string) (*models.Calendar, error)) *HolidayService_CreateCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCalendar provides a mock function with given fields: ctx, role, calendarID
+func (_m *HolidayService) DeleteCalendar(ctx context.Context, role string, calendarID int64) error {
+	ret := _m.Called(ctx, role, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, role, calendarID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HolidayService_DeleteCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCalendar'
+type HolidayService_DeleteCalendar_Call struct {
+	*mock.Call
+}
+
+// DeleteCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - calendarID int64
+func (_e *HolidayService_Expecter) DeleteCalendar(ctx interface{}, role interface{}, calendarID interface{}) *HolidayService_DeleteCalendar_Call {
+	return &HolidayService_DeleteCalendar_Call{Call: _e.mock.On("DeleteCalendar", ctx, role, calendarID)}
+}
+
+func (_c *HolidayService_DeleteCalendar_Call) Run(run func(ctx context.Context, role string, calendarID int64)) *HolidayService_DeleteCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayService_DeleteCalendar_Call) Return(_a0 error) *HolidayService_DeleteCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HolidayService_DeleteCalendar_Call) RunAndReturn(run func(context.Context, string, int64) error) *HolidayService_DeleteCalendar_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -121,24 +293,84 @@ func (_c *HolidayService_DeleteHoliday_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// GetHolidays provides a mock function with given fields: ctx, role
-func (_m *HolidayService) GetHolidays(ctx context.Context, role string) ([]map[string]interface{}, error) {
+// ExportHolidays provides a mock function with given fields: ctx, role, calendarID
+func (_m *HolidayService) ExportHolidays(ctx context.Context, role string, calendarID int64) ([]byte, error) {
+	ret := _m.Called(ctx, role, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportHolidays")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]byte, error)); ok {
+		return rf(ctx, role, calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []byte); ok {
+		r0 = rf(ctx, role, calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, role, calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_ExportHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportHolidays'
+type HolidayService_ExportHolidays_Call struct {
+	*mock.Call
+}
+
+// ExportHolidays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - calendarID int64
+func (_e *HolidayService_Expecter) ExportHolidays(ctx interface{}, role interface{}, calendarID interface{}) *HolidayService_ExportHolidays_Call {
+	return &HolidayService_ExportHolidays_Call{Call: _e.mock.On("ExportHolidays", ctx, role, calendarID)}
+}
+
+func (_c *HolidayService_ExportHolidays_Call) Run(run func(ctx context.Context, role string, calendarID int64)) *HolidayService_ExportHolidays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayService_ExportHolidays_Call) Return(_a0 []byte, _a1 error) *HolidayService_ExportHolidays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_ExportHolidays_Call) RunAndReturn(run func(context.Context, string, int64) ([]byte, error)) *HolidayService_ExportHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendars provides a mock function with given fields: ctx, role
+func (_m *HolidayService) GetCalendars(ctx context.Context, role string) ([]models.Calendar, error) {
 	ret := _m.Called(ctx, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetHolidays")
+		panic("no return value specified for GetCalendars")
 	}
 
-	var r0 []map[string]interface{}
+	var r0 []models.Calendar
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]map[string]interface{}, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Calendar, error)); ok {
 		return rf(ctx, role)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []map[string]interface{}); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Calendar); ok {
 		r0 = rf(ctx, role)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]map[string]interface{})
+			r0 = ret.Get(0).([]models.Calendar)
 		}
 	}
 
@@ -151,6 +383,65 @@ func (_m *HolidayService) GetHolidays(ctx context.Context, role string) ([]map[s
 	return r0, r1
 }
 
+// HolidayService_GetCalendars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendars'
+type HolidayService_GetCalendars_Call struct {
+	*mock.Call
+}
+
+// GetCalendars is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *HolidayService_Expecter) GetCalendars(ctx interface{}, role interface{}) *HolidayService_GetCalendars_Call {
+	return &HolidayService_GetCalendars_Call{Call: _e.mock.On("GetCalendars", ctx, role)}
+}
+
+func (_c *HolidayService_GetCalendars_Call) Run(run func(ctx context.Context, role string)) *HolidayService_GetCalendars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HolidayService_GetCalendars_Call) Return(_a0 []models.Calendar, _a1 error) *HolidayService_GetCalendars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_GetCalendars_Call) RunAndReturn(run func(context.Context, string) ([]models.Calendar, error)) *HolidayService_GetCalendars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHolidays provides a mock function with given fields: ctx, role, calendarID
+func (_m *HolidayService) GetHolidays(ctx context.Context, role string, calendarID int64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, role, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidays")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, role, calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []map[string]interface{}); ok {
+		r0 = rf(ctx, role, calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, role, calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HolidayService_GetHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidays'
 type HolidayService_GetHolidays_Call struct {
 	*mock.Call
@@ -159,13 +450,14 @@ type HolidayService_GetHolidays_Call struct {
 // GetHolidays is a helper method to define mock.On call
 //   - ctx context.Context
 //   - role string
-func (_e *HolidayService_Expecter) GetHolidays(ctx interface{}, role interface{}) *HolidayService_GetHolidays_Call {
-	return &HolidayService_GetHolidays_Call{Call: _e.mock.On("GetHolidays", ctx, role)}
+//   - calendarID int64
+func (_e *HolidayService_Expecter) GetHolidays(ctx interface{}, role interface{}, calendarID interface{}) *HolidayService_GetHolidays_Call {
+	return &HolidayService_GetHolidays_Call{Call: _e.mock.On("GetHolidays", ctx, role, calendarID)}
 }
 
-func (_c *HolidayService_GetHolidays_Call) Run(run func(ctx context.Context, role string)) *HolidayService_GetHolidays_Call {
+func (_c *HolidayService_GetHolidays_Call) Run(run func(ctx context.Context, role string, calendarID int64)) *HolidayService_GetHolidays_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
 	})
 	return _c
 }
@@ -175,7 +467,254 @@ func (_c *HolidayService_GetHolidays_Call) Return(_a0 []map[string]interface{},
 	return _c
 }
 
-func (_c *HolidayService_GetHolidays_Call) RunAndReturn(run func(context.Context, string) ([]map[string]interface{}, error)) *HolidayService_GetHolidays_Call {
+func (_c *HolidayService_GetHolidays_Call) RunAndReturn(run func(context.Context, string, int64) ([]map[string]interface{}, error)) *HolidayService_GetHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkWeek provides a mock function with given fields: ctx, calendarID
+func (_m *HolidayService) GetWorkWeek(ctx context.Context, calendarID int64) ([]string, error) {
+	ret := _m.Called(ctx, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkWeek")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]string, error)); ok {
+		return rf(ctx, calendarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(ctx, calendarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, calendarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_GetWorkWeek_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkWeek'
+type HolidayService_GetWorkWeek_Call struct {
+	*mock.Call
+}
+
+// GetWorkWeek is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID int64
+func (_e *HolidayService_Expecter) GetWorkWeek(ctx interface{}, calendarID interface{}) *HolidayService_GetWorkWeek_Call {
+	return &HolidayService_GetWorkWeek_Call{Call: _e.mock.On("GetWorkWeek", ctx, calendarID)}
+}
+
+func (_c *HolidayService_GetWorkWeek_Call) Run(run func(ctx context.Context, calendarID int64)) *HolidayService_GetWorkWeek_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *HolidayService_GetWorkWeek_Call) Return(_a0 []string, _a1 error) *HolidayService_GetWorkWeek_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_GetWorkWeek_Call) RunAndReturn(run func(context.Context, int64) ([]string, error)) *HolidayService_GetWorkWeek_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportHolidays provides a mock function with given fields: ctx, role, adminID, calendarID, file, preview
+func (_m *HolidayService) ImportHolidays(ctx context.Context, role string, adminID int64, calendarID int64, file io.Reader, preview bool) (*models.HolidayImport, error) {
+	ret := _m.Called(ctx, role, adminID, calendarID, file, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportHolidays")
+	}
+
+	var r0 *models.HolidayImport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, io.Reader, bool) (*models.HolidayImport, error)); ok {
+		return rf(ctx, role, adminID, calendarID, file, preview)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, io.Reader, bool) *models.HolidayImport); ok {
+		r0 = rf(ctx, role, adminID, calendarID, file, preview)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.HolidayImport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, io.Reader, bool) error); ok {
+		r1 = rf(ctx, role, adminID, calendarID, file, preview)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_ImportHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportHolidays'
+type HolidayService_ImportHolidays_Call struct {
+	*mock.Call
+}
+
+// ImportHolidays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - calendarID int64
+//   - file io.Reader
+//   - preview bool
+func (_e *HolidayService_Expecter) ImportHolidays(ctx interface{}, role interface{}, adminID interface{}, calendarID interface{}, file interface{}, preview interface{}) *HolidayService_ImportHolidays_Call {
+	return &HolidayService_ImportHolidays_Call{Call: _e.mock.On("ImportHolidays", ctx, role, adminID, calendarID, file, preview)}
+}
+
+func (_c *HolidayService_ImportHolidays_Call) Run(run func(ctx context.Context, role string, adminID int64, calendarID int64, file io.Reader, preview bool)) *HolidayService_ImportHolidays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(io.Reader), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *HolidayService_ImportHolidays_Call) Return(_a0 *models.HolidayImport, _a1 error) *HolidayService_ImportHolidays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_ImportHolidays_Call) RunAndReturn(run func(context.Context, string, int64, int64, io.Reader, bool) (*models.HolidayImport, error)) *HolidayService_ImportHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWorkWeek provides a mock function with given fields: ctx, role, adminID, calendarID, workingDays
+func (_m *HolidayService) SetWorkWeek(ctx context.Context, role string, adminID int64, calendarID int64, workingDays []string) ([]string, error) {
+	ret := _m.Called(ctx, role, adminID, calendarID, workingDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWorkWeek")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, []string) ([]string, error)); ok {
+		return rf(ctx, role, adminID, calendarID, workingDays)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, []string) []string); ok {
+		r0 = rf(ctx, role, adminID, calendarID, workingDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, []string) error); ok {
+		r1 = rf(ctx, role, adminID, calendarID, workingDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_SetWorkWeek_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkWeek'
+type HolidayService_SetWorkWeek_Call struct {
+	*mock.Call
+}
+
+// SetWorkWeek is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - calendarID int64
+//   - workingDays []string
+func (_e *HolidayService_Expecter) SetWorkWeek(ctx interface{}, role interface{}, adminID interface{}, calendarID interface{}, workingDays interface{}) *HolidayService_SetWorkWeek_Call {
+	return &HolidayService_SetWorkWeek_Call{Call: _e.mock.On("SetWorkWeek", ctx, role, adminID, calendarID, workingDays)}
+}
+
+func (_c *HolidayService_SetWorkWeek_Call) Run(run func(ctx context.Context, role string, adminID int64, calendarID int64, workingDays []string)) *HolidayService_SetWorkWeek_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *HolidayService_SetWorkWeek_Call) Return(_a0 []string, _a1 error) *HolidayService_SetWorkWeek_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_SetWorkWeek_Call) RunAndReturn(run func(context.Context, string, int64, int64, []string) ([]string, error)) *HolidayService_SetWorkWeek_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCalendar provides a mock function with given fields: ctx, role, adminID, calendarID, name, workingDays
+func (_m *HolidayService) UpdateCalendar(ctx context.Context, role string, adminID int64, calendarID int64, name string, workingDays []string) (*models.Calendar, error) {
+	ret := _m.Called(ctx, role, adminID, calendarID, name, workingDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCalendar")
+	}
+
+	var r0 *models.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string, []string) (*models.Calendar, error)); ok {
+		return rf(ctx, role, adminID, calendarID, name, workingDays)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string, []string) *models.Calendar); ok {
+		r0 = rf(ctx, role, adminID, calendarID, name, workingDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, string, []string) error); ok {
+		r1 = rf(ctx, role, adminID, calendarID, name, workingDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayService_UpdateCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCalendar'
+type HolidayService_UpdateCalendar_Call struct {
+	*mock.Call
+}
+
+// UpdateCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - calendarID int64
+//   - name string
+//   - workingDays []string
+func (_e *HolidayService_Expecter) UpdateCalendar(ctx interface{}, role interface{}, adminID interface{}, calendarID interface{}, name interface{}, workingDays interface{}) *HolidayService_UpdateCalendar_Call {
+	return &HolidayService_UpdateCalendar_Call{Call: _e.mock.On("UpdateCalendar", ctx, role, adminID, calendarID, name, workingDays)}
+}
+
+func (_c *HolidayService_UpdateCalendar_Call) Run(run func(ctx context.Context, role string, adminID int64, calendarID int64, name string, workingDays []string)) *HolidayService_UpdateCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string), args[5].([]string))
+	})
+	return _c
+}
+
+func (_c *HolidayService_UpdateCalendar_Call) Return(_a0 *models.Calendar, _a1 error) *HolidayService_UpdateCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayService_UpdateCalendar_Call) RunAndReturn(run func(context.Context, string, int64, int64, string, []string) (*models.Calendar, error)) *HolidayService_UpdateCalendar_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,404 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobRunRepository is an autogenerated mock type for the JobRunRepository type
+type JobRunRepository struct {
+	mock.Mock
+}
+
+type JobRunRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobRunRepository) EXPECT() *JobRunRepository_Expecter {
+	return &JobRunRepository_Expecter{mock: &_m.Mock}
+}
+
+// FailStaleRuns provides a mock function with given fields: ctx, jobName
+func (_m *JobRunRepository) FailStaleRuns(ctx context.Context, jobName string) error {
+	ret := _m.Called(ctx, jobName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailStaleRuns")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, jobName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_FailStaleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailStaleRuns'
+type JobRunRepository_FailStaleRuns_Call struct {
+	*mock.Call
+}
+
+// FailStaleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobName string
+func (_e *JobRunRepository_Expecter) FailStaleRuns(ctx interface{}, jobName interface{}) *JobRunRepository_FailStaleRuns_Call {
+	return &JobRunRepository_FailStaleRuns_Call{Call: _e.mock.On("FailStaleRuns", ctx, jobName)}
+}
+
+func (_c *JobRunRepository_FailStaleRuns_Call) Run(run func(ctx context.Context, jobName string)) *JobRunRepository_FailStaleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_FailStaleRuns_Call) Return(_a0 error) *JobRunRepository_FailStaleRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_FailStaleRuns_Call) RunAndReturn(run func(context.Context, string) error) *JobRunRepository_FailStaleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finish provides a mock function with given fields: ctx, run
+func (_m *JobRunRepository) Finish(ctx context.Context, run *models.JobRun) error {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.JobRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type JobRunRepository_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run *models.JobRun
+func (_e *JobRunRepository_Expecter) Finish(ctx interface{}, run interface{}) *JobRunRepository_Finish_Call {
+	return &JobRunRepository_Finish_Call{Call: _e.mock.On("Finish", ctx, run)}
+}
+
+func (_c *JobRunRepository_Finish_Call) Run(run func(ctx context.Context, run *models.JobRun)) *JobRunRepository_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.JobRun))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_Finish_Call) Return(_a0 error) *JobRunRepository_Finish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_Finish_Call) RunAndReturn(run func(context.Context, *models.JobRun) error) *JobRunRepository_Finish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPausedJobs provides a mock function with given fields: ctx
+func (_m *JobRunRepository) GetPausedJobs(ctx context.Context) (map[string]bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPausedJobs")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]bool); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunRepository_GetPausedJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPausedJobs'
+type JobRunRepository_GetPausedJobs_Call struct {
+	*mock.Call
+}
+
+// GetPausedJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JobRunRepository_Expecter) GetPausedJobs(ctx interface{}) *JobRunRepository_GetPausedJobs_Call {
+	return &JobRunRepository_GetPausedJobs_Call{Call: _e.mock.On("GetPausedJobs", ctx)}
+}
+
+func (_c *JobRunRepository_GetPausedJobs_Call) Run(run func(ctx context.Context)) *JobRunRepository_GetPausedJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_GetPausedJobs_Call) Return(_a0 map[string]bool, _a1 error) *JobRunRepository_GetPausedJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunRepository_GetPausedJobs_Call) RunAndReturn(run func(context.Context) (map[string]bool, error)) *JobRunRepository_GetPausedJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecent provides a mock function with given fields: ctx, jobName, limit
+func (_m *JobRunRepository) GetRecent(ctx context.Context, jobName string, limit int) ([]models.JobRun, error) {
+	ret := _m.Called(ctx, jobName, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecent")
+	}
+
+	var r0 []models.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.JobRun, error)); ok {
+		return rf(ctx, jobName, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.JobRun); ok {
+		r0 = rf(ctx, jobName, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, jobName, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunRepository_GetRecent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecent'
+type JobRunRepository_GetRecent_Call struct {
+	*mock.Call
+}
+
+// GetRecent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobName string
+//   - limit int
+func (_e *JobRunRepository_Expecter) GetRecent(ctx interface{}, jobName interface{}, limit interface{}) *JobRunRepository_GetRecent_Call {
+	return &JobRunRepository_GetRecent_Call{Call: _e.mock.On("GetRecent", ctx, jobName, limit)}
+}
+
+func (_c *JobRunRepository_GetRecent_Call) Run(run func(ctx context.Context, jobName string, limit int)) *JobRunRepository_GetRecent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_GetRecent_Call) Return(_a0 []models.JobRun, _a1 error) *JobRunRepository_GetRecent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunRepository_GetRecent_Call) RunAndReturn(run func(context.Context, string, int) ([]models.JobRun, error)) *JobRunRepository_GetRecent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPaused provides a mock function with given fields: ctx, jobName, paused, adminID
+func (_m *JobRunRepository) SetPaused(ctx context.Context, jobName string, paused bool, adminID int64) error {
+	ret := _m.Called(ctx, jobName, paused, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPaused")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, int64) error); ok {
+		r0 = rf(ctx, jobName, paused, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_SetPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPaused'
+type JobRunRepository_SetPaused_Call struct {
+	*mock.Call
+}
+
+// SetPaused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobName string
+//   - paused bool
+//   - adminID int64
+func (_e *JobRunRepository_Expecter) SetPaused(ctx interface{}, jobName interface{}, paused interface{}, adminID interface{}) *JobRunRepository_SetPaused_Call {
+	return &JobRunRepository_SetPaused_Call{Call: _e.mock.On("SetPaused", ctx, jobName, paused, adminID)}
+}
+
+func (_c *JobRunRepository_SetPaused_Call) Run(run func(ctx context.Context, jobName string, paused bool, adminID int64)) *JobRunRepository_SetPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_SetPaused_Call) Return(_a0 error) *JobRunRepository_SetPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_SetPaused_Call) RunAndReturn(run func(context.Context, string, bool, int64) error) *JobRunRepository_SetPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, run
+func (_m *JobRunRepository) Start(ctx context.Context, run *models.JobRun) error {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.JobRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type JobRunRepository_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run *models.JobRun
+func (_e *JobRunRepository_Expecter) Start(ctx interface{}, run interface{}) *JobRunRepository_Start_Call {
+	return &JobRunRepository_Start_Call{Call: _e.mock.On("Start", ctx, run)}
+}
+
+func (_c *JobRunRepository_Start_Call) Run(run func(ctx context.Context, run *models.JobRun)) *JobRunRepository_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.JobRun))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_Start_Call) Return(_a0 error) *JobRunRepository_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_Start_Call) RunAndReturn(run func(context.Context, *models.JobRun) error) *JobRunRepository_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TryLock provides a mock function with given fields: ctx, tx, jobName
+func (_m *JobRunRepository) TryLock(ctx context.Context, tx interfaces.Tx, jobName string) (bool, error) {
+	ret := _m.Called(ctx, tx, jobName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) (bool, error)); ok {
+		return rf(ctx, tx, jobName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) bool); ok {
+		r0 = rf(ctx, tx, jobName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string) error); ok {
+		r1 = rf(ctx, tx, jobName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunRepository_TryLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryLock'
+type JobRunRepository_TryLock_Call struct {
+	*mock.Call
+}
+
+// TryLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - jobName string
+func (_e *JobRunRepository_Expecter) TryLock(ctx interface{}, tx interface{}, jobName interface{}) *JobRunRepository_TryLock_Call {
+	return &JobRunRepository_TryLock_Call{Call: _e.mock.On("TryLock", ctx, tx, jobName)}
+}
+
+func (_c *JobRunRepository_TryLock_Call) Run(run func(ctx context.Context, tx interfaces.Tx, jobName string)) *JobRunRepository_TryLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_TryLock_Call) Return(_a0 bool, _a1 error) *JobRunRepository_TryLock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunRepository_TryLock_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string) (bool, error)) *JobRunRepository_TryLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobRunRepository creates a new instance of JobRunRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRunRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRunRepository {
+	mock := &JobRunRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,160 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// JobRunService is an autogenerated mock type for the JobRunService type
+type JobRunService struct {
+	mock.Mock
+}
+
+type JobRunService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobRunService) EXPECT() *JobRunService_Expecter {
+	return &JobRunService_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, jobName, triggeredBy, job
+func (_m *JobRunService) Execute(ctx context.Context, jobName string, triggeredBy *int64, job func(context.Context) (map[string]int, error)) (*models.JobRun, error) {
+	ret := _m.Called(ctx, jobName, triggeredBy, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *models.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int64, func(context.Context) (map[string]int, error)) (*models.JobRun, error)); ok {
+		return rf(ctx, jobName, triggeredBy, job)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int64, func(context.Context) (map[string]int, error)) *models.JobRun); ok {
+		r0 = rf(ctx, jobName, triggeredBy, job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.JobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int64, func(context.Context) (map[string]int, error)) error); ok {
+		r1 = rf(ctx, jobName, triggeredBy, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunService_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type JobRunService_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobName string
+//   - triggeredBy *int64
+//   - job func(context.Context)(map[string]int , error)
+func (_e *JobRunService_Expecter) Execute(ctx interface{}, jobName interface{}, triggeredBy interface{}, job interface{}) *JobRunService_Execute_Call {
+	return &JobRunService_Execute_Call{Call: _e.mock.On("Execute", ctx, jobName, triggeredBy, job)}
+}
+
+func (_c *JobRunService_Execute_Call) Run(run func(ctx context.Context, jobName string, triggeredBy *int64, job func(context.Context) (map[string]int, error))) *JobRunService_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*int64), args[3].(func(context.Context) (map[string]int, error)))
+	})
+	return _c
+}
+
+func (_c *JobRunService_Execute_Call) Return(_a0 *models.JobRun, _a1 error) *JobRunService_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunService_Execute_Call) RunAndReturn(run func(context.Context, string, *int64, func(context.Context) (map[string]int, error)) (*models.JobRun, error)) *JobRunService_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuns provides a mock function with given fields: ctx, role, jobName, limit
+func (_m *JobRunService) GetRuns(ctx context.Context, role string, jobName string, limit int) ([]models.JobRun, error) {
+	ret := _m.Called(ctx, role, jobName, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuns")
+	}
+
+	var r0 []models.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]models.JobRun, error)); ok {
+		return rf(ctx, role, jobName, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []models.JobRun); ok {
+		r0 = rf(ctx, role, jobName, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, role, jobName, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunService_GetRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuns'
+type JobRunService_GetRuns_Call struct {
+	*mock.Call
+}
+
+// GetRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - jobName string
+//   - limit int
+func (_e *JobRunService_Expecter) GetRuns(ctx interface{}, role interface{}, jobName interface{}, limit interface{}) *JobRunService_GetRuns_Call {
+	return &JobRunService_GetRuns_Call{Call: _e.mock.On("GetRuns", ctx, role, jobName, limit)}
+}
+
+func (_c *JobRunService_GetRuns_Call) Run(run func(ctx context.Context, role string, jobName string, limit int)) *JobRunService_GetRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *JobRunService_GetRuns_Call) Return(_a0 []models.JobRun, _a1 error) *JobRunService_GetRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunService_GetRuns_Call) RunAndReturn(run func(context.Context, string, string, int) ([]models.JobRun, error)) *JobRunService_GetRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobRunService creates a new instance of JobRunService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRunService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRunService {
+	mock := &JobRunService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,13 +4,11 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
-
-	time "time"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // LeaveRequestRepository is an autogenerated mock type for the LeaveRequestRepository type
@@ -74,9 +72,9 @@ func (_c *LeaveRequestRepository_Cancel_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// CheckOverlap provides a mock function with given fields: ctx, userID, fromDate, toDate
-func (_m *LeaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, fromDate time.Time, toDate time.Time) (bool, error) {
-	ret := _m.Called(ctx, userID, fromDate, toDate)
+// CheckOverlap provides a mock function with given fields: ctx, userID, period
+func (_m *LeaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, period models.LeavePeriod) (bool, error) {
+	ret := _m.Called(ctx, userID, period)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CheckOverlap")
@@ -84,17 +82,17 @@ func (_m *LeaveRequestRepository) CheckOverlap(ctx context.Context, userID int64
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) (bool, error)); ok {
-		return rf(ctx, userID, fromDate, toDate)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.LeavePeriod) (bool, error)); ok {
+		return rf(ctx, userID, period)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) bool); ok {
-		r0 = rf(ctx, userID, fromDate, toDate)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.LeavePeriod) bool); ok {
+		r0 = rf(ctx, userID, period)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, userID, fromDate, toDate)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.LeavePeriod) error); ok {
+		r1 = rf(ctx, userID, period)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -110,15 +108,14 @@ type LeaveRequestRepository_CheckOverlap_Call struct {
 // CheckOverlap is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-//   - fromDate time.Time
-//   - toDate time.Time
-func (_e *LeaveRequestRepository_Expecter) CheckOverlap(ctx interface{}, userID interface{}, fromDate interface{}, toDate interface{}) *LeaveRequestRepository_CheckOverlap_Call {
-	return &LeaveRequestRepository_CheckOverlap_Call{Call: _e.mock.On("CheckOverlap", ctx, userID, fromDate, toDate)}
+//   - period models.LeavePeriod
+func (_e *LeaveRequestRepository_Expecter) CheckOverlap(ctx interface{}, userID interface{}, period interface{}) *LeaveRequestRepository_CheckOverlap_Call {
+	return &LeaveRequestRepository_CheckOverlap_Call{Call: _e.mock.On("CheckOverlap", ctx, userID, period)}
 }
 
-func (_c *LeaveRequestRepository_CheckOverlap_Call) Run(run func(ctx context.Context, userID int64, fromDate time.Time, toDate time.Time)) *LeaveRequestRepository_CheckOverlap_Call {
+func (_c *LeaveRequestRepository_CheckOverlap_Call) Run(run func(ctx context.Context, userID int64, period models.LeavePeriod)) *LeaveRequestRepository_CheckOverlap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.LeavePeriod))
 	})
 	return _c
 }
@@ -128,7 +125,7 @@ func (_c *LeaveRequestRepository_CheckOverlap_Call) Return(_a0 bool, _a1 error)
 	return _c
 }
 
-func (_c *LeaveRequestRepository_CheckOverlap_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) (bool, error)) *LeaveRequestRepository_CheckOverlap_Call {
+func (_c *LeaveRequestRepository_CheckOverlap_Call) RunAndReturn(run func(context.Context, int64, models.LeavePeriod) (bool, error)) *LeaveRequestRepository_CheckOverlap_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -308,9 +305,9 @@ func (_c *LeaveRequestRepository_GetPendingForAdmin_Call) RunAndReturn(run func(
 	return _c
 }
 
-// GetPendingForManager provides a mock function with given fields: ctx, managerID, limit, offset
-func (_m *LeaveRequestRepository) GetPendingForManager(ctx context.Context, managerID int64, limit int, offset int) ([]map[string]interface{}, int, error) {
-	ret := _m.Called(ctx, managerID, limit, offset)
+// GetPendingForManager provides a mock function with given fields: ctx, managerIDs, limit, offset
+func (_m *LeaveRequestRepository) GetPendingForManager(ctx context.Context, managerIDs []int64, limit int, offset int) ([]map[string]interface{}, int, error) {
+	ret := _m.Called(ctx, managerIDs, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingForManager")
@@ -319,25 +316,25 @@ func (_m *LeaveRequestRepository) GetPendingForManager(ctx context.Context, mana
 	var r0 []map[string]interface{}
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]map[string]interface{}, int, error)); ok {
-		return rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int, int) ([]map[string]interface{}, int, error)); ok {
+		return rf(ctx, managerIDs, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) []map[string]interface{}); ok {
-		r0 = rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int, int) []map[string]interface{}); ok {
+		r0 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
-		r1 = rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, int, int) int); ok {
+		r1 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = rf(ctx, managerID, limit, offset)
+	if rf, ok := ret.Get(2).(func(context.Context, []int64, int, int) error); ok {
+		r2 = rf(ctx, managerIDs, limit, offset)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -352,16 +349,16 @@ type LeaveRequestRepository_GetPendingForManager_Call struct {
 
 // GetPendingForManager is a helper method to define mock.On call
 //   - ctx context.Context
-//   - managerID int64
+//   - managerIDs []int64
 //   - limit int
 //   - offset int
-func (_e *LeaveRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerID interface{}, limit interface{}, offset interface{}) *LeaveRequestRepository_GetPendingForManager_Call {
-	return &LeaveRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerID, limit, offset)}
+func (_e *LeaveRequestRepository_Expecter) GetPendingForManager(ctx interface{}, managerIDs interface{}, limit interface{}, offset interface{}) *LeaveRequestRepository_GetPendingForManager_Call {
+	return &LeaveRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerIDs, limit, offset)}
 }
 
-func (_c *LeaveRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerID int64, limit int, offset int)) *LeaveRequestRepository_GetPendingForManager_Call {
+func (_c *LeaveRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, managerIDs []int64, limit int, offset int)) *LeaveRequestRepository_GetPendingForManager_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].([]int64), args[2].(int), args[3].(int))
 	})
 	return _c
 }
@@ -371,7 +368,7 @@ func (_c *LeaveRequestRepository_GetPendingForManager_Call) Return(_a0 []map[str
 	return _c
 }
 
-func (_c *LeaveRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, int64, int, int) ([]map[string]interface{}, int, error)) *LeaveRequestRepository_GetPendingForManager_Call {
+func (_c *LeaveRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, []int64, int, int) ([]map[string]interface{}, int, error)) *LeaveRequestRepository_GetPendingForManager_Call {
 	_c.Call.Return(run)
 	return _c
 }
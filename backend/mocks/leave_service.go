@@ -5,9 +5,8 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // LeaveService is an autogenerated mock type for the LeaveService type
@@ -23,9 +22,9 @@ func (_m *LeaveService) EXPECT() *LeaveService_Expecter {
 	return &LeaveService_Expecter{mock: &_m.Mock}
 }
 
-// ApplyLeave provides a mock function with given fields: ctx, userID, from, to, days, leaveType, reason
-func (_m *LeaveService) ApplyLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string) (string, string, error) {
-	ret := _m.Called(ctx, userID, from, to, days, leaveType, reason)
+// ApplyLeave provides a mock function with given fields: ctx, userID, period, leaveType, reason
+func (_m *LeaveService) ApplyLeave(ctx context.Context, userID int64, period models.LeavePeriod, leaveType string, reason string) (string, string, models.LeavePeriod, error) {
+	ret := _m.Called(ctx, userID, period, leaveType, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ApplyLeave")
@@ -33,29 +32,36 @@ func (_m *LeaveService) ApplyLeave(ctx context.Context, userID int64, from time.
 
 	var r0 string
 	var r1 string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string, string) (string, string, error)); ok {
-		return rf(ctx, userID, from, to, days, leaveType, reason)
+	var r2 models.LeavePeriod
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.LeavePeriod, string, string) (string, string, models.LeavePeriod, error)); ok {
+		return rf(ctx, userID, period, leaveType, reason)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string, string) string); ok {
-		r0 = rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.LeavePeriod, string, string) string); ok {
+		r0 = rf(ctx, userID, period, leaveType, reason)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, int, string, string) string); ok {
-		r1 = rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.LeavePeriod, string, string) string); ok {
+		r1 = rf(ctx, userID, period, leaveType, reason)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, time.Time, time.Time, int, string, string) error); ok {
-		r2 = rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(2).(func(context.Context, int64, models.LeavePeriod, string, string) models.LeavePeriod); ok {
+		r2 = rf(ctx, userID, period, leaveType, reason)
+	} else {
+		r2 = ret.Get(2).(models.LeavePeriod)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, int64, models.LeavePeriod, string, string) error); ok {
+		r3 = rf(ctx, userID, period, leaveType, reason)
 	} else {
-		r2 = ret.Error(2)
+		r3 = ret.Error(3)
 	}
 
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
 // LeaveService_ApplyLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyLeave'
@@ -66,28 +72,26 @@ type LeaveService_ApplyLeave_Call struct {
 // ApplyLeave is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-//   - from time.Time
-//   - to time.Time
-//   - days int
+//   - period models.LeavePeriod
 //   - leaveType string
 //   - reason string
-func (_e *LeaveService_Expecter) ApplyLeave(ctx interface{}, userID interface{}, from interface{}, to interface{}, days interface{}, leaveType interface{}, reason interface{}) *LeaveService_ApplyLeave_Call {
-	return &LeaveService_ApplyLeave_Call{Call: _e.mock.On("ApplyLeave", ctx, userID, from, to, days, leaveType, reason)}
+func (_e *LeaveService_Expecter) ApplyLeave(ctx interface{}, userID interface{}, period interface{}, leaveType interface{}, reason interface{}) *LeaveService_ApplyLeave_Call {
+	return &LeaveService_ApplyLeave_Call{Call: _e.mock.On("ApplyLeave", ctx, userID, period, leaveType, reason)}
 }
 
-func (_c *LeaveService_ApplyLeave_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string)) *LeaveService_ApplyLeave_Call {
+func (_c *LeaveService_ApplyLeave_Call) Run(run func(ctx context.Context, userID int64, period models.LeavePeriod, leaveType string, reason string)) *LeaveService_ApplyLeave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(int), args[5].(string), args[6].(string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.LeavePeriod), args[3].(string), args[4].(string))
 	})
 	return _c
 }
 
-func (_c *LeaveService_ApplyLeave_Call) Return(_a0 string, _a1 string, _a2 error) *LeaveService_ApplyLeave_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *LeaveService_ApplyLeave_Call) Return(_a0 string, _a1 string, _a2 models.LeavePeriod, _a3 error) *LeaveService_ApplyLeave_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
 	return _c
 }
 
-func (_c *LeaveService_ApplyLeave_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, int, string, string) (string, string, error)) *LeaveService_ApplyLeave_Call {
+func (_c *LeaveService_ApplyLeave_Call) RunAndReturn(run func(context.Context, int64, models.LeavePeriod, string, string) (string, string, models.LeavePeriod, error)) *LeaveService_ApplyLeave_Call {
 	_c.Call.Return(run)
 	return _c
 }
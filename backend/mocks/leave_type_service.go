@@ -0,0 +1,204 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LeaveTypeService is an autogenerated mock type for the LeaveTypeService type
+type LeaveTypeService struct {
+	mock.Mock
+}
+
+type LeaveTypeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LeaveTypeService) EXPECT() *LeaveTypeService_Expecter {
+	return &LeaveTypeService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteLeaveType provides a mock function with given fields: ctx, role, code
+func (_m *LeaveTypeService) DeleteLeaveType(ctx context.Context, role string, code string) error {
+	ret := _m.Called(ctx, role, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLeaveType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, role, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveTypeService_DeleteLeaveType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLeaveType'
+type LeaveTypeService_DeleteLeaveType_Call struct {
+	*mock.Call
+}
+
+// DeleteLeaveType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - code string
+func (_e *LeaveTypeService_Expecter) DeleteLeaveType(ctx interface{}, role interface{}, code interface{}) *LeaveTypeService_DeleteLeaveType_Call {
+	return &LeaveTypeService_DeleteLeaveType_Call{Call: _e.mock.On("DeleteLeaveType", ctx, role, code)}
+}
+
+func (_c *LeaveTypeService_DeleteLeaveType_Call) Run(run func(ctx context.Context, role string, code string)) *LeaveTypeService_DeleteLeaveType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeService_DeleteLeaveType_Call) Return(_a0 error) *LeaveTypeService_DeleteLeaveType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveTypeService_DeleteLeaveType_Call) RunAndReturn(run func(context.Context, string, string) error) *LeaveTypeService_DeleteLeaveType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaveTypes provides a mock function with given fields: ctx
+func (_m *LeaveTypeService) GetLeaveTypes(ctx context.Context) ([]models.LeaveType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveTypes")
+	}
+
+	var r0 []models.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.LeaveType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.LeaveType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveTypeService_GetLeaveTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveTypes'
+type LeaveTypeService_GetLeaveTypes_Call struct {
+	*mock.Call
+}
+
+// GetLeaveTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LeaveTypeService_Expecter) GetLeaveTypes(ctx interface{}) *LeaveTypeService_GetLeaveTypes_Call {
+	return &LeaveTypeService_GetLeaveTypes_Call{Call: _e.mock.On("GetLeaveTypes", ctx)}
+}
+
+func (_c *LeaveTypeService_GetLeaveTypes_Call) Run(run func(ctx context.Context)) *LeaveTypeService_GetLeaveTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeService_GetLeaveTypes_Call) Return(_a0 []models.LeaveType, _a1 error) *LeaveTypeService_GetLeaveTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveTypeService_GetLeaveTypes_Call) RunAndReturn(run func(context.Context) ([]models.LeaveType, error)) *LeaveTypeService_GetLeaveTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLeaveType provides a mock function with given fields: ctx, role, adminID, leaveType
+func (_m *LeaveTypeService) SetLeaveType(ctx context.Context, role string, adminID int64, leaveType models.LeaveType) (*models.LeaveType, error) {
+	ret := _m.Called(ctx, role, adminID, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLeaveType")
+	}
+
+	var r0 *models.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.LeaveType) (*models.LeaveType, error)); ok {
+		return rf(ctx, role, adminID, leaveType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.LeaveType) *models.LeaveType); ok {
+		r0 = rf(ctx, role, adminID, leaveType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.LeaveType) error); ok {
+		r1 = rf(ctx, role, adminID, leaveType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveTypeService_SetLeaveType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLeaveType'
+type LeaveTypeService_SetLeaveType_Call struct {
+	*mock.Call
+}
+
+// SetLeaveType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - leaveType models.LeaveType
+func (_e *LeaveTypeService_Expecter) SetLeaveType(ctx interface{}, role interface{}, adminID interface{}, leaveType interface{}) *LeaveTypeService_SetLeaveType_Call {
+	return &LeaveTypeService_SetLeaveType_Call{Call: _e.mock.On("SetLeaveType", ctx, role, adminID, leaveType)}
+}
+
+func (_c *LeaveTypeService_SetLeaveType_Call) Run(run func(ctx context.Context, role string, adminID int64, leaveType models.LeaveType)) *LeaveTypeService_SetLeaveType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.LeaveType))
+	})
+	return _c
+}
+
+func (_c *LeaveTypeService_SetLeaveType_Call) Return(_a0 *models.LeaveType, _a1 error) *LeaveTypeService_SetLeaveType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveTypeService_SetLeaveType_Call) RunAndReturn(run func(context.Context, string, int64, models.LeaveType) (*models.LeaveType, error)) *LeaveTypeService_SetLeaveType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLeaveTypeService creates a new instance of LeaveTypeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLeaveTypeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LeaveTypeService {
+	mock := &LeaveTypeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -311,9 +311,9 @@ func (_c *MyRequestsRepository_GetMyLeaveRequests_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// GetPendingAllRequests provides a mock function with given fields: ctx, role, approverID, limit, offset
-func (_m *MyRequestsRepository) GetPendingAllRequests(ctx context.Context, role string, approverID int64, limit int, offset int) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}, int, error) {
-	ret := _m.Called(ctx, role, approverID, limit, offset)
+// GetPendingAllRequests provides a mock function with given fields: ctx, role, approverIDs, limit, offset
+func (_m *MyRequestsRepository) GetPendingAllRequests(ctx context.Context, role string, approverIDs []int64, limit int, offset int) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}, int, error) {
+	ret := _m.Called(ctx, role, approverIDs, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingAllRequests")
@@ -324,41 +324,41 @@ func (_m *MyRequestsRepository) GetPendingAllRequests(ctx context.Context, role
 	var r2 []map[string]interface{}
 	var r3 int
 	var r4 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, int) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}, int, error)); ok {
-		return rf(ctx, role, approverID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int64, int, int) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}, int, error)); ok {
+		return rf(ctx, role, approverIDs, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, int) []map[string]interface{}); ok {
-		r0 = rf(ctx, role, approverID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int64, int, int) []map[string]interface{}); ok {
+		r0 = rf(ctx, role, approverIDs, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int, int) []map[string]interface{}); ok {
-		r1 = rf(ctx, role, approverID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []int64, int, int) []map[string]interface{}); ok {
+		r1 = rf(ctx, role, approverIDs, limit, offset)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, int64, int, int) []map[string]interface{}); ok {
-		r2 = rf(ctx, role, approverID, limit, offset)
+	if rf, ok := ret.Get(2).(func(context.Context, string, []int64, int, int) []map[string]interface{}); ok {
+		r2 = rf(ctx, role, approverIDs, limit, offset)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(3).(func(context.Context, string, int64, int, int) int); ok {
-		r3 = rf(ctx, role, approverID, limit, offset)
+	if rf, ok := ret.Get(3).(func(context.Context, string, []int64, int, int) int); ok {
+		r3 = rf(ctx, role, approverIDs, limit, offset)
 	} else {
 		r3 = ret.Get(3).(int)
 	}
 
-	if rf, ok := ret.Get(4).(func(context.Context, string, int64, int, int) error); ok {
-		r4 = rf(ctx, role, approverID, limit, offset)
+	if rf, ok := ret.Get(4).(func(context.Context, string, []int64, int, int) error); ok {
+		r4 = rf(ctx, role, approverIDs, limit, offset)
 	} else {
 		r4 = ret.Error(4)
 	}
@@ -374,16 +374,16 @@ type MyRequestsRepository_GetPendingAllRequests_Call struct {
 // GetPendingAllRequests is a helper method to define mock.On call
 //   - ctx context.Context
 //   - role string
-//   - approverID int64
+//   - approverIDs []int64
 //   - limit int
 //   - offset int
-func (_e *MyRequestsRepository_Expecter) GetPendingAllRequests(ctx interface{}, role interface{}, approverID interface{}, limit interface{}, offset interface{}) *MyRequestsRepository_GetPendingAllRequests_Call {
-	return &MyRequestsRepository_GetPendingAllRequests_Call{Call: _e.mock.On("GetPendingAllRequests", ctx, role, approverID, limit, offset)}
+func (_e *MyRequestsRepository_Expecter) GetPendingAllRequests(ctx interface{}, role interface{}, approverIDs interface{}, limit interface{}, offset interface{}) *MyRequestsRepository_GetPendingAllRequests_Call {
+	return &MyRequestsRepository_GetPendingAllRequests_Call{Call: _e.mock.On("GetPendingAllRequests", ctx, role, approverIDs, limit, offset)}
 }
 
-func (_c *MyRequestsRepository_GetPendingAllRequests_Call) Run(run func(ctx context.Context, role string, approverID int64, limit int, offset int)) *MyRequestsRepository_GetPendingAllRequests_Call {
+func (_c *MyRequestsRepository_GetPendingAllRequests_Call) Run(run func(ctx context.Context, role string, approverIDs []int64, limit int, offset int)) *MyRequestsRepository_GetPendingAllRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int), args[4].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].([]int64), args[3].(int), args[4].(int))
 	})
 	return _c
 }
@@ -393,7 +393,7 @@ func (_c *MyRequestsRepository_GetPendingAllRequests_Call) Return(leaves []map[s
 	return _c
 }
 
-func (_c *MyRequestsRepository_GetPendingAllRequests_Call) RunAndReturn(run func(context.Context, string, int64, int, int) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}, int, error)) *MyRequestsRepository_GetPendingAllRequests_Call {
+func (_c *MyRequestsRepository_GetPendingAllRequests_Call) RunAndReturn(run func(context.Context, string, []int64, int, int) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}, int, error)) *MyRequestsRepository_GetPendingAllRequests_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,9 +5,8 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ReportService is an autogenerated mock type for the ReportService type
@@ -82,6 +81,123 @@ func (_c *ReportService_GetDashboardSummary_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetLeaveByCalendar provides a mock function with given fields: ctx, role
+func (_m *ReportService) GetLeaveByCalendar(ctx context.Context, role string) ([]models.CalendarLeaveReport, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveByCalendar")
+	}
+
+	var r0 []models.CalendarLeaveReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.CalendarLeaveReport, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.CalendarLeaveReport); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CalendarLeaveReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportService_GetLeaveByCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveByCalendar'
+type ReportService_GetLeaveByCalendar_Call struct {
+	*mock.Call
+}
+
+// GetLeaveByCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *ReportService_Expecter) GetLeaveByCalendar(ctx interface{}, role interface{}) *ReportService_GetLeaveByCalendar_Call {
+	return &ReportService_GetLeaveByCalendar_Call{Call: _e.mock.On("GetLeaveByCalendar", ctx, role)}
+}
+
+func (_c *ReportService_GetLeaveByCalendar_Call) Run(run func(ctx context.Context, role string)) *ReportService_GetLeaveByCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReportService_GetLeaveByCalendar_Call) Return(_a0 []models.CalendarLeaveReport, _a1 error) *ReportService_GetLeaveByCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportService_GetLeaveByCalendar_Call) RunAndReturn(run func(context.Context, string) ([]models.CalendarLeaveReport, error)) *ReportService_GetLeaveByCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingCounts provides a mock function with given fields: ctx
+func (_m *ReportService) GetPendingCounts(ctx context.Context) (map[string]int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingCounts")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportService_GetPendingCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingCounts'
+type ReportService_GetPendingCounts_Call struct {
+	*mock.Call
+}
+
+// GetPendingCounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportService_Expecter) GetPendingCounts(ctx interface{}) *ReportService_GetPendingCounts_Call {
+	return &ReportService_GetPendingCounts_Call{Call: _e.mock.On("GetPendingCounts", ctx)}
+}
+
+func (_c *ReportService_GetPendingCounts_Call) Run(run func(ctx context.Context)) *ReportService_GetPendingCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportService_GetPendingCounts_Call) Return(_a0 map[string]int, _a1 error) *ReportService_GetPendingCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportService_GetPendingCounts_Call) RunAndReturn(run func(context.Context) (map[string]int, error)) *ReportService_GetPendingCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRequestStatusDistribution provides a mock function with given fields: ctx
 func (_m *ReportService) GetRequestStatusDistribution(ctx context.Context) (map[string]int, error) {
 	ret := _m.Called(ctx)
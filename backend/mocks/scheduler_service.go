@@ -0,0 +1,368 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SchedulerService is an autogenerated mock type for the SchedulerService type
+type SchedulerService struct {
+	mock.Mock
+}
+
+type SchedulerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SchedulerService) EXPECT() *SchedulerService_Expecter {
+	return &SchedulerService_Expecter{mock: &_m.Mock}
+}
+
+// GetJobs provides a mock function with given fields: ctx, role
+func (_m *SchedulerService) GetJobs(ctx context.Context, role string) ([]models.ScheduledJob, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobs")
+	}
+
+	var r0 []models.ScheduledJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ScheduledJob, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ScheduledJob); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ScheduledJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchedulerService_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type SchedulerService_GetJobs_Call struct {
+	*mock.Call
+}
+
+// GetJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *SchedulerService_Expecter) GetJobs(ctx interface{}, role interface{}) *SchedulerService_GetJobs_Call {
+	return &SchedulerService_GetJobs_Call{Call: _e.mock.On("GetJobs", ctx, role)}
+}
+
+func (_c *SchedulerService_GetJobs_Call) Run(run func(ctx context.Context, role string)) *SchedulerService_GetJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SchedulerService_GetJobs_Call) Return(_a0 []models.ScheduledJob, _a1 error) *SchedulerService_GetJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchedulerService_GetJobs_Call) RunAndReturn(run func(context.Context, string) ([]models.ScheduledJob, error)) *SchedulerService_GetJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseJob provides a mock function with given fields: ctx, role, adminID, name
+func (_m *SchedulerService) PauseJob(ctx context.Context, role string, adminID int64, name string) error {
+	ret := _m.Called(ctx, role, adminID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) error); ok {
+		r0 = rf(ctx, role, adminID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchedulerService_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type SchedulerService_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - name string
+func (_e *SchedulerService_Expecter) PauseJob(ctx interface{}, role interface{}, adminID interface{}, name interface{}) *SchedulerService_PauseJob_Call {
+	return &SchedulerService_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, role, adminID, name)}
+}
+
+func (_c *SchedulerService_PauseJob_Call) Run(run func(ctx context.Context, role string, adminID int64, name string)) *SchedulerService_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SchedulerService_PauseJob_Call) Return(_a0 error) *SchedulerService_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchedulerService_PauseJob_Call) RunAndReturn(run func(context.Context, string, int64, string) error) *SchedulerService_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: name, schedule, timezone, job
+func (_m *SchedulerService) Register(name string, schedule string, timezone string, job func(context.Context) (map[string]int, error)) error {
+	ret := _m.Called(name, schedule, timezone, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, func(context.Context) (map[string]int, error)) error); ok {
+		r0 = rf(name, schedule, timezone, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchedulerService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type SchedulerService_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - name string
+//   - schedule string
+//   - timezone string
+//   - job func(context.Context)(map[string]int , error)
+func (_e *SchedulerService_Expecter) Register(name interface{}, schedule interface{}, timezone interface{}, job interface{}) *SchedulerService_Register_Call {
+	return &SchedulerService_Register_Call{Call: _e.mock.On("Register", name, schedule, timezone, job)}
+}
+
+func (_c *SchedulerService_Register_Call) Run(run func(name string, schedule string, timezone string, job func(context.Context) (map[string]int, error))) *SchedulerService_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(func(context.Context) (map[string]int, error)))
+	})
+	return _c
+}
+
+func (_c *SchedulerService_Register_Call) Return(_a0 error) *SchedulerService_Register_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchedulerService_Register_Call) RunAndReturn(run func(string, string, string, func(context.Context) (map[string]int, error)) error) *SchedulerService_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeJob provides a mock function with given fields: ctx, role, adminID, name
+func (_m *SchedulerService) ResumeJob(ctx context.Context, role string, adminID int64, name string) error {
+	ret := _m.Called(ctx, role, adminID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) error); ok {
+		r0 = rf(ctx, role, adminID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchedulerService_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type SchedulerService_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - name string
+func (_e *SchedulerService_Expecter) ResumeJob(ctx interface{}, role interface{}, adminID interface{}, name interface{}) *SchedulerService_ResumeJob_Call {
+	return &SchedulerService_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, role, adminID, name)}
+}
+
+func (_c *SchedulerService_ResumeJob_Call) Run(run func(ctx context.Context, role string, adminID int64, name string)) *SchedulerService_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SchedulerService_ResumeJob_Call) Return(_a0 error) *SchedulerService_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchedulerService_ResumeJob_Call) RunAndReturn(run func(context.Context, string, int64, string) error) *SchedulerService_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *SchedulerService) Start() {
+	_m.Called()
+}
+
+// SchedulerService_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type SchedulerService_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *SchedulerService_Expecter) Start() *SchedulerService_Start_Call {
+	return &SchedulerService_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *SchedulerService_Start_Call) Run(run func()) *SchedulerService_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SchedulerService_Start_Call) Return() *SchedulerService_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchedulerService_Start_Call) RunAndReturn(run func()) *SchedulerService_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *SchedulerService) Stop() {
+	_m.Called()
+}
+
+// SchedulerService_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type SchedulerService_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *SchedulerService_Expecter) Stop() *SchedulerService_Stop_Call {
+	return &SchedulerService_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *SchedulerService_Stop_Call) Run(run func()) *SchedulerService_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SchedulerService_Stop_Call) Return() *SchedulerService_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchedulerService_Stop_Call) RunAndReturn(run func()) *SchedulerService_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// TriggerJob provides a mock function with given fields: ctx, role, adminID, name
+func (_m *SchedulerService) TriggerJob(ctx context.Context, role string, adminID int64, name string) (*models.JobRun, error) {
+	ret := _m.Called(ctx, role, adminID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TriggerJob")
+	}
+
+	var r0 *models.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) (*models.JobRun, error)); ok {
+		return rf(ctx, role, adminID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) *models.JobRun); ok {
+		r0 = rf(ctx, role, adminID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.JobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string) error); ok {
+		r1 = rf(ctx, role, adminID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchedulerService_TriggerJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerJob'
+type SchedulerService_TriggerJob_Call struct {
+	*mock.Call
+}
+
+// TriggerJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - name string
+func (_e *SchedulerService_Expecter) TriggerJob(ctx interface{}, role interface{}, adminID interface{}, name interface{}) *SchedulerService_TriggerJob_Call {
+	return &SchedulerService_TriggerJob_Call{Call: _e.mock.On("TriggerJob", ctx, role, adminID, name)}
+}
+
+func (_c *SchedulerService_TriggerJob_Call) Run(run func(ctx context.Context, role string, adminID int64, name string)) *SchedulerService_TriggerJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SchedulerService_TriggerJob_Call) Return(_a0 *models.JobRun, _a1 error) *SchedulerService_TriggerJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchedulerService_TriggerJob_Call) RunAndReturn(run func(context.Context, string, int64, string) (*models.JobRun, error)) *SchedulerService_TriggerJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSchedulerService creates a new instance of SchedulerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSchedulerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SchedulerService {
+	mock := &SchedulerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
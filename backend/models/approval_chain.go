@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// ApprovalChain is an ordered list of approvers for pending requests of one type whose
+// amount falls in [MinAmount, MaxAmount). The amount is the expense amount, the leave
+// days or the discount percent; a nil MaxAmount leaves the band open-ended.
+type ApprovalChain struct {
+	ID          int64               `json:"id"`
+	Name        string              `json:"name"`
+	RequestType string              `json:"request_type"`
+	MinAmount   float64             `json:"min_amount"`
+	MaxAmount   *float64            `json:"max_amount,omitempty"`
+	Active      bool                `json:"active"`
+	Steps       []ApprovalChainStep `json:"steps"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+// ApprovalChainStep names who approves at one level of a chain. ROLE steps use
+// ApproverRole and USER steps ApproverUserID; manager steps are resolved per requester.
+type ApprovalChainStep struct {
+	StepOrder      int    `json:"step_order"`
+	ApproverType   string `json:"approver_type"`
+	ApproverRole   string `json:"approver_role,omitempty"`
+	ApproverUserID *int64 `json:"approver_user_id,omitempty"`
+}
+
+// RequestApprovalStep is one resolved step of the chain a request goes through
+type RequestApprovalStep struct {
+	ID             int64      `json:"id"`
+	RequestType    string     `json:"request_type"`
+	RequestID      int64      `json:"request_id"`
+	ChainID        *int64     `json:"chain_id,omitempty"`
+	StepOrder      int        `json:"step_order"`
+	ApproverType   string     `json:"approver_type"`
+	ApproverRole   string     `json:"approver_role,omitempty"`
+	ApproverUserID *int64     `json:"approver_user_id,omitempty"`
+	Status         string     `json:"status"`
+	DecidedBy      *int64     `json:"decided_by,omitempty"`
+	OnBehalfOfID   *int64     `json:"on_behalf_of_id,omitempty"`
+	DelegationID   *int64     `json:"delegation_id,omitempty"`
+	Comment        string     `json:"comment,omitempty"`
+	DecidedAt      *time.Time `json:"decided_at,omitempty"`
+}
@@ -0,0 +1,23 @@
+package models
+
+// AutoRejectItem is a pending request the auto-reject job acted on, or would act on
+type AutoRejectItem struct {
+	RequestID   int64 `json:"request_id"`
+	WorkingDays int   `json:"working_days"`
+	SLAPolicyID int64 `json:"sla_policy_id"`
+}
+
+// AutoRejectSummary lists the requests of one type by the escalation step they went through
+type AutoRejectSummary struct {
+	RequestType  string           `json:"request_type"`
+	Reminded     []AutoRejectItem `json:"reminded"`
+	Escalated    []AutoRejectItem `json:"escalated"`
+	AutoRejected []AutoRejectItem `json:"auto_rejected"`
+}
+
+// AutoRejectReport is the outcome of an auto-reject run. A dry run changes nothing and
+// reports what a real run would do.
+type AutoRejectReport struct {
+	DryRun    bool                `json:"dry_run"`
+	Summaries []AutoRejectSummary `json:"summaries"`
+}
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+// BalanceChange says why a balance moved. RequestID and ActorID are nil when the change
+// was not made for a request or was made by the system.
+type BalanceChange struct {
+	RequestID *int64
+	ActorID   *int64
+	Reason    string
+}
+
+// BalanceLedgerEntry is one change to a user's leave, expense or discount balance.
+// Amount is negative for deductions; BalanceAfter is the remaining balance once it applied.
+// LeaveType names the leave wallet of leave entries.
+type BalanceLedgerEntry struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`
+	BalanceType  string    `json:"balance_type"`
+	LeaveType    string    `json:"leave_type,omitempty"`
+	EntryType    string    `json:"entry_type"`
+	Amount       float64   `json:"amount"`
+	BalanceAfter float64   `json:"balance_after"`
+	RequestID    *int64    `json:"request_id,omitempty"`
+	ActorID      *int64    `json:"actor_id,omitempty"`
+	Reason       string    `json:"reason"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Balance splits what was allocated to a wallet. Remaining is what approved requests
+// have not consumed; pending requests reserve part of it and the rest is available to
+// apply for. LeaveType names the wallet of leave balances.
+type Balance struct {
+	LeaveType string  `json:"leave_type,omitempty"`
+	Total     float64 `json:"total"`
+	Consumed  float64 `json:"consumed"`
+	Reserved  float64 `json:"reserved"`
+	Available float64 `json:"available"`
+	Remaining float64 `json:"remaining"`
+}
+
+// BalanceAdjustment is an admin correction to a wallet: a credit when Amount is positive
+// and a debit when it is negative. LeaveType picks the wallet of leave adjustments.
+// Balance is the wallet once the adjustment applied.
+type BalanceAdjustment struct {
+	UserID      int64    `json:"user_id"`
+	BalanceType string   `json:"balance_type"`
+	LeaveType   string   `json:"leave_type,omitempty"`
+	Amount      float64  `json:"amount"`
+	Reason      string   `json:"reason"`
+	Balance     *Balance `json:"balance,omitempty"`
+}
+
+// BalanceAdjustmentError is why one row of a bulk adjustment file was refused. Rows
+// count from the header, which is row 1.
+type BalanceAdjustmentError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// BulkBalanceAdjustment reports a bulk adjustment. The rows apply together or, when any
+// of them is refused, not at all; a preview never applies them.
+type BulkBalanceAdjustment struct {
+	Preview     bool                     `json:"preview"`
+	Applied     bool                     `json:"applied"`
+	Adjustments []BalanceAdjustment      `json:"adjustments"`
+	Errors      []BalanceAdjustmentError `json:"errors"`
+}
@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// BalancePolicy says how the balances of one type are replenished. The grade limit, or for
+// leave the allocation of the leave type to the grade, is the allocation of a full cycle;
+// a nil CarryForwardCap carries the whole remaining balance over and a nil
+// CarryForwardExpiryMonths keeps carried balance for the whole cycle.
+type BalancePolicy struct {
+	ID                       int64     `json:"id"`
+	BalanceType              string    `json:"balance_type"`
+	ResetCycle               string    `json:"reset_cycle"`
+	FiscalStartMonth         int       `json:"fiscal_start_month"`
+	Accrual                  string    `json:"accrual"`
+	CarryForwardCap          *float64  `json:"carry_forward_cap,omitempty"`
+	CarryForwardExpiryMonths *int      `json:"carry_forward_expiry_months,omitempty"`
+	ProrateJoiners           bool      `json:"prorate_joiners"`
+	Active                   bool      `json:"active"`
+	UpdatedBy                *int64    `json:"updated_by,omitempty"`
+	UpdatedAt                time.Time `json:"updated_at"`
+}
+
+// BalanceCycle is where one wallet stands in the current cycle of its policy. Allocated
+// is what the cycle granted so far, not counting the carried-over balance.
+type BalanceCycle struct {
+	UserID         int64
+	BalanceType    string
+	LeaveType      string
+	CycleStart     time.Time
+	Allocated      float64
+	CarriedOver    float64
+	CarryExpiresOn *time.Time
+	CarryExpired   bool
+}
+
+// BalanceAccount is a wallet as the policy job sees it. Cycle is nil until the job
+// first runs for the wallet; UsedInCycle is what the requests took from the wallet
+// since the stored cycle started.
+type BalanceAccount struct {
+	UserID      int64
+	LeaveType   string
+	JoinedAt    time.Time
+	AnnualLimit float64
+	Total       float64
+	Remaining   float64
+	UsedInCycle float64
+	Cycle       *BalanceCycle
+}
+
+// BalancePlanEntry is a ledger entry a policy calls for
+type BalancePlanEntry struct {
+	EntryType string
+	Amount    float64
+	Reason    string
+}
+
+// BalancePlan is what a policy does to one wallet on one day: the ledger entries to
+// append, the total allocated for the cycle and the cycle state to keep
+type BalancePlan struct {
+	Entries []BalancePlanEntry
+	Total   float64
+	Cycle   BalanceCycle
+}
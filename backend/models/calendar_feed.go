@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// ICalEvent is an all-day event of an iCalendar (.ics) file, from Start to End inclusive
+type ICalEvent struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+}
+
+// ImportedHoliday is a holiday date read from an iCalendar file
+type ImportedHoliday struct {
+	Date        string `json:"date"`
+	Description string `json:"description"`
+}
+
+// HolidayImport reports an iCalendar holiday import into a calendar. Skipped counts the
+// events left out: cancelled ones and dates an earlier event of the file already took.
+// A preview never applies the holidays.
+type HolidayImport struct {
+	CalendarID int64             `json:"calendar_id"`
+	Preview    bool              `json:"preview"`
+	Applied    bool              `json:"applied"`
+	Holidays   []ImportedHoliday `json:"holidays"`
+	Skipped    int               `json:"skipped"`
+}
+
+// FeedToken lets calendar apps subscribe to a user's iCalendar feeds without signing in.
+// Only a hash of the token is stored; Token is filled in once, when it is created. A SELF
+// feed has the user's approved leave, a TEAM feed that of their direct reports as well.
+type FeedToken struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Scope      string     `json:"scope"`
+	Token      string     `json:"token,omitempty"`
+	TokenHash  string     `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	// the current role of the owner, read when the token is used
+	OwnerRole string `json:"-"`
+}
+
+// FeedLeave is an approved leave request as it appears in a leave feed
+type FeedLeave struct {
+	ID           int64
+	EmployeeID   int64
+	EmployeeName string
+	LeaveType    string
+	FromDate     time.Time
+	ToDate       time.Time
+	FromSession  string
+	ToSession    string
+	Hours        int
+	Days         float64
+}
@@ -0,0 +1,32 @@
+package models
+
+// DecisionTrace explains how the rule engine decided a request
+type DecisionTrace struct {
+	RequestType    string      `json:"request_type"`
+	EvaluationMode string      `json:"evaluation_mode,omitempty"`
+	Rules          []RuleTrace `json:"rules"`
+	RuleID         *int64      `json:"rule_id,omitempty"`
+	Action         string      `json:"action"`
+	Outcome        string      `json:"outcome"`
+	Reason         string      `json:"reason"`
+}
+
+// RuleTrace is the evaluation of one rule against the request
+type RuleTrace struct {
+	RuleID     int64            `json:"rule_id,omitempty"`
+	Priority   int              `json:"priority"`
+	Action     string           `json:"action"`
+	IsDefault  bool             `json:"is_default,omitempty"`
+	Matched    bool             `json:"matched"`
+	Error      string           `json:"error,omitempty"`
+	Conditions []ConditionTrace `json:"conditions,omitempty"`
+}
+
+// ConditionTrace compares the request's actual value with a rule threshold
+type ConditionTrace struct {
+	Field     string      `json:"field"`
+	Op        string      `json:"op"`
+	Actual    interface{} `json:"actual"`
+	Threshold interface{} `json:"threshold"`
+	Matched   bool        `json:"matched"`
+}
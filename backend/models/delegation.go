@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ApprovalDelegation lets DelegateID approve for DelegatorID between StartDate and EndDate
+// (open-ended when nil). OnLeaveOnly delegations apply only while the delegator is on approved leave.
+type ApprovalDelegation struct {
+	ID          int64      `json:"id"`
+	DelegatorID int64      `json:"delegator_id"`
+	DelegateID  int64      `json:"delegate_id"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	OnLeaveOnly bool       `json:"on_leave_only"`
+	Active      bool       `json:"active"`
+	// InEffect reports whether the delegation applies today
+	InEffect  bool      `json:"in_effect"`
+	CreatedBy int64     `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ApprovalActor is who an approver decides as: themselves, or the delegator they stand in for
+type ApprovalActor struct {
+	Role       string
+	UserID     int64
+	Delegation *ApprovalDelegation
+}
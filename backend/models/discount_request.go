@@ -9,6 +9,11 @@ type DiscountRequest struct {
 	Reason             string
 	Status             string
 	RuleID             *int64
+	DecisionAction     string
+	RoutedToRole       string
+	RoutedToUserID     *int64
+	ApprovalComment    string
+	DecisionTrace      *DecisionTrace
 	ApprovedByID       *int64
 	CreatedAt          time.Time
 }
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// SLAPolicy holds the working-day thresholds for pending requests of a type, optionally
+// for a single grade. A nil reminder or escalation threshold skips that step.
+type SLAPolicy struct {
+	ID                int64     `json:"id"`
+	RequestType       string    `json:"request_type"`
+	GradeID           *int64    `json:"grade_id,omitempty"`
+	ReminderAfterDays *int      `json:"reminder_after_days,omitempty"`
+	EscalateAfterDays *int      `json:"escalate_after_days,omitempty"`
+	RejectAfterDays   int       `json:"reject_after_days"`
+	Active            bool      `json:"active"`
+	UpdatedBy         *int64    `json:"updated_by,omitempty"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// RequestEscalation is one escalation step taken on a pending request
+type RequestEscalation struct {
+	ID                int64     `json:"id"`
+	RequestType       string    `json:"request_type"`
+	RequestID         int64     `json:"request_id"`
+	Step              string    `json:"step"`
+	WorkingDays       int       `json:"working_days"`
+	EscalatedToRole   string    `json:"escalated_to_role,omitempty"`
+	EscalatedToUserID *int64    `json:"escalated_to_user_id,omitempty"`
+	SLAPolicyID       *int64    `json:"sla_policy_id,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// EscalationCandidate is a pending request with what escalation needs to know about it
+type EscalationCandidate struct {
+	RequestID  int64
+	EmployeeID int64
+	GradeID    int64
+	CalendarID int64
+	CreatedAt  time.Time
+	// ApproverManagerID is the manager of the request's current approver, if any
+	ApproverManagerID *int64
+	Reminded          bool
+	Escalated         bool
+}
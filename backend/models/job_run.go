@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// JobRun is one run of a background job. TriggeredBy is set when an admin started the
+// run instead of the scheduler.
+type JobRun struct {
+	ID          int64          `json:"id"`
+	JobName     string         `json:"job_name"`
+	Status      string         `json:"status"`
+	StartedAt   time.Time      `json:"started_at"`
+	FinishedAt  *time.Time     `json:"finished_at,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	Counts      map[string]int `json:"counts"`
+	TriggeredBy *int64         `json:"triggered_by,omitempty"`
+	Instance    string         `json:"instance"`
+}
+
+// ScheduledJob is a background job registered with the scheduler. A paused job is
+// skipped on schedule but can still be triggered by hand.
+type ScheduledJob struct {
+	Name     string     `json:"name"`
+	Schedule string     `json:"schedule"`
+	Timezone string     `json:"timezone"`
+	Paused   bool       `json:"paused"`
+	NextRun  *time.Time `json:"next_run,omitempty"`
+	LastRun  *JobRun    `json:"last_run,omitempty"`
+}
@@ -3,14 +3,43 @@ package models
 import "time"
 
 type LeaveRequest struct {
-	ID           int64
-	EmployeeID   int64
-	FromDate     time.Time
-	ToDate       time.Time
-	LeaveType    string
-	Reason       string
-	Status       string
-	ApprovedByID *int64
-	RuleID       *int64
-	CreatedAt    time.Time
+	ID          int64
+	EmployeeID  int64
+	FromDate    time.Time
+	ToDate      time.Time
+	FromSession string
+	ToSession   string
+	Hours       int
+	Days        float64
+	CalendarID  int64
+	LeaveType   string
+	// BalanceLeaveType is the leave type whose wallet the request draws from
+	BalanceLeaveType string
+	BalanceExempt    bool
+	Reason           string
+	Status           string
+	ApprovedByID     *int64
+	RuleID           *int64
+	DecisionAction   string
+	RoutedToRole     string
+	RoutedToUserID   *int64
+	ApprovalComment  string
+	DecisionTrace    *DecisionTrace
+	CreatedAt        time.Time
+}
+
+// LeavePeriod is the time a leave request takes off. FromSession and ToSession take the
+// second half of the first date or the first half of the last date; a single date takes
+// one session (both are the same) or Hours of a working day. Days is the leave it
+// costs, in days: once charged on a work calendar, Excluded lists the dates it does
+// not cost and Sandwiched the weekend dates the sandwich rule charged anyway.
+type LeavePeriod struct {
+	FromDate    time.Time
+	ToDate      time.Time
+	FromSession string
+	ToSession   string
+	Hours       int
+	Days        float64
+	Excluded    []ExcludedLeaveDay
+	Sandwiched  []string
 }
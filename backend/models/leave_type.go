@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// LeaveType is an entry of the leave type catalog. Each type has its own wallet per
+// user, allocated per grade, unless it bypasses the balance: requests for those types
+// are never checked against or deducted from a wallet. Under the sandwich rule the
+// weekends between the leave days of a request are charged as leave.
+type LeaveType struct {
+	Code          string                `json:"code"`
+	Name          string                `json:"name"`
+	BypassBalance bool                  `json:"bypass_balance"`
+	SandwichRule  bool                  `json:"sandwich_rule"`
+	Active        bool                  `json:"active"`
+	Allocations   []LeaveTypeAllocation `json:"allocations"`
+	UpdatedBy     *int64                `json:"updated_by,omitempty"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+}
+
+// LeaveTypeAllocation is the days a full cycle allocates to the wallet of a leave type
+// for users of one grade
+type LeaveTypeAllocation struct {
+	GradeID    int64 `json:"grade_id"`
+	AnnualDays int   `json:"annual_days"`
+}
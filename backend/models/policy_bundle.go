@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+// PolicyBundle is the portable form of the whole rule set of an environment.
+// Grades and users are referenced by name and email so a bundle can be moved
+// between databases whose ids differ.
+type PolicyBundle struct {
+	Version         int                    `json:"version"`
+	ExportedAt      time.Time              `json:"exported_at"`
+	Grades          []BundleGrade          `json:"grades"`
+	Holidays        []BundleHoliday        `json:"holidays"`
+	EvaluationModes []BundleEvaluationMode `json:"evaluation_modes"`
+	Rules           []BundleRule           `json:"rules"`
+}
+
+type BundleGrade struct {
+	Name                 string  `json:"name"`
+	AnnualLeaveLimit     int     `json:"annual_leave_limit"`
+	AnnualExpenseLimit   float64 `json:"annual_expense_limit"`
+	DiscountLimitPercent float64 `json:"discount_limit_percent"`
+}
+
+type BundleHoliday struct {
+	Date        string `json:"date"`
+	Description string `json:"description"`
+}
+
+type BundleEvaluationMode struct {
+	RequestType string `json:"request_type"`
+	Grade       string `json:"grade"`
+	Mode        string `json:"mode"`
+}
+
+type BundleRule struct {
+	RequestType      string                 `json:"request_type"`
+	Grade            string                 `json:"grade"`
+	Priority         int                    `json:"priority"`
+	IsDefault        bool                   `json:"is_default"`
+	Active           bool                   `json:"active"`
+	Action           string                 `json:"action"`
+	Condition        map[string]interface{} `json:"condition"`
+	Message          string                 `json:"message,omitempty"`
+	RouteToRole      string                 `json:"route_to_role,omitempty"`
+	RouteToUserEmail string                 `json:"route_to_user_email,omitempty"`
+}
+
+// BundleDiff lists what an import changes; unchanged entries are left out
+type BundleDiff struct {
+	Applied         bool           `json:"applied"`
+	Grades          []BundleChange `json:"grades"`
+	Holidays        []BundleChange `json:"holidays"`
+	EvaluationModes []BundleChange `json:"evaluation_modes"`
+	Rules           []BundleChange `json:"rules"`
+}
+
+type BundleChange struct {
+	Change string      `json:"change"`
+	Key    string      `json:"key"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
@@ -6,3 +6,11 @@ type RequestTypeReport struct {
 	AutoApproved        int     `json:"auto_approved"`
 	AutoApprovedPercent float64 `json:"auto_approved_percentage"`
 }
+
+// CalendarLeaveReport is the approved leave charged on a work calendar
+type CalendarLeaveReport struct {
+	CalendarID int64   `json:"calendar_id"`
+	Calendar   string  `json:"calendar"`
+	Requests   int     `json:"requests"`
+	Days       float64 `json:"days"`
+}
@@ -1,5 +1,9 @@
 package models
 
+import "time"
+
+// Rule is one immutable version of a rule. Versions of the same rule share RuleKey;
+// a version decides requests between EffectiveFrom and EffectiveTo (open-ended when nil).
 type Rule struct {
 	ID          int64                  `json:"id"`
 	RequestType string                 `json:"request_type"`
@@ -7,4 +11,31 @@ type Rule struct {
 	Action      string                 `json:"action"`
 	GradeID     int64                  `json:"grade_id"`
 	Active      bool                   `json:"active"`
+	Priority    int                    `json:"priority"`
+	IsDefault   bool                   `json:"is_default"`
+	// Message is the policy message returned when the rule auto-rejects a request
+	Message string `json:"message,omitempty"`
+	// RouteToRole / RouteToUserID name the approver for ROUTE_TO rules
+	RouteToRole   string `json:"route_to_role,omitempty"`
+	RouteToUserID *int64 `json:"route_to_user_id,omitempty"`
+
+	RuleKey       int64      `json:"rule_key"`
+	Version       int        `json:"version"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty"`
+}
+
+// RuleSet is the ordered list of active rules for one request type and grade
+type RuleSet struct {
+	RequestType    string `json:"request_type"`
+	GradeID        int64  `json:"grade_id"`
+	EvaluationMode string `json:"evaluation_mode"`
+	Rules          []Rule `json:"rules"`
+}
+
+// EvaluationMode is the configured evaluation mode of one request type and grade
+type EvaluationMode struct {
+	RequestType string `json:"request_type"`
+	GradeID     int64  `json:"grade_id"`
+	Mode        string `json:"mode"`
 }
@@ -0,0 +1,18 @@
+package models
+
+// RuleIssue is one coverage gap or conflict found in the configured rules
+type RuleIssue struct {
+	Kind        string  `json:"kind"`
+	Severity    string  `json:"severity"`
+	RequestType string  `json:"request_type"`
+	GradeID     int64   `json:"grade_id"`
+	RuleIDs     []int64 `json:"rule_ids,omitempty"`
+	Message     string  `json:"message"`
+}
+
+// RuleAnalysis is the result of checking every grade and request type
+type RuleAnalysis struct {
+	Errors   int         `json:"errors"`
+	Warnings int         `json:"warnings"`
+	Issues   []RuleIssue `json:"issues"`
+}
@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// RuleSimulation is a draft rule set replayed against a hypothetical request
+// or the historical requests created in [From, To]
+type RuleSimulation struct {
+	RequestType    string               `json:"request_type"`
+	GradeID        int64                `json:"grade_id"`
+	EvaluationMode string               `json:"evaluation_mode"`
+	Rules          []Rule               `json:"rules"`
+	Request        *HypotheticalRequest `json:"request,omitempty"`
+	From           string               `json:"from,omitempty"`
+	To             string               `json:"to,omitempty"`
+}
+
+// HypotheticalRequest describes a request that was never filed.
+// When UserID is set the requester's role and tenure come from that user.
+type HypotheticalRequest struct {
+	UserID     int64   `json:"user_id,omitempty"`
+	Role       string  `json:"role,omitempty"`
+	TenureDays int     `json:"tenure_days,omitempty"`
+	Date       string  `json:"date,omitempty"`
+	Days       float64 `json:"days,omitempty"`
+	LeaveType  string  `json:"leave_type,omitempty"`
+	Amount     float64 `json:"amount,omitempty"`
+	Category   string  `json:"category,omitempty"`
+	Percent    float64 `json:"percent,omitempty"`
+}
+
+// SimulationSample is a stored request with the requester details needed to replay it
+type SimulationSample struct {
+	RequestID     int64
+	Status        string
+	CreatedAt     time.Time
+	FromDate      time.Time
+	ToDate        time.Time
+	Days          float64
+	LeaveType     string
+	Amount        float64
+	Category      string
+	Percent       float64
+	Role          string
+	GradeID       int64
+	UserCreatedAt time.Time
+}
+
+// SimulationResult compares the live and draft decision for one request
+type SimulationResult struct {
+	RequestID       *int64         `json:"request_id,omitempty"`
+	CurrentStatus   string         `json:"current_status"`
+	SimulatedStatus string         `json:"simulated_status"`
+	Changed         bool           `json:"changed"`
+	Trace           *DecisionTrace `json:"trace"`
+}
+
+// SimulationReport summarises how a draft rule set would change decisions
+type SimulationReport struct {
+	Evaluated             int                `json:"evaluated"`
+	Changed               int                `json:"changed"`
+	AutoApprovedToPending int                `json:"auto_approved_to_pending"`
+	PendingToAutoApproved int                `json:"pending_to_auto_approved"`
+	Transitions           map[string]int     `json:"transitions"`
+	Results               []SimulationResult `json:"results"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Calendar is a named work calendar: the working week and holidays of an office. Users
+// without a calendar of their own follow the default one.
+type Calendar struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	IsDefault   bool      `json:"is_default"`
+	WorkingDays []string  `json:"working_days"`
+	Users       int       `json:"users"`
+	UpdatedBy   *int64    `json:"updated_by,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WorkCalendar is the working week and the holidays of a calendar that leave and SLAs
+// are counted against
+type WorkCalendar struct {
+	CalendarID  int64
+	WorkingDays []time.Weekday
+	// description of each holiday, keyed by its YYYY-MM-DD date
+	Holidays map[string]string
+}
+
+// ExcludedLeaveDay is a date of a leave request that is not charged: a WEEKEND (a day
+// off in the working week) or a HOLIDAY
+type ExcludedLeaveDay struct {
+	Date        string `json:"date"`
+	Reason      string `json:"reason"`
+	Description string `json:"description,omitempty"`
+}
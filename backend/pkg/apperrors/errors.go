@@ -15,6 +15,11 @@ var (
 	ErrLeaveRequestNotFound    = errors.New("leave request not found")
 	ErrLeaveVerificationFailed = errors.New("unable to verify existing leave requests")
 	ErrLeaveCannotCancel       = errors.New("cannot cancel finalized leave request")
+	ErrInvalidLeaveSession     = errors.New(
+		"from_session must be FULL or SECOND_HALF and to_session FULL or FIRST_HALF; a single day takes one session",
+	)
+	ErrInvalidLeaveHours = errors.New("hours must be whole hours shorter than a working day, on a single full day")
+	ErrNoWorkingDays     = errors.New("the leave covers no working days")
 )
 
 // --- Expense-related errors ---
@@ -49,6 +54,7 @@ var (
 	ErrManagerNeedsAdmin         = errors.New("managers can only be approved by admin")
 	ErrAdminRequestNotAllowed    = errors.New("admin requests are not allowed")
 	ErrUnauthorizedApproval      = errors.New("unauthorized approval attempt")
+	ErrNotRoutedApprover         = errors.New("request is routed to a different approver")
 	ErrRequestCannotCancel       = errors.New("cannot cancel finalized request")
 	ErrCommentRequired           = errors.New("comment is required")
 )
@@ -101,6 +107,7 @@ var (
 var (
 	ErrBalanceUpdateFailed = errors.New("failed to update balance")
 	ErrBalanceFetchFailed  = errors.New("failed to fetch balance")
+	ErrReservationNotHeld  = errors.New("balance does not hold the reservation being released")
 )
 
 // --- State / consistency errors ---
@@ -123,6 +130,119 @@ var (
 	ErrConditionRequired     = errors.New("condition is required")
 	ErrInvalidConditionJSON  = errors.New("invalid condition JSON")
 	ErrRuleNotFoundForDelete = errors.New("rule not found")
+	ErrInvalidRuleAction     = errors.New("action must be AUTO_APPROVE, MANUAL, AUTO_REJECT or ROUTE_TO")
+	ErrRouteTargetRequired   = errors.New("ROUTE_TO rules need exactly one of route_to_role or route_to_user_id")
+	ErrRouteTargetNotAllowed = errors.New("route_to_role and route_to_user_id are only allowed on ROUTE_TO rules")
+	ErrInvalidRouteTarget    = errors.New("requests can only be routed to a manager or admin")
+	ErrSimulationRules       = errors.New("draft rule set must contain at least one rule")
+	ErrSimulationSource      = errors.New("provide either a hypothetical request or a from/to date range")
+	ErrInvalidEffectiveDate  = errors.New("effective_from cannot be in the past or before the current version")
+	ErrRuleVersionNotFound   = errors.New("rule version not found")
+)
+
+// --- Policy bundle errors ---
+var (
+	ErrUnsupportedBundleVersion = errors.New("unsupported policy bundle version")
+	ErrInvalidBundleFormat      = errors.New("format must be json or yaml")
+	ErrGradeNameRequired        = errors.New("grade name is required")
+	ErrUnknownBundleGrade       = errors.New("bundle references a grade that is neither in the bundle nor in the database")
+	ErrDuplicateBundleEntry     = errors.New("bundle lists the same grade, holiday or evaluation mode more than once")
+)
+
+// --- Approval chain errors ---
+var (
+	ErrApprovalChainNotFound  = errors.New("approval chain not found")
+	ErrChainNameRequired      = errors.New("approval chain name is required")
+	ErrChainStepsRequired     = errors.New("approval chain needs at least one step")
+	ErrInvalidApproverType    = errors.New("approver_type must be REPORTING_MANAGER, SKIP_LEVEL_MANAGER, ROLE or USER")
+	ErrApproverTargetRequired = errors.New("ROLE steps need approver_role and USER steps need approver_user_id, other steps take neither")
+	ErrInvalidAmountBand      = errors.New("max_amount must be greater than min_amount")
+	ErrApprovalChainOverlap   = errors.New("an active approval chain already covers part of this amount band")
+	ErrStepApproverRepeated   = errors.New("an approver cannot approve more than one step of the same request")
+)
+
+// --- Delegation errors ---
+var (
+	ErrDelegationNotFound   = errors.New("delegation not found")
+	ErrSelfDelegation       = errors.New("approval authority cannot be delegated to yourself")
+	ErrInvalidDelegate      = errors.New("approval authority can only be delegated to a manager or admin")
+	ErrDelegatorNotApprover = errors.New("only managers and admins can delegate approval authority")
+	ErrDelegationEnded      = errors.New("delegation cannot end in the past")
+)
+
+// --- SLA and escalation errors ---
+var (
+	ErrSLAPolicyNotFound      = errors.New("SLA policy not found")
+	ErrSLAPolicyExists        = errors.New("an active SLA policy already covers this request type and grade")
+	ErrRejectDeadlineRequired = errors.New("reject_after_days must be greater than zero")
+	ErrInvalidEscalationOrder = errors.New("escalation thresholds must increase: reminder, then escalation, then rejection")
+)
+
+// --- Leave type errors ---
+var (
+	ErrLeaveTypeNotFound      = errors.New("leave type not found")
+	ErrLeaveTypeRequired      = errors.New("leave_type is required")
+	ErrInvalidLeaveTypeCode   = errors.New("code must be 1 to 20 letters, digits or underscores")
+	ErrLeaveTypeNameRequired  = errors.New("leave type name is required")
+	ErrInvalidLeaveAllocation = errors.New("each grade can be allocated once and annual_days must not be negative")
+)
+
+// --- Balance adjustment errors ---
+var (
+	ErrAdjustmentReasonRequired = errors.New("a reason is required for balance adjustments")
+	ErrInvalidAdjustmentAmount  = errors.New("adjustment amount must be non-zero, in whole hours for leave")
+	ErrAdjustmentExceedsBalance = errors.New("debit exceeds the available balance")
+	ErrInvalidAdjustmentFile    = errors.New("balance adjustment file has invalid rows")
+	ErrInvalidAdjustmentHeader  = errors.New("balance adjustment file needs user_id, balance_type, amount and reason columns")
+)
+
+// --- Balance policy errors ---
+var (
+	ErrBalancePolicyNotFound = errors.New("balance policy not found")
+	ErrInvalidResetCycle     = errors.New("reset_cycle must be CALENDAR or FISCAL")
+	ErrInvalidFiscalStart    = errors.New("fiscal_start_month must be between 1 and 12")
+	ErrInvalidAccrual        = errors.New("accrual must be ANNUAL or MONTHLY")
+	ErrInvalidCarryForward   = errors.New("carry_forward_cap must not be negative and carry_forward_expiry_months must be between 1 and 12")
+)
+
+// --- Work calendar errors ---
+var (
+	ErrInvalidWorkWeek       = errors.New("working_days must name each working weekday once, at least one")
+	ErrCalendarNotFound      = errors.New("calendar not found")
+	ErrCalendarNameRequired  = errors.New("calendar name is required")
+	ErrCalendarExists        = errors.New("a calendar with this name already exists")
+	ErrDefaultCalendarDelete = errors.New("the default calendar cannot be deleted")
+	ErrCalendarUsersRequired = errors.New("user_ids must list at least one user")
+)
+
+// --- Calendar feed errors ---
+var (
+	ErrInvalidICalFile    = errors.New("file is not an iCalendar file with dated events")
+	ErrICalEventTooLong   = errors.New("an event of the file spans more than 31 days")
+	ErrInvalidFeedScope   = errors.New("scope must be SELF or TEAM")
+	ErrTeamFeedNotAllowed = errors.New("only managers and admins can subscribe to a team feed")
+	ErrFeedTokenNotFound  = errors.New("feed token not found or revoked")
+)
+
+// --- Background job errors ---
+var (
+	ErrJobAlreadyRunning  = errors.New("job is already running")
+	ErrJobNotFound        = errors.New("job not found")
+	ErrJobExists          = errors.New("a job with this name is already registered")
+	ErrInvalidJobSchedule = errors.New("invalid cron schedule")
+	ErrInvalidTimezone    = errors.New("invalid timezone")
+)
+
+// --- Rule condition errors ---
+var (
+	ErrInvalidRequestType           = errors.New("request_type must be LEAVE, EXPENSE or DISCOUNT")
+	ErrInvalidCondition             = errors.New("malformed condition: each node must be all, any, not or a field comparison")
+	ErrUnknownConditionField        = errors.New("condition references a field not available for this request type")
+	ErrUnsupportedConditionOperator = errors.New("condition uses an operator not supported for this field")
+	ErrConditionTypeMismatch        = errors.New("condition value does not match the field type")
+	ErrInvalidRuleMode              = errors.New("evaluation mode must be FIRST_MATCH or ALL_MATCH")
+	ErrInvalidRulePriority          = errors.New("priority must not be negative")
+	ErrDefaultRuleExists            = errors.New("an active default rule already exists for this request type and grade")
 )
 
 // --- Shared / Generic errors ---
@@ -2,38 +2,23 @@ package utils
 
 import (
 	"context"
-	"fmt"
+
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 
 	"github.com/jackc/pgx/v5"
 )
 
 type DecisionResult struct {
-	Status  string
-	Message string
-}
-
-func MakeDecision(
-	requestType string,
-	condition map[string]interface{},
-	value float64,
-) DecisionResult {
-
-	decision := Decide(requestType, condition, value)
-	fmt.Println("DEBUG Decide returned:", decision)
-
-	if decision == constants.StatusAutoApprove {
-		return DecisionResult{
-			Status:  constants.StatusAutoApproved,
-			Message: requestType + " approved by system",
-		}
-	}
-
-	return DecisionResult{
-		Status:  constants.StatusPending,
-		Message: requestType + " submitted for approval",
-	}
+	Status         string
+	Message        string
+	Action         string
+	RuleID         *int64
+	MatchedRuleIDs []int64
+	RouteToRole    string
+	RouteToUserID  *int64
+	Trace          *models.DecisionTrace
 }
 
 func CanCancel(status string) error {
@@ -57,51 +42,3 @@ func FetchUserGrade(ctx context.Context, tx pgx.Tx, userID int64) (int64, error)
 	}
 	return gradeID, err
 }
-func Decide(
-	requestType string,
-	rule map[string]interface{},
-	value float64,
-) string {
-
-	switch requestType {
-	case "LEAVE":
-		if EvaluateLeaveRule(rule, int(value)) {
-			return constants.StatusAutoApprove
-		}
-	case "EXPENSE":
-		if EvaluateExpenseRule(rule, value) {
-			return constants.StatusAutoApprove
-		}
-	case "DISCOUNT":
-		if EvaluateDiscountRule(rule, value) {
-			return constants.StatusAutoApprove
-		}
-	}
-
-	return "MANUAL"
-}
-
-func EvaluateLeaveRule(rule map[string]interface{}, days int) bool {
-	maxDays, ok := rule["max_days"].(float64)
-	fmt.Println("EvaluateLeaveRule ", ok)
-	if !ok {
-		return false
-	}
-	return days <= int(maxDays)
-}
-
-func EvaluateExpenseRule(rule map[string]interface{}, amount float64) bool {
-	maxAmount, ok := rule["max_amount"].(float64)
-	if !ok {
-		return false
-	}
-	return amount <= maxAmount
-}
-
-func EvaluateDiscountRule(rule map[string]interface{}, percent float64) bool {
-	maxPercent, ok := rule["max_percent"].(float64)
-	if !ok {
-		return false
-	}
-	return percent <= maxPercent
-}
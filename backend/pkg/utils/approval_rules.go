@@ -45,3 +45,32 @@ func ValidateApproverRole(approverRole, requesterRole string) error {
 
 	return apperrors.ErrUnauthorizedApproval
 }
+
+// ValidateRequestApprover authorizes an approver for a request. A request routed by a
+// ROUTE_TO rule can only be decided by its routed role or user (or an admin); any other
+// request falls back to the requester-role hierarchy.
+func ValidateRequestApprover(approverRole string, approverID int64, requesterRole, routedToRole string, routedToUserID *int64) error {
+	if routedToRole == "" && routedToUserID == nil {
+		return ValidateApproverRole(approverRole, requesterRole)
+	}
+
+	if approverRole == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	if approverRole == constants.RoleAdmin {
+		return nil
+	}
+
+	if routedToUserID != nil && *routedToUserID == approverID {
+		return nil
+	}
+
+	// routing to a role does not let a manager decide the request of a fellow manager,
+	// which still needs an admin
+	if routedToRole != "" && routedToRole == approverRole {
+		return ValidateApproverRole(approverRole, requesterRole)
+	}
+
+	return apperrors.ErrNotRoutedApprover
+}
@@ -0,0 +1,20 @@
+package utils
+
+import "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+// AutoRejectCounts totals the requests of an auto-reject run across request types, as
+// the run history records them
+func AutoRejectCounts(report *models.AutoRejectReport) map[string]int {
+	counts := map[string]int{}
+	if report == nil {
+		return counts
+	}
+
+	for _, summary := range report.Summaries {
+		counts["reminded"] += len(summary.Reminded)
+		counts["escalated"] += len(summary.Escalated)
+		counts["auto_rejected"] += len(summary.AutoRejected)
+	}
+
+	return counts
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+var balanceAdjustmentColumns = []string{"user_id", "balance_type", "amount", "reason"}
+
+// BalanceAdjustmentRow is one data row of a bulk adjustment file. Err is set when the
+// row could not be read; Row counts from the header, which is row 1.
+type BalanceAdjustmentRow struct {
+	Row        int
+	Adjustment models.BalanceAdjustment
+	Err        error
+}
+
+// ParseBalanceAdjustments reads a CSV bulk adjustment file. The header names the
+// user_id, balance_type, amount and reason columns in any order, and a leave_type column
+// when the file adjusts leave balances; other columns are ignored. Blank lines are skipped.
+func ParseBalanceAdjustments(file io.Reader) ([]BalanceAdjustmentRow, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, apperrors.ErrInvalidAdjustmentHeader
+	}
+
+	index := map[string]int{}
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range balanceAdjustmentColumns {
+		if _, ok := index[column]; !ok {
+			return nil, apperrors.ErrInvalidAdjustmentHeader
+		}
+	}
+
+	var rows []BalanceAdjustmentRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		// FieldPos only knows the fields of a record that was read
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, BalanceAdjustmentRow{Row: parseErr.StartLine, Err: apperrors.ErrInvalidInput})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := BalanceAdjustmentRow{Row: line}
+
+		field := func(column string) string {
+			if i, ok := index[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row.Adjustment = models.BalanceAdjustment{
+			BalanceType: strings.ToUpper(field("balance_type")),
+			LeaveType:   strings.ToUpper(field("leave_type")),
+			Reason:      field("reason"),
+		}
+
+		userID, err := strconv.ParseInt(field("user_id"), 10, 64)
+		if err != nil {
+			row.Err = apperrors.ErrInvalidUser
+		}
+		row.Adjustment.UserID = userID
+
+		amount, err := strconv.ParseFloat(field("amount"), 64)
+		if err != nil && row.Err == nil {
+			row.Err = apperrors.ErrInvalidAdjustmentAmount
+		}
+		row.Adjustment.Amount = amount
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// BalanceCycleStart returns the first day of the policy cycle that contains day
+func BalanceCycleStart(policy models.BalancePolicy, day time.Time) time.Time {
+	startMonth := time.January
+	if policy.ResetCycle == constants.ResetFiscal {
+		startMonth = time.Month(policy.FiscalStartMonth)
+	}
+
+	year := day.Year()
+	if day.Month() < startMonth {
+		year--
+	}
+
+	return time.Date(year, startMonth, 1, 0, 0, 0, 0, day.Location())
+}
+
+// PlanBalancePolicy works out what a policy does to one wallet today: the reset and
+// carry-forward when a new cycle started, the allocation or monthly accrual of the
+// cycle, and the expiry of carried-over balance left unused. It returns nil when the
+// wallet is already up to date.
+//
+// The first time a wallet is planned its current total is taken as the allocation of
+// the cycle, except for users who joined during the cycle, whose allocation is
+// pro-rated when the policy asks for it.
+func PlanBalancePolicy(policy models.BalancePolicy, account models.BalanceAccount, today time.Time) *models.BalancePlan {
+	cycleStart := BalanceCycleStart(policy, today)
+	unit := balanceUnit(policy.BalanceType)
+	entitled := balanceEntitlement(policy, account, cycleStart, today, unit)
+
+	plan := &models.BalancePlan{}
+	remaining := account.Remaining
+	changed := false
+
+	var cycle models.BalanceCycle
+	switch {
+	case account.Cycle == nil:
+		changed = true
+		cycle = models.BalanceCycle{
+			UserID:      account.UserID,
+			BalanceType: policy.BalanceType,
+			LeaveType:   account.LeaveType,
+			CycleStart:  cycleStart,
+			Allocated:   account.Total,
+		}
+
+		if !account.JoinedAt.Before(cycleStart) && entitled != account.Total {
+			// never take back balance that was already used
+			delta := math.Max(entitled-account.Total, -remaining)
+			plan.Entries = append(plan.Entries, models.BalancePlanEntry{
+				EntryType: constants.LedgerAllocation,
+				Amount:    delta,
+				Reason:    fmt.Sprintf("Pro-rated allocation for joining on %s", account.JoinedAt.Format("2006-01-02")),
+			})
+			cycle.Allocated += delta
+			remaining += delta
+		}
+
+	case account.Cycle.CycleStart.Before(cycleStart):
+		changed = true
+		carry := math.Max(remaining, 0)
+		if policy.CarryForwardCap != nil {
+			carry = math.Min(carry, *policy.CarryForwardCap)
+		}
+		carry = roundDown(carry, unit)
+
+		plan.Entries = append(plan.Entries, models.BalancePlanEntry{
+			EntryType: constants.LedgerReset,
+			Amount:    carry - remaining,
+			Reason: fmt.Sprintf("Reset for the cycle starting %s, %s carried forward",
+				cycleStart.Format("2006-01-02"), formatBalance(carry)),
+		})
+		remaining = carry
+
+		cycle = models.BalanceCycle{
+			UserID:      account.UserID,
+			BalanceType: policy.BalanceType,
+			LeaveType:   account.LeaveType,
+			CycleStart:  cycleStart,
+			CarriedOver: carry,
+		}
+		if policy.CarryForwardExpiryMonths != nil && carry > 0 {
+			expiresOn := cycleStart.AddDate(0, *policy.CarryForwardExpiryMonths, 0)
+			cycle.CarryExpiresOn = &expiresOn
+		}
+		// nothing was used in the new cycle yet
+		account.UsedInCycle = 0
+
+	default:
+		cycle = *account.Cycle
+	}
+
+	// grant what the cycle allocates so far; a lower grade limit does not take back
+	// balance already granted
+	if grant := entitled - cycle.Allocated; grant > 0 {
+		entryType := constants.LedgerAllocation
+		reason := fmt.Sprintf("Allocation for the cycle starting %s", cycleStart.Format("2006-01-02"))
+		if policy.Accrual == constants.AccrualMonthly {
+			entryType = constants.LedgerAccrual
+			reason = fmt.Sprintf("Monthly accrual for %s", today.Format("January 2006"))
+		}
+
+		plan.Entries = append(plan.Entries, models.BalancePlanEntry{EntryType: entryType, Amount: grant, Reason: reason})
+		cycle.Allocated += grant
+		remaining += grant
+		changed = true
+	}
+
+	// carried-over balance is used before the balance of the cycle, so only what is
+	// left of it expires
+	if cycle.CarryExpiresOn != nil && !cycle.CarryExpired && !today.Before(*cycle.CarryExpiresOn) {
+		unused := math.Min(math.Max(cycle.CarriedOver-account.UsedInCycle, 0), math.Max(remaining, 0))
+		if unused > 0 {
+			plan.Entries = append(plan.Entries, models.BalancePlanEntry{
+				EntryType: constants.LedgerExpiry,
+				Amount:    -unused,
+				Reason:    fmt.Sprintf("Carried-over balance expired on %s", cycle.CarryExpiresOn.Format("2006-01-02")),
+			})
+			cycle.CarriedOver -= unused
+		}
+		cycle.CarryExpired = true
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	plan.Cycle = cycle
+	plan.Total = cycle.Allocated + cycle.CarriedOver
+	return plan
+}
+
+// balanceEntitlement is what the grade limit allocates for the cycle up to today:
+// the whole limit for annual allocation, or a twelfth of it for every month started
+// for monthly accrual. Users who joined during the cycle are entitled from the month
+// they joined when the policy pro-rates joiners.
+func balanceEntitlement(policy models.BalancePolicy, account models.BalanceAccount, cycleStart, today time.Time, unit float64) float64 {
+	eligibleFrom := cycleStart
+	if policy.ProrateJoiners && account.JoinedAt.After(cycleStart) {
+		eligibleFrom = time.Date(account.JoinedAt.Year(), account.JoinedAt.Month(), 1, 0, 0, 0, 0, cycleStart.Location())
+	}
+
+	months := 12 - monthsBetween(cycleStart, eligibleFrom)
+	if policy.Accrual == constants.AccrualMonthly {
+		months = min(monthsBetween(eligibleFrom, today)+1, months)
+	}
+	if months <= 0 {
+		return 0
+	}
+
+	return roundDown(account.AnnualLimit*float64(months)/12, unit)
+}
+
+// balanceUnit is the smallest amount a balance type is allocated in: half days of
+// leave, cents of expense and hundredths of a discount percent
+func balanceUnit(balanceType string) float64 {
+	if balanceType == "LEAVE" {
+		return 0.5
+	}
+	return 0.01
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
+
+func roundDown(value, unit float64) float64 {
+	// the epsilon keeps values like 0.29/0.01 from landing just under a whole unit
+	return math.Floor(value/unit+1e-9) * unit
+}
+
+func formatBalance(value float64) string {
+	return fmt.Sprintf("%g", math.Round(value*100)/100)
+}
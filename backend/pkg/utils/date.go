@@ -1,8 +1,99 @@
 package utils
 
-import "time"
+import (
+	"math"
+	"strings"
+	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+// CalculateLeaveDays counts the dates from from to to, both included
 func CalculateLeaveDays(from, to time.Time) int {
 	days := int(to.Sub(from).Hours()/24) + 1
 	return days
 }
+
+// NewLeavePeriod checks the sessions or hours of a leave and works out the days it
+// costs. An empty session is FULL. A leave over several dates can start at the
+// SECOND_HALF of the first one and end at the FIRST_HALF of the last one, each taking
+// half a day off; a single date takes one session, or hours of a working day.
+func NewLeavePeriod(from, to time.Time, fromSession, toSession string, hours int) (models.LeavePeriod, error) {
+	dates := CalculateLeaveDays(from, to)
+	if dates <= 0 {
+		return models.LeavePeriod{}, apperrors.ErrInvalidLeaveDays
+	}
+
+	fromSession = normalizeSession(fromSession)
+	toSession = normalizeSession(toSession)
+	if !isSession(fromSession) || !isSession(toSession) {
+		return models.LeavePeriod{}, apperrors.ErrInvalidLeaveSession
+	}
+
+	period := models.LeavePeriod{FromDate: from, ToDate: to}
+
+	switch {
+	case hours != 0:
+		if dates != 1 || fromSession != constants.SessionFull || toSession != constants.SessionFull ||
+			hours < 0 || hours >= constants.LeaveHoursPerDay {
+			return models.LeavePeriod{}, apperrors.ErrInvalidLeaveHours
+		}
+		period.FromSession, period.ToSession = constants.SessionFull, constants.SessionFull
+		period.Hours = hours
+		period.Days = float64(hours) / constants.LeaveHoursPerDay
+
+	case dates == 1:
+		// the session given, whichever side it was given on
+		session := fromSession
+		if session == constants.SessionFull {
+			session = toSession
+		} else if toSession != constants.SessionFull && toSession != session {
+			return models.LeavePeriod{}, apperrors.ErrInvalidLeaveSession
+		}
+		period.FromSession, period.ToSession = session, session
+		period.Days = 1
+		if session != constants.SessionFull {
+			period.Days = 0.5
+		}
+
+	default:
+		if fromSession == constants.SessionFirstHalf || toSession == constants.SessionSecondHalf {
+			return models.LeavePeriod{}, apperrors.ErrInvalidLeaveSession
+		}
+		period.FromSession, period.ToSession = fromSession, toSession
+		period.Days = float64(dates)
+		if fromSession == constants.SessionSecondHalf {
+			period.Days -= 0.5
+		}
+		if toSession == constants.SessionFirstHalf {
+			period.Days -= 0.5
+		}
+	}
+
+	return period, nil
+}
+
+// IsWholeLeaveHours reports whether days of leave come to whole hours of a working day,
+// the smallest amount leave is taken in
+func IsWholeLeaveHours(days float64) bool {
+	hours := days * constants.LeaveHoursPerDay
+	return math.Abs(hours-math.Round(hours)) < 1e-9
+}
+
+func normalizeSession(session string) string {
+	session = strings.ToUpper(strings.TrimSpace(session))
+	if session == "" {
+		return constants.SessionFull
+	}
+	return session
+}
+
+func isSession(session string) bool {
+	switch session {
+	case constants.SessionFull, constants.SessionFirstHalf, constants.SessionSecondHalf:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// GenerateFeedToken returns a random calendar feed token and the hash it is stored and
+// looked up by
+func GenerateFeedToken() (string, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+
+	token := hex.EncodeToString(raw)
+	return token, HashFeedToken(token), nil
+}
+
+// HashFeedToken hashes a feed token. Tokens are random, so a plain SHA-256 without salt
+// is enough to keep them out of the database.
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,295 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+const (
+	icalProductID = "-//Rule Based Approval Engine//EN"
+	icalUIDDomain = "rule-based-approval-engine"
+	// lines are folded at 75 octets, the continuation lines starting with a space
+	icalLineLimit = 75
+	// longest event taken, in days; a longer one is more likely a mistake than a holiday
+	icalMaxEventDays = 31
+)
+
+var icalDuration = regexp.MustCompile(`^P(\d+)([DW])$`)
+
+var icalUnescaper = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// ParseICalEvents reads the events of an iCalendar (.ics) file as all-day events. A
+// timed event covers the dates it touches, an event without an end its start date only.
+// Cancelled events are left out, and a recurring event gives its first occurrence. An
+// event spanning more than 31 days fails the whole file.
+func ParseICalEvents(file io.Reader) ([]models.ICalEvent, error) {
+	lines, err := unfoldICalLines(file)
+	if err != nil || len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, apperrors.ErrInvalidICalFile
+	}
+
+	events := []models.ICalEvent{}
+	// values of the properties of the event being read, keyed by name
+	var props map[string]string
+	// nesting of the components (alarms) inside the event being read; -1 outside events
+	depth := -1
+
+	for _, line := range lines {
+		name, value, ok := splitICalLine(line)
+		if !ok {
+			return nil, apperrors.ErrInvalidICalFile
+		}
+
+		switch {
+		case depth < 0 && name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			depth = 0
+			props = map[string]string{}
+		case depth < 0:
+			continue
+		case name == "BEGIN":
+			depth++
+		case name == "END" && depth > 0:
+			depth--
+		case name == "END":
+			depth = -1
+			event, keep, err := icalEvent(props)
+			if err != nil {
+				return nil, err
+			}
+			if keep {
+				events = append(events, event)
+			}
+		case depth == 0:
+			// the first of repeated properties counts
+			if _, ok := props[name]; !ok {
+				props[name] = value
+			}
+		}
+	}
+
+	if depth >= 0 {
+		return nil, apperrors.ErrInvalidICalFile
+	}
+	return events, nil
+}
+
+// unfoldICalLines reads the content lines of a file, joining folded lines back together
+// and skipping blank ones
+func unfoldICalLines(file io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, strings.TrimPrefix(line, "\ufeff"))
+	}
+
+	return lines, scanner.Err()
+}
+
+// splitICalLine splits a content line, NAME;PARAM=value:value, into its upper-cased name
+// and its value; parameters (time zones, value types) are not needed for all-day events.
+// A colon inside a quoted parameter value does not split.
+func splitICalLine(line string) (string, string, bool) {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '"':
+			quoted = !quoted
+		case line[i] == ':' && !quoted:
+			name, _, _ := strings.Cut(line[:i], ";")
+			return strings.ToUpper(name), line[i+1:], name != ""
+		}
+	}
+	return "", "", false
+}
+
+// icalEvent turns the properties of a VEVENT into an all-day event; keep is false for
+// cancelled events. An event of more than icalMaxEventDays days is an error.
+func icalEvent(props map[string]string) (event models.ICalEvent, keep bool, err error) {
+	if strings.EqualFold(props["STATUS"], "CANCELLED") {
+		return event, false, nil
+	}
+
+	dtstart, ok := props["DTSTART"]
+	if !ok {
+		return event, false, apperrors.ErrInvalidICalFile
+	}
+	start, _, err := icalDate(dtstart)
+	if err != nil {
+		return event, false, err
+	}
+
+	end := start
+	if dtend, ok := props["DTEND"]; ok {
+		date, midnight, err := icalDate(dtend)
+		if err != nil {
+			return event, false, err
+		}
+		// an end at midnight, as all-day events have, does not take that date
+		end = date
+		if midnight {
+			end = date.AddDate(0, 0, -1)
+		}
+	} else if match := icalDuration.FindStringSubmatch(props["DURATION"]); match != nil {
+		days, err := strconv.Atoi(match[1])
+		if err != nil {
+			return event, false, apperrors.ErrInvalidICalFile
+		}
+		if match[2] == "W" {
+			days *= 7
+		}
+		end = start.AddDate(0, 0, days-1)
+	}
+	if end.Before(start) {
+		end = start
+	}
+	if end.Sub(start) >= icalMaxEventDays*24*time.Hour {
+		return event, false, apperrors.ErrICalEventTooLong
+	}
+
+	return models.ICalEvent{
+		UID:         props["UID"],
+		Start:       start,
+		End:         end,
+		Summary:     strings.TrimSpace(icalUnescaper.Replace(props["SUMMARY"])),
+		Description: strings.TrimSpace(icalUnescaper.Replace(props["DESCRIPTION"])),
+	}, true, nil
+}
+
+// icalDate reads a DATE (20260101) or DATE-TIME (20260101T090000, Z for UTC) value as
+// the date it falls on, its wall-clock date whatever its time zone, and whether it is at
+// midnight
+func icalDate(value string) (time.Time, bool, error) {
+	if len(value) < 8 {
+		return time.Time{}, false, apperrors.ErrInvalidICalFile
+	}
+
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, false, apperrors.ErrInvalidICalFile
+	}
+	if len(value) == 8 {
+		return date, true, nil
+	}
+
+	clock, err := time.Parse("20060102T150405", strings.TrimSuffix(value, "Z"))
+	if err != nil {
+		return time.Time{}, false, apperrors.ErrInvalidICalFile
+	}
+	return date, clock.Equal(date), nil
+}
+
+// BuildICal writes the events as an iCalendar (.ics) calendar called name, each of them
+// an all-day event
+func BuildICal(name string, events []models.ICalEvent) []byte {
+	var b strings.Builder
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:"+icalProductID)
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+icalEscaper.Replace(name))
+
+	for _, event := range events {
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+event.UID)
+		writeICalLine(&b, "DTSTAMP:"+stamp)
+		writeICalLine(&b, "DTSTART;VALUE=DATE:"+event.Start.Format("20060102"))
+		// the end of an all-day event is the day after its last date
+		writeICalLine(&b, "DTEND;VALUE=DATE:"+event.End.AddDate(0, 0, 1).Format("20060102"))
+		writeICalLine(&b, "SUMMARY:"+icalEscaper.Replace(event.Summary))
+		if event.Description != "" {
+			writeICalLine(&b, "DESCRIPTION:"+icalEscaper.Replace(event.Description))
+		}
+		writeICalLine(&b, "TRANSP:TRANSPARENT")
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// writeICalLine writes a content line, folded so no line is longer than the limit and no
+// character is split
+func writeICalLine(b *strings.Builder, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// HolidayEvent is the event of a holiday of a calendar
+func HolidayEvent(calendarID int64, date time.Time, description string) models.ICalEvent {
+	if description == "" {
+		description = "Holiday"
+	}
+	return models.ICalEvent{
+		UID:     fmt.Sprintf("holiday-%d-%s@%s", calendarID, date.Format("20060102"), icalUIDDomain),
+		Start:   date,
+		End:     date,
+		Summary: description,
+	}
+}
+
+// LeaveEvent is the event of an approved leave. A partial day shows the half or the hours
+// taken in the summary; a leave over several dates starting or ending on a half day says
+// so in the description.
+func LeaveEvent(leave models.FeedLeave) models.ICalEvent {
+	summary := fmt.Sprintf("%s - %s leave", leave.EmployeeName, leave.LeaveType)
+	description := fmt.Sprintf("%s day(s) of %s leave", strconv.FormatFloat(leave.Days, 'f', -1, 64), leave.LeaveType)
+
+	switch {
+	case leave.Hours > 0:
+		summary += fmt.Sprintf(" (%dh)", leave.Hours)
+	case leave.FromDate.Equal(leave.ToDate) && leave.FromSession == constants.SessionFirstHalf:
+		summary += " (first half)"
+	case leave.FromDate.Equal(leave.ToDate) && leave.FromSession == constants.SessionSecondHalf:
+		summary += " (second half)"
+	default:
+		if leave.FromSession == constants.SessionSecondHalf {
+			description += ", from the second half of " + leave.FromDate.Format("2006-01-02")
+		}
+		if leave.ToSession == constants.SessionFirstHalf {
+			description += ", until the first half of " + leave.ToDate.Format("2006-01-02")
+		}
+	}
+
+	return models.ICalEvent{
+		UID:         fmt.Sprintf("leave-%d@%s", leave.ID, icalUIDDomain),
+		Start:       leave.FromDate,
+		End:         leave.ToDate,
+		Summary:     summary,
+		Description: description,
+	}
+}
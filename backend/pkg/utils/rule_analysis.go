@@ -0,0 +1,354 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// AnalyzeRuleSet reports rules of a rule set that can never decide a request and pairs
+// of rules that match the same requests.
+//
+// Conditions only compare request fields against constants, so the thresholds of all
+// rules split the request space into regions in which every request matches the same
+// rules. Evaluating one representative request per region is therefore exhaustive.
+func AnalyzeRuleSet(set models.RuleSet) []models.RuleIssue {
+	type analyzedRule struct {
+		rule    models.Rule
+		cond    Condition
+		matches []bool
+	}
+
+	var issues []models.RuleIssue
+	issue := func(kind, severity string, ruleIDs []int64, format string, args ...interface{}) {
+		issues = append(issues, models.RuleIssue{
+			Kind:        kind,
+			Severity:    severity,
+			RequestType: set.RequestType,
+			GradeID:     set.GradeID,
+			RuleIDs:     ruleIDs,
+			Message:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	var rules []analyzedRule
+	var fallback *models.Rule
+	for i := range set.Rules {
+		rule := set.Rules[i]
+		if rule.IsDefault {
+			if fallback == nil {
+				fallback = &set.Rules[i]
+			}
+			continue
+		}
+
+		cond, err := ParseCondition(set.RequestType, rule.Condition)
+		if err != nil {
+			issue(constants.IssueUnreachable, constants.SeverityError, []int64{rule.ID},
+				"rule %d never matches: %v", rule.ID, err)
+			continue
+		}
+		rules = append(rules, analyzedRule{rule: rule, cond: cond})
+	}
+
+	conds := make([]Condition, len(rules))
+	for i := range rules {
+		conds[i] = rules[i].cond
+	}
+	points := representativeFacts(set, conds)
+
+	for i := range rules {
+		rules[i].matches = make([]bool, len(points))
+		for p, facts := range points {
+			rules[i].matches[p] = rules[i].cond.Evaluate(facts)
+		}
+	}
+
+	allMatch := set.EvaluationMode == constants.RuleModeAllMatch
+	reachable := make([]bool, len(rules))
+	covered := make([]bool, len(points))
+
+	for i, r := range rules {
+		// rules that decide every request this rule matches
+		var deciders []int64
+		decided := true
+		matchesAny := false
+
+		for p := range points {
+			if !r.matches[p] {
+				continue
+			}
+			matchesAny = true
+
+			found := false
+			for j, other := range rules {
+				if j == i || !other.matches[p] {
+					continue
+				}
+				if (!allMatch && j < i) || (allMatch && actionRank[other.rule.Action] > actionRank[r.rule.Action]) {
+					found = true
+					deciders = appendUnique(deciders, other.rule.ID)
+					if !allMatch {
+						break
+					}
+				}
+			}
+			if !found {
+				decided = false
+			}
+			covered[p] = true
+		}
+
+		switch {
+		case !matchesAny:
+			issue(constants.IssueUnreachable, constants.SeverityError, []int64{r.rule.ID},
+				"rule %d never matches: its condition can never be true", r.rule.ID)
+		case decided && allMatch:
+			issue(constants.IssueUnreachable, constants.SeverityWarning, append([]int64{r.rule.ID}, deciders...),
+				"rule %d never decides: every request it matches also matches a more restrictive rule (%s)", r.rule.ID, joinIDs(deciders))
+		case decided:
+			issue(constants.IssueUnreachable, constants.SeverityWarning, append([]int64{r.rule.ID}, deciders...),
+				"rule %d never decides: every request it matches is decided first by higher priority rules (%s)", r.rule.ID, joinIDs(deciders))
+		default:
+			reachable[i] = true
+		}
+	}
+
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			if !reachable[i] || !reachable[j] {
+				continue
+			}
+
+			shared := false
+			for p := range points {
+				if rules[i].matches[p] && rules[j].matches[p] {
+					shared = true
+					break
+				}
+			}
+			if !shared {
+				continue
+			}
+
+			a, b := rules[i].rule, rules[j].rule
+			ids := []int64{a.ID, b.ID}
+
+			if sameOutcome(a, b) {
+				issue(constants.IssueOverlap, constants.SeverityWarning, ids,
+					"rules %d and %d match some of the same requests with the same outcome", a.ID, b.ID)
+				continue
+			}
+
+			// the evaluation order only settles the conflict when the rules differ in priority or restrictiveness
+			if allMatch && actionRank[a.Action] == actionRank[b.Action] {
+				issue(constants.IssueContradiction, constants.SeverityError, ids,
+					"rules %d and %d match some of the same requests but route them differently; rule %d wins only because it was created first", a.ID, b.ID, a.ID)
+				continue
+			}
+			if !allMatch && a.Priority == b.Priority {
+				issue(constants.IssueContradiction, constants.SeverityError, ids,
+					"rules %d and %d share priority %d and match some of the same requests with different actions; rule %d wins only because it was created first", a.ID, b.ID, a.Priority, a.ID)
+				continue
+			}
+
+			winner := a
+			if allMatch && actionRank[b.Action] > actionRank[a.Action] {
+				winner = b
+			}
+			issue(constants.IssueOverlap, constants.SeverityWarning, ids,
+				"rules %d (%s) and %d (%s) match some of the same requests; rule %d decides them", a.ID, a.Action, b.ID, b.Action, winner.ID)
+		}
+	}
+
+	if fallback != nil && len(rules) > 0 {
+		allCovered := true
+		for _, c := range covered {
+			if !c {
+				allCovered = false
+				break
+			}
+		}
+		if allCovered {
+			issue(constants.IssueUnreachable, constants.SeverityWarning, []int64{fallback.ID},
+				"default rule %d is never used: every request matches another rule", fallback.ID)
+		}
+	}
+
+	return issues
+}
+
+// sameOutcome reports whether two rules decide a request the same way
+func sameOutcome(a, b models.Rule) bool {
+	if a.Action != b.Action || a.RouteToRole != b.RouteToRole || a.Message != b.Message {
+		return false
+	}
+	if (a.RouteToUserID == nil) != (b.RouteToUserID == nil) {
+		return false
+	}
+	return a.RouteToUserID == nil || *a.RouteToUserID == *b.RouteToUserID
+}
+
+// representativeFacts returns one request for every region the conditions split the request space into
+func representativeFacts(set models.RuleSet, conds []Condition) []RequestFacts {
+	numbers := map[string][]float64{}
+	texts := map[string][]string{}
+	var fields []string
+
+	for _, cond := range conds {
+		for _, cmp := range ConditionComparisons(cond) {
+			// every request of the rule set comes from the same grade
+			if cmp.Field == FieldGrade {
+				continue
+			}
+			if _, seen := numbers[cmp.Field]; !seen {
+				if _, seen := texts[cmp.Field]; !seen {
+					fields = append(fields, cmp.Field)
+				}
+			}
+			if conditionFields[cmp.Field].kind == numericField {
+				numbers[cmp.Field] = append(numbers[cmp.Field], cmp.Numbers...)
+			} else {
+				texts[cmp.Field] = append(texts[cmp.Field], cmp.Texts...)
+			}
+		}
+	}
+	sort.Strings(fields)
+
+	points := []RequestFacts{{RequestType: set.RequestType, GradeID: set.GradeID}}
+	for _, field := range fields {
+		var next []RequestFacts
+		if conditionFields[field].kind == numericField {
+			for _, value := range numericSamples(field, numbers[field]) {
+				for _, facts := range points {
+					facts.setNumber(field, value)
+					next = append(next, facts)
+				}
+			}
+		} else {
+			for _, value := range textSamples(field, texts[field]) {
+				for _, facts := range points {
+					facts.setText(field, value)
+					next = append(next, facts)
+				}
+			}
+		}
+		points = next
+	}
+
+	return points
+}
+
+// numericSamples picks a value on and between every threshold. Request values are never negative
+// and tenure is counted in whole days.
+func numericSamples(field string, thresholds []float64) []float64 {
+	candidates := []float64{0}
+	sorted := append([]float64(nil), thresholds...)
+	sort.Float64s(sorted)
+
+	for i, t := range sorted {
+		candidates = append(candidates, t, t+1)
+		if i == 0 {
+			candidates = append(candidates, t/2)
+		} else {
+			candidates = append(candidates, (sorted[i-1]+t)/2)
+		}
+		if field == FieldTenureDays {
+			candidates = append(candidates, math.Floor(t), math.Ceil(t), math.Floor(t)-1)
+		}
+	}
+
+	seen := map[float64]bool{}
+	var samples []float64
+	for _, c := range candidates {
+		if field == FieldTenureDays && c != math.Trunc(c) {
+			continue
+		}
+		if c < 0 || seen[c] {
+			continue
+		}
+		seen[c] = true
+		samples = append(samples, c)
+	}
+
+	sort.Float64s(samples)
+	return samples
+}
+
+// textSamples uses the whole domain of closed fields, otherwise every compared value plus one that matches none
+func textSamples(field string, values []string) []string {
+	switch field {
+	case FieldRole:
+		return []string{constants.RoleEmployee, constants.RoleManager, constants.RoleAdmin}
+	case FieldDayOfWeek:
+		days := make([]string, 0, 7)
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			days = append(days, d.String())
+		}
+		return days
+	}
+
+	seen := map[string]bool{}
+	samples := []string{""}
+	for _, v := range values {
+		key := strings.ToLower(v)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		samples = append(samples, v)
+	}
+	return samples
+}
+
+func (f *RequestFacts) setNumber(field string, value float64) {
+	switch field {
+	case FieldAmount:
+		f.Amount = value
+	case FieldDays:
+		f.Days = value
+	case FieldPercent:
+		f.Percent = value
+	case FieldTenureDays:
+		f.TenureDays = int(value)
+	}
+}
+
+func (f *RequestFacts) setText(field string, value string) {
+	switch field {
+	case FieldCategory:
+		f.Category = value
+	case FieldLeaveType:
+		f.LeaveType = value
+	case FieldRole:
+		f.Role = value
+	case FieldDayOfWeek:
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			if strings.EqualFold(d.String(), value) {
+				f.DayOfWeek = d
+			}
+		}
+	}
+}
+
+func appendUnique(ids []int64, id int64) []int64 {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+func joinIDs(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprint(id)
+	}
+	return strings.Join(parts, ", ")
+}
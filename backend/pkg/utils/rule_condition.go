@@ -0,0 +1,522 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+// Condition operators supported in a rule condition
+const (
+	OpEq    = "eq"
+	OpNeq   = "neq"
+	OpLt    = "lt"
+	OpLte   = "lte"
+	OpGt    = "gt"
+	OpGte   = "gte"
+	OpIn    = "in"
+	OpNotIn = "not_in"
+)
+
+// Condition fields that can be referenced from a rule condition
+const (
+	FieldAmount     = "amount"
+	FieldCategory   = "category"
+	FieldDays       = "days"
+	FieldLeaveType  = "leave_type"
+	FieldPercent    = "percent"
+	FieldGrade      = "grade"
+	FieldRole       = "role"
+	FieldTenureDays = "tenure_days"
+	FieldDayOfWeek  = "day_of_week"
+)
+
+type fieldKind int
+
+const (
+	numericField fieldKind = iota
+	textField
+)
+
+type conditionField struct {
+	kind         fieldKind
+	requestTypes []string // empty means the field applies to every request type
+}
+
+var conditionFields = map[string]conditionField{
+	FieldAmount:     {kind: numericField, requestTypes: []string{"EXPENSE"}},
+	FieldCategory:   {kind: textField, requestTypes: []string{"EXPENSE"}},
+	FieldDays:       {kind: numericField, requestTypes: []string{"LEAVE"}},
+	FieldLeaveType:  {kind: textField, requestTypes: []string{"LEAVE"}},
+	FieldPercent:    {kind: numericField, requestTypes: []string{"DISCOUNT"}},
+	FieldGrade:      {kind: numericField},
+	FieldRole:       {kind: textField},
+	FieldTenureDays: {kind: numericField},
+	FieldDayOfWeek:  {kind: textField},
+}
+
+// legacy single-key conditions ({"max_days": 3}) keep working as "<field> lte <value>"
+var legacyConditionKeys = map[string]struct {
+	requestType string
+	field       string
+}{
+	"max_days":    {requestType: "LEAVE", field: FieldDays},
+	"max_amount":  {requestType: "EXPENSE", field: FieldAmount},
+	"max_percent": {requestType: "DISCOUNT", field: FieldPercent},
+}
+
+// RequestFacts holds the request attributes a rule condition is evaluated against
+type RequestFacts struct {
+	RequestType string
+	Amount      float64
+	Days        float64
+	Percent     float64
+	Category    string
+	LeaveType   string
+	GradeID     int64
+	Role        string
+	TenureDays  int
+	DayOfWeek   time.Weekday
+}
+
+// NewRequestFacts fills the requester-related facts; callers set the request specific values
+func NewRequestFacts(requestType string, user *models.User, requestDate time.Time) RequestFacts {
+	return RequestFacts{
+		RequestType: requestType,
+		GradeID:     user.GradeID,
+		Role:        user.Role,
+		TenureDays:  int(requestDate.Sub(user.CreatedAt).Hours() / 24),
+		DayOfWeek:   requestDate.Weekday(),
+	}
+}
+
+func (f RequestFacts) number(field string) float64 {
+	switch field {
+	case FieldAmount:
+		return f.Amount
+	case FieldDays:
+		return f.Days
+	case FieldPercent:
+		return f.Percent
+	case FieldGrade:
+		return float64(f.GradeID)
+	case FieldTenureDays:
+		return float64(f.TenureDays)
+	}
+	return 0
+}
+
+func (f RequestFacts) text(field string) string {
+	switch field {
+	case FieldCategory:
+		return f.Category
+	case FieldLeaveType:
+		return f.LeaveType
+	case FieldRole:
+		return f.Role
+	case FieldDayOfWeek:
+		return f.DayOfWeek.String()
+	}
+	return ""
+}
+
+// Condition is a parsed, type-checked rule condition
+type Condition interface {
+	Evaluate(facts RequestFacts) bool
+}
+
+type allCondition []Condition
+
+func (c allCondition) Evaluate(facts RequestFacts) bool {
+	for _, child := range c {
+		if !child.Evaluate(facts) {
+			return false
+		}
+	}
+	return true
+}
+
+type anyCondition []Condition
+
+func (c anyCondition) Evaluate(facts RequestFacts) bool {
+	for _, child := range c {
+		if child.Evaluate(facts) {
+			return true
+		}
+	}
+	return false
+}
+
+type notCondition struct {
+	inner Condition
+}
+
+func (c notCondition) Evaluate(facts RequestFacts) bool {
+	return !c.inner.Evaluate(facts)
+}
+
+// Comparison is a single "<field> <op> <value>" leaf of a condition
+type Comparison struct {
+	Field   string
+	Op      string
+	Numbers []float64
+	Texts   []string
+}
+
+func (c Comparison) Evaluate(facts RequestFacts) bool {
+	if conditionFields[c.Field].kind == numericField {
+		return compareNumber(facts.number(c.Field), c.Op, c.Numbers)
+	}
+	return compareText(facts.text(c.Field), c.Op, c.Texts)
+}
+
+// Trace reports the comparison against the request's actual value
+func (c Comparison) Trace(facts RequestFacts) models.ConditionTrace {
+	trace := models.ConditionTrace{Field: c.Field, Op: c.Op, Matched: c.Evaluate(facts)}
+
+	if conditionFields[c.Field].kind == numericField {
+		trace.Actual = facts.number(c.Field)
+		trace.Threshold = thresholdValue(c.Op, c.Numbers)
+		return trace
+	}
+
+	trace.Actual = facts.text(c.Field)
+	trace.Threshold = thresholdValue(c.Op, c.Texts)
+	return trace
+}
+
+// set operators keep the whole list, everything else compares against a single value
+func thresholdValue[T any](op string, values []T) interface{} {
+	if op == OpIn || op == OpNotIn {
+		return values
+	}
+	return values[0]
+}
+
+func compareNumber(actual float64, op string, values []float64) bool {
+	switch op {
+	case OpEq:
+		return actual == values[0]
+	case OpNeq:
+		return actual != values[0]
+	case OpLt:
+		return actual < values[0]
+	case OpLte:
+		return actual <= values[0]
+	case OpGt:
+		return actual > values[0]
+	case OpGte:
+		return actual >= values[0]
+	case OpIn, OpNotIn:
+		found := false
+		for _, v := range values {
+			if actual == v {
+				found = true
+				break
+			}
+		}
+		return found == (op == OpIn)
+	}
+	return false
+}
+
+func compareText(actual string, op string, values []string) bool {
+	switch op {
+	case OpEq:
+		return strings.EqualFold(actual, values[0])
+	case OpNeq:
+		return !strings.EqualFold(actual, values[0])
+	case OpIn, OpNotIn:
+		found := false
+		for _, v := range values {
+			if strings.EqualFold(actual, v) {
+				found = true
+				break
+			}
+		}
+		return found == (op == OpIn)
+	}
+	return false
+}
+
+// ParseCondition parses and type-checks a rule condition for the given request type.
+//
+// A condition node is one of:
+//
+//	{"all": [node, ...]}                            every child must match
+//	{"any": [node, ...]}                            at least one child must match
+//	{"not": node}                                   negates the child
+//	{"field": "amount", "op": "lte", "value": 8000} compares a request field
+//	{"max_days": 3}                                 legacy shorthand for "days lte 3"
+func ParseCondition(requestType string, raw map[string]interface{}) (Condition, error) {
+	if !IsValidRequestType(requestType) {
+		return nil, apperrors.ErrInvalidRequestType
+	}
+	if len(raw) == 0 {
+		return nil, apperrors.ErrConditionRequired
+	}
+	return parseConditionNode(requestType, raw)
+}
+
+// IsValidRequestType reports whether rules can be configured for the request type
+func IsValidRequestType(requestType string) bool {
+	switch requestType {
+	case "LEAVE", "EXPENSE", "DISCOUNT":
+		return true
+	}
+	return false
+}
+
+func parseConditionNode(requestType string, node map[string]interface{}) (Condition, error) {
+	if children, ok := node["all"]; ok {
+		if len(node) != 1 {
+			return nil, apperrors.ErrInvalidCondition
+		}
+		list, err := parseConditionList(requestType, children)
+		if err != nil {
+			return nil, err
+		}
+		return allCondition(list), nil
+	}
+
+	if children, ok := node["any"]; ok {
+		if len(node) != 1 {
+			return nil, apperrors.ErrInvalidCondition
+		}
+		list, err := parseConditionList(requestType, children)
+		if err != nil {
+			return nil, err
+		}
+		return anyCondition(list), nil
+	}
+
+	if child, ok := node["not"]; ok {
+		if len(node) != 1 {
+			return nil, apperrors.ErrInvalidCondition
+		}
+		childMap, ok := child.(map[string]interface{})
+		if !ok {
+			return nil, apperrors.ErrInvalidCondition
+		}
+		inner, err := parseConditionNode(requestType, childMap)
+		if err != nil {
+			return nil, err
+		}
+		return notCondition{inner: inner}, nil
+	}
+
+	if _, ok := node["field"]; ok {
+		return parseComparison(requestType, node)
+	}
+
+	return parseLegacyCondition(requestType, node)
+}
+
+func parseConditionList(requestType string, raw interface{}) ([]Condition, error) {
+	items, ok := raw.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, apperrors.ErrInvalidCondition
+	}
+
+	list := make([]Condition, 0, len(items))
+	for _, item := range items {
+		childMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, apperrors.ErrInvalidCondition
+		}
+		child, err := parseConditionNode(requestType, childMap)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, child)
+	}
+	return list, nil
+}
+
+func parseComparison(requestType string, node map[string]interface{}) (Condition, error) {
+	for key := range node {
+		if key != "field" && key != "op" && key != "value" {
+			return nil, apperrors.ErrInvalidCondition
+		}
+	}
+
+	field, _ := node["field"].(string)
+	op, _ := node["op"].(string)
+
+	def, ok := conditionFields[field]
+	if !ok || !fieldAppliesTo(def, requestType) {
+		return nil, apperrors.ErrUnknownConditionField
+	}
+
+	cmp := Comparison{Field: field, Op: op}
+
+	var values []interface{}
+	switch op {
+	case OpIn, OpNotIn:
+		list, ok := node["value"].([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, apperrors.ErrConditionTypeMismatch
+		}
+		values = list
+	case OpEq, OpNeq:
+		values = []interface{}{node["value"]}
+	case OpLt, OpLte, OpGt, OpGte:
+		if def.kind != numericField {
+			return nil, apperrors.ErrUnsupportedConditionOperator
+		}
+		values = []interface{}{node["value"]}
+	default:
+		return nil, apperrors.ErrUnsupportedConditionOperator
+	}
+
+	for _, v := range values {
+		if def.kind == numericField {
+			num, ok := toFloat(v)
+			if !ok {
+				return nil, apperrors.ErrConditionTypeMismatch
+			}
+			cmp.Numbers = append(cmp.Numbers, num)
+			continue
+		}
+
+		text, ok := v.(string)
+		if !ok || strings.TrimSpace(text) == "" {
+			return nil, apperrors.ErrConditionTypeMismatch
+		}
+		if err := validateTextValue(field, text); err != nil {
+			return nil, err
+		}
+		cmp.Texts = append(cmp.Texts, text)
+	}
+
+	return cmp, nil
+}
+
+func parseLegacyCondition(requestType string, node map[string]interface{}) (Condition, error) {
+	list := make([]Condition, 0, len(node))
+	for key, raw := range node {
+		legacy, ok := legacyConditionKeys[key]
+		if !ok || legacy.requestType != requestType {
+			return nil, apperrors.ErrUnknownConditionField
+		}
+		num, ok := toFloat(raw)
+		if !ok {
+			return nil, apperrors.ErrConditionTypeMismatch
+		}
+		list = append(list, Comparison{Field: legacy.field, Op: OpLte, Numbers: []float64{num}})
+	}
+
+	if len(list) == 1 {
+		return list[0], nil
+	}
+	return allCondition(list), nil
+}
+
+func fieldAppliesTo(def conditionField, requestType string) bool {
+	if len(def.requestTypes) == 0 {
+		return true
+	}
+	for _, t := range def.requestTypes {
+		if t == requestType {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTextValue(field, value string) error {
+	switch field {
+	case FieldRole:
+		switch strings.ToUpper(value) {
+		case constants.RoleEmployee, constants.RoleManager, constants.RoleAdmin:
+			return nil
+		}
+		return apperrors.ErrConditionTypeMismatch
+	case FieldDayOfWeek:
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			if strings.EqualFold(d.String(), value) {
+				return nil
+			}
+		}
+		return apperrors.ErrConditionTypeMismatch
+	}
+	return nil
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// ConditionComparisons flattens a parsed condition into its comparison leaves
+func ConditionComparisons(cond Condition) []Comparison {
+	switch c := cond.(type) {
+	case Comparison:
+		return []Comparison{c}
+	case allCondition:
+		var out []Comparison
+		for _, child := range c {
+			out = append(out, ConditionComparisons(child)...)
+		}
+		return out
+	case anyCondition:
+		var out []Comparison
+		for _, child := range c {
+			out = append(out, ConditionComparisons(child)...)
+		}
+		return out
+	case notCondition:
+		return ConditionComparisons(c.inner)
+	}
+	return nil
+}
+
+// PinnedLeaveType returns the leave type every request matching the condition is of,
+// or "" when the condition can match requests of more than one leave type
+func PinnedLeaveType(cond Condition) string {
+	switch c := cond.(type) {
+	case Comparison:
+		if c.Field == FieldLeaveType && (c.Op == OpEq || (c.Op == OpIn && len(c.Texts) == 1)) {
+			return strings.ToUpper(c.Texts[0])
+		}
+	case allCondition:
+		for _, child := range c {
+			if code := PinnedLeaveType(child); code != "" {
+				return code
+			}
+		}
+	case anyCondition:
+		pinned := ""
+		for i, child := range c {
+			code := PinnedLeaveType(child)
+			if code == "" || (i > 0 && code != pinned) {
+				return ""
+			}
+			pinned = code
+		}
+		return pinned
+	}
+	return ""
+}
+
+// TraceCondition evaluates every comparison leaf of a condition for the decision trace
+func TraceCondition(cond Condition, facts RequestFacts) []models.ConditionTrace {
+	comparisons := ConditionComparisons(cond)
+	traces := make([]models.ConditionTrace, 0, len(comparisons))
+	for _, cmp := range comparisons {
+		traces = append(traces, cmp.Trace(facts))
+	}
+	return traces
+}
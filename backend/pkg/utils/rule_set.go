@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// actionRank orders rule actions from least to most restrictive.
+// In ALL_MATCH mode the most restrictive matched action wins.
+var actionRank = map[string]int{
+	constants.StatusAutoApprove: 0,
+	constants.ActionManual:      1,
+	constants.ActionRouteTo:     2,
+	constants.ActionAutoReject:  3,
+}
+
+// IsValidRuleAction reports whether action is a supported rule action
+func IsValidRuleAction(action string) bool {
+	_, ok := actionRank[action]
+	return ok
+}
+
+// IsValidRuleMode reports whether mode is a supported rule evaluation mode
+func IsValidRuleMode(mode string) bool {
+	return mode == constants.RuleModeFirstMatch || mode == constants.RuleModeAllMatch
+}
+
+// MakeRuleSetDecision evaluates the prioritized rules of a rule set against the request.
+//
+// FIRST_MATCH: the first rule (lowest priority value) whose condition matches decides.
+// ALL_MATCH:   every rule is evaluated and the most restrictive matched action decides.
+// When nothing matches, the default rule (if any) decides; otherwise the request goes to manual review.
+// The result carries a trace of every rule evaluated and why the outcome was chosen.
+func MakeRuleSetDecision(set models.RuleSet, facts RequestFacts) DecisionResult {
+	var matched []models.Rule
+	var fallback *models.Rule
+
+	trace := &models.DecisionTrace{
+		RequestType:    set.RequestType,
+		EvaluationMode: set.EvaluationMode,
+		Rules:          []models.RuleTrace{},
+	}
+
+	for i := range set.Rules {
+		rule := set.Rules[i]
+
+		if rule.IsDefault {
+			if fallback == nil {
+				fallback = &set.Rules[i]
+			}
+			continue
+		}
+
+		ruleTrace := models.RuleTrace{RuleID: rule.ID, Priority: rule.Priority, Action: rule.Action}
+
+		cond, err := ParseCondition(set.RequestType, rule.Condition)
+		if err != nil {
+			// a broken stored rule never matches
+			ruleTrace.Error = err.Error()
+			trace.Rules = append(trace.Rules, ruleTrace)
+			continue
+		}
+
+		ruleTrace.Matched = cond.Evaluate(facts)
+		ruleTrace.Conditions = TraceCondition(cond, facts)
+		trace.Rules = append(trace.Rules, ruleTrace)
+
+		if !ruleTrace.Matched {
+			continue
+		}
+
+		matched = append(matched, rule)
+		if set.EvaluationMode != constants.RuleModeAllMatch {
+			break
+		}
+	}
+
+	var chosen *models.Rule
+	switch {
+	case len(matched) == 0:
+		chosen = fallback
+		if fallback != nil {
+			trace.Rules = append(trace.Rules, models.RuleTrace{
+				RuleID:    fallback.ID,
+				Priority:  fallback.Priority,
+				Action:    fallback.Action,
+				IsDefault: true,
+				Matched:   true,
+			})
+			trace.Reason = fmt.Sprintf("no rule matched, default rule %d applied", fallback.ID)
+		} else {
+			trace.Reason = "no rule matched, sent for manual review"
+		}
+	case set.EvaluationMode == constants.RuleModeAllMatch:
+		chosen = &matched[0]
+		for i := range matched {
+			if actionRank[matched[i].Action] > actionRank[chosen.Action] {
+				chosen = &matched[i]
+			}
+		}
+		trace.Reason = fmt.Sprintf("%d rule(s) matched, rule %d has the most restrictive action", len(matched), chosen.ID)
+	default:
+		chosen = &matched[0]
+		trace.Reason = fmt.Sprintf("rule %d matched first", chosen.ID)
+	}
+
+	result := decisionForAction(set.RequestType, chosen)
+	for _, rule := range matched {
+		result.MatchedRuleIDs = append(result.MatchedRuleIDs, rule.ID)
+	}
+
+	trace.RuleID = result.RuleID
+	trace.Action = result.Action
+	trace.Outcome = result.Status
+	result.Trace = trace
+
+	return result
+}
+
+func decisionForAction(requestType string, rule *models.Rule) DecisionResult {
+	if rule == nil {
+		return DecisionResult{
+			Status:  constants.StatusPending,
+			Message: requestType + " submitted for approval",
+			Action:  constants.ActionManual,
+		}
+	}
+
+	ruleID := rule.ID
+	switch rule.Action {
+	case constants.StatusAutoApprove:
+		return DecisionResult{
+			Status:  constants.StatusAutoApproved,
+			Message: requestType + " approved by system",
+			Action:  rule.Action,
+			RuleID:  &ruleID,
+		}
+
+	case constants.ActionAutoReject:
+		message := rule.Message
+		if message == "" {
+			message = requestType + " rejected by policy"
+		}
+		return DecisionResult{
+			Status:  constants.StatusAutoRejected,
+			Message: message,
+			Action:  rule.Action,
+			RuleID:  &ruleID,
+		}
+
+	case constants.ActionRouteTo:
+		return DecisionResult{
+			Status:        constants.StatusPending,
+			Message:       requestType + " submitted for approval",
+			Action:        rule.Action,
+			RuleID:        &ruleID,
+			RouteToRole:   rule.RouteToRole,
+			RouteToUserID: rule.RouteToUserID,
+		}
+	}
+
+	return DecisionResult{
+		Status:  constants.StatusPending,
+		Message: requestType + " submitted for approval",
+		Action:  constants.ActionManual,
+		RuleID:  &ruleID,
+	}
+}
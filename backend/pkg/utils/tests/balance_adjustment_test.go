@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBalanceAdjustments(t *testing.T) {
+	t.Run("Columns In Any Order", func(t *testing.T) {
+		file := "reason,amount,note,balance_type,leave_type,user_id\n\"carried over, 2025\",2,ignored,leave, sick ,7\n\nbonus,-150.5,,Expense,,8\n"
+
+		rows, err := utils.ParseBalanceAdjustments(strings.NewReader(file))
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+
+		assert.Equal(t, 2, rows[0].Row)
+		assert.NoError(t, rows[0].Err)
+		assert.Equal(t, int64(7), rows[0].Adjustment.UserID)
+		assert.Equal(t, "LEAVE", rows[0].Adjustment.BalanceType)
+		assert.Equal(t, "SICK", rows[0].Adjustment.LeaveType)
+		assert.Equal(t, float64(2), rows[0].Adjustment.Amount)
+		assert.Equal(t, "carried over, 2025", rows[0].Adjustment.Reason)
+
+		assert.Equal(t, 4, rows[1].Row)
+		assert.Equal(t, "EXPENSE", rows[1].Adjustment.BalanceType)
+		assert.Equal(t, -150.5, rows[1].Adjustment.Amount)
+		assert.Empty(t, rows[1].Adjustment.LeaveType)
+	})
+
+	t.Run("Unreadable Fields", func(t *testing.T) {
+		file := "user_id,balance_type,amount,reason\nx,LEAVE,1,a\n3,LEAVE,lots,a\n4,LEAVE\n"
+
+		rows, err := utils.ParseBalanceAdjustments(strings.NewReader(file))
+		assert.NoError(t, err)
+		assert.Len(t, rows, 3)
+		assert.ErrorIs(t, rows[0].Err, apperrors.ErrInvalidUser)
+		assert.ErrorIs(t, rows[1].Err, apperrors.ErrInvalidAdjustmentAmount)
+		assert.ErrorIs(t, rows[2].Err, apperrors.ErrInvalidAdjustmentAmount)
+	})
+
+	t.Run("Malformed Quotes", func(t *testing.T) {
+		file := "user_id,balance_type,amount,reason\n\"1,LEAVE,2,abc\n"
+
+		rows, err := utils.ParseBalanceAdjustments(strings.NewReader(file))
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.Equal(t, 2, rows[0].Row)
+		assert.ErrorIs(t, rows[0].Err, apperrors.ErrInvalidInput)
+
+		file = "user_id,balance_type,amount,reason\n1,LEAVE,2,ok\n\"x\"y,LEAVE,2,abc\n3,LEAVE,1,ok\n"
+
+		rows, err = utils.ParseBalanceAdjustments(strings.NewReader(file))
+		assert.NoError(t, err)
+		assert.Len(t, rows, 3)
+		assert.NoError(t, rows[0].Err)
+		assert.Equal(t, 3, rows[1].Row)
+		assert.ErrorIs(t, rows[1].Err, apperrors.ErrInvalidInput)
+		assert.Equal(t, 4, rows[2].Row)
+		assert.NoError(t, rows[2].Err)
+	})
+
+	t.Run("Missing Column", func(t *testing.T) {
+		_, err := utils.ParseBalanceAdjustments(strings.NewReader("user_id,amount,reason\n1,2,a\n"))
+		assert.ErrorIs(t, err, apperrors.ErrInvalidAdjustmentHeader)
+	})
+
+	t.Run("Empty File", func(t *testing.T) {
+		_, err := utils.ParseBalanceAdjustments(strings.NewReader(""))
+		assert.ErrorIs(t, err, apperrors.ErrInvalidAdjustmentHeader)
+	})
+}
@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestBalanceCycleStart(t *testing.T) {
+	calendar := models.BalancePolicy{ResetCycle: constants.ResetCalendar}
+	fiscal := models.BalancePolicy{ResetCycle: constants.ResetFiscal, FiscalStartMonth: 4}
+
+	assert.Equal(t, date(2026, 1, 1), utils.BalanceCycleStart(calendar, date(2026, 10, 16)))
+	assert.Equal(t, date(2025, 4, 1), utils.BalanceCycleStart(fiscal, date(2026, 3, 31)))
+	assert.Equal(t, date(2026, 4, 1), utils.BalanceCycleStart(fiscal, date(2026, 4, 1)))
+}
+
+func TestPlanBalancePolicy(t *testing.T) {
+	cap5 := 5.0
+	threeMonths := 3
+	expiresOn := date(2026, 4, 1)
+
+	annual := models.BalancePolicy{
+		BalanceType:              "LEAVE",
+		ResetCycle:               constants.ResetCalendar,
+		Accrual:                  constants.AccrualAnnual,
+		CarryForwardCap:          &cap5,
+		CarryForwardExpiryMonths: &threeMonths,
+		ProrateJoiners:           true,
+	}
+	monthly := models.BalancePolicy{
+		BalanceType:    "EXPENSE",
+		ResetCycle:     constants.ResetCalendar,
+		Accrual:        constants.AccrualMonthly,
+		ProrateJoiners: true,
+	}
+
+	tests := []struct {
+		name          string
+		policy        models.BalancePolicy
+		account       models.BalanceAccount
+		today         time.Time
+		expectNil     bool
+		expectEntries []models.BalancePlanEntry
+		expectTotal   float64
+		expectCycle   models.BalanceCycle
+	}{
+		{
+			name:   "First Run Adopts Current Balance",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2024, 5, 10), AnnualLimit: 20, Total: 20, Remaining: 12,
+			},
+			today:       date(2026, 10, 16),
+			expectTotal: 20,
+			expectCycle: models.BalanceCycle{UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20},
+		},
+		{
+			name:   "First Run Pro-rates Joiner",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2026, 7, 14), AnnualLimit: 24, Total: 24, Remaining: 24,
+			},
+			today: date(2026, 10, 16),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerAllocation, Amount: -12, Reason: "Pro-rated allocation for joining on 2026-07-14"},
+			},
+			expectTotal: 12,
+			expectCycle: models.BalanceCycle{UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 12},
+		},
+		{
+			name:   "First Run Pro-rates Joiner To Half Days",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2026, 7, 14), AnnualLimit: 15, Total: 15, Remaining: 15,
+			},
+			today: date(2026, 10, 16),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerAllocation, Amount: -7.5, Reason: "Pro-rated allocation for joining on 2026-07-14"},
+			},
+			expectTotal: 7.5,
+			expectCycle: models.BalanceCycle{UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 7.5},
+		},
+		{
+			name:   "First Run Keeps Used Balance Of Joiner",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2026, 7, 14), AnnualLimit: 24, Total: 24, Remaining: 5,
+			},
+			today: date(2026, 10, 16),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerAllocation, Amount: -5, Reason: "Pro-rated allocation for joining on 2026-07-14"},
+			},
+			expectTotal: 19,
+			expectCycle: models.BalanceCycle{UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 19},
+		},
+		{
+			name:   "Up To Date",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2024, 5, 10), AnnualLimit: 20, Total: 20, Remaining: 12,
+				Cycle: &models.BalanceCycle{UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20},
+			},
+			today:     date(2026, 10, 16),
+			expectNil: true,
+		},
+		{
+			name:   "New Cycle Caps Carry Forward",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2024, 5, 10), AnnualLimit: 20, Total: 20, Remaining: 8,
+				Cycle: &models.BalanceCycle{UserID: 1, BalanceType: "LEAVE", CycleStart: date(2025, 1, 1), Allocated: 20},
+			},
+			today: date(2026, 1, 1),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerReset, Amount: -3, Reason: "Reset for the cycle starting 2026-01-01, 5 carried forward"},
+				{EntryType: constants.LedgerAllocation, Amount: 20, Reason: "Allocation for the cycle starting 2026-01-01"},
+			},
+			expectTotal: 25,
+			expectCycle: models.BalanceCycle{
+				UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20,
+				CarriedOver: 5, CarryExpiresOn: &expiresOn,
+			},
+		},
+		{
+			name:   "Carried Balance Expires",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2024, 5, 10), AnnualLimit: 20, Total: 25, Remaining: 23, UsedInCycle: 2,
+				Cycle: &models.BalanceCycle{
+					UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20,
+					CarriedOver: 5, CarryExpiresOn: &expiresOn,
+				},
+			},
+			today: date(2026, 4, 1),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerExpiry, Amount: -3, Reason: "Carried-over balance expired on 2026-04-01"},
+			},
+			expectTotal: 22,
+			expectCycle: models.BalanceCycle{
+				UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20,
+				CarriedOver: 2, CarryExpiresOn: &expiresOn, CarryExpired: true,
+			},
+		},
+		{
+			name:   "Carried Balance Used Up Before Expiry",
+			policy: annual,
+			account: models.BalanceAccount{
+				UserID: 1, JoinedAt: date(2024, 5, 10), AnnualLimit: 20, Total: 25, Remaining: 18, UsedInCycle: 7,
+				Cycle: &models.BalanceCycle{
+					UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20,
+					CarriedOver: 5, CarryExpiresOn: &expiresOn,
+				},
+			},
+			today:       date(2026, 4, 2),
+			expectTotal: 25,
+			expectCycle: models.BalanceCycle{
+				UserID: 1, BalanceType: "LEAVE", CycleStart: date(2026, 1, 1), Allocated: 20,
+				CarriedOver: 5, CarryExpiresOn: &expiresOn, CarryExpired: true,
+			},
+		},
+		{
+			name:   "Monthly Accrual",
+			policy: monthly,
+			account: models.BalanceAccount{
+				UserID: 2, JoinedAt: date(2024, 5, 10), AnnualLimit: 1000, Total: 750, Remaining: 400,
+				Cycle: &models.BalanceCycle{UserID: 2, BalanceType: "EXPENSE", CycleStart: date(2026, 1, 1), Allocated: 750},
+			},
+			today: date(2026, 10, 16),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerAccrual, Amount: 83.33, Reason: "Monthly accrual for October 2026"},
+			},
+			expectTotal: 833.33,
+			expectCycle: models.BalanceCycle{UserID: 2, BalanceType: "EXPENSE", CycleStart: date(2026, 1, 1), Allocated: 833.33},
+		},
+		{
+			name:   "New Cycle Monthly Accrual Carries Everything Without Cap",
+			policy: monthly,
+			account: models.BalanceAccount{
+				UserID: 2, JoinedAt: date(2024, 5, 10), AnnualLimit: 1200, Total: 1200, Remaining: 300,
+				Cycle: &models.BalanceCycle{UserID: 2, BalanceType: "EXPENSE", CycleStart: date(2025, 1, 1), Allocated: 1200},
+			},
+			today: date(2026, 1, 1),
+			expectEntries: []models.BalancePlanEntry{
+				{EntryType: constants.LedgerReset, Amount: 0, Reason: "Reset for the cycle starting 2026-01-01, 300 carried forward"},
+				{EntryType: constants.LedgerAccrual, Amount: 100, Reason: "Monthly accrual for January 2026"},
+			},
+			expectTotal: 400,
+			expectCycle: models.BalanceCycle{
+				UserID: 2, BalanceType: "EXPENSE", CycleStart: date(2026, 1, 1), Allocated: 100, CarriedOver: 300,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := utils.PlanBalancePolicy(tt.policy, tt.account, tt.today)
+			if tt.expectNil {
+				assert.Nil(t, plan)
+				return
+			}
+
+			assert.NotNil(t, plan)
+			assert.Len(t, plan.Entries, len(tt.expectEntries))
+			for i, expected := range tt.expectEntries {
+				assert.Equal(t, expected.EntryType, plan.Entries[i].EntryType)
+				assert.InDelta(t, expected.Amount, plan.Entries[i].Amount, 0.001)
+				assert.Equal(t, expected.Reason, plan.Entries[i].Reason)
+			}
+			assert.InDelta(t, tt.expectTotal, plan.Total, 0.001)
+			assert.InDelta(t, tt.expectCycle.Allocated, plan.Cycle.Allocated, 0.001)
+			assert.InDelta(t, tt.expectCycle.CarriedOver, plan.Cycle.CarriedOver, 0.001)
+			tt.expectCycle.Allocated = plan.Cycle.Allocated
+			tt.expectCycle.CarriedOver = plan.Cycle.CarriedOver
+			assert.Equal(t, tt.expectCycle, plan.Cycle)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func icalDay(month time.Month, day int) time.Time {
+	return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseICalEvents(t *testing.T) {
+	t.Run("All-Day And Timed Events", func(t *testing.T) {
+		file := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"BEGIN:VEVENT",
+			"UID:diwali@example.com",
+			"DTSTART;VALUE=DATE:20261108",
+			"DTEND;VALUE=DATE:20261110",
+			"SUMMARY:Diwali\\, day one",
+			"  and two",
+			"BEGIN:VALARM",
+			"DESCRIPTION:Reminder",
+			"END:VALARM",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			`DTSTART;TZID="Asia/Kolkata":20261002T090000`,
+			"DTEND;TZID=Asia/Kolkata:20261002T180000",
+			"SUMMARY:Gandhi Jayanti",
+			"DESCRIPTION:National holiday\\nOffices closed",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"DTSTART:20261231T000000Z",
+			"DTEND:20270101T000000Z",
+			"SUMMARY:Year end",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"DTSTART;VALUE=DATE:20260413",
+			"DURATION:P1W",
+			"SUMMARY:Shutdown",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\r\n")
+
+		events, err := utils.ParseICalEvents(strings.NewReader(file))
+		assert.NoError(t, err)
+		assert.Len(t, events, 4)
+
+		assert.Equal(t, "diwali@example.com", events[0].UID)
+		assert.Equal(t, icalDay(11, 8), events[0].Start)
+		assert.Equal(t, icalDay(11, 9), events[0].End)
+		assert.Equal(t, "Diwali, day one and two", events[0].Summary)
+		assert.Empty(t, events[0].Description)
+
+		assert.Equal(t, icalDay(10, 2), events[1].Start)
+		assert.Equal(t, icalDay(10, 2), events[1].End)
+		assert.Equal(t, "National holiday\nOffices closed", events[1].Description)
+
+		assert.Equal(t, icalDay(12, 31), events[2].Start)
+		assert.Equal(t, icalDay(12, 31), events[2].End)
+
+		assert.Equal(t, icalDay(4, 13), events[3].Start)
+		assert.Equal(t, icalDay(4, 19), events[3].End)
+	})
+
+	t.Run("Skips Cancelled Events", func(t *testing.T) {
+		file := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nSTATUS:CANCELLED\nEND:VEVENT\nEND:VCALENDAR\n"
+
+		events, err := utils.ParseICalEvents(strings.NewReader(file))
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Events Up To 31 Days", func(t *testing.T) {
+		month := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260701\nDTEND;VALUE=DATE:20260801\nEND:VEVENT\nEND:VCALENDAR\n"
+
+		events, err := utils.ParseICalEvents(strings.NewReader(month))
+		assert.NoError(t, err)
+		assert.Equal(t, icalDay(7, 31), events[0].End)
+
+		files := map[string]string{
+			"Long Duration": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nDURATION:P9999W\nEND:VEVENT\nEND:VCALENDAR\n",
+			"Long Span":     "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nDTEND;VALUE=DATE:20260202\nEND:VEVENT\nEND:VCALENDAR\n",
+		}
+		for name, file := range files {
+			_, err := utils.ParseICalEvents(strings.NewReader(file))
+			assert.ErrorIs(t, err, apperrors.ErrICalEventTooLong, name)
+		}
+	})
+
+	t.Run("Invalid Files", func(t *testing.T) {
+		files := map[string]string{
+			"Empty":          "",
+			"Not A Calendar": "date,description\n2026-01-01,New Year\n",
+			"No Start":       "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:x\nEND:VEVENT\nEND:VCALENDAR\n",
+			"Bad Date":       "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:2026-01-01\nEND:VEVENT\nEND:VCALENDAR\n",
+			"Unterminated":   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20260101\n",
+			"Malformed Line": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART\nEND:VEVENT\nEND:VCALENDAR\n",
+			"Huge Duration":  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20260101\nDURATION:P99999999999999999999D\nEND:VEVENT\nEND:VCALENDAR\n",
+		}
+
+		for name, file := range files {
+			_, err := utils.ParseICalEvents(strings.NewReader(file))
+			assert.ErrorIs(t, err, apperrors.ErrInvalidICalFile, name)
+		}
+	})
+}
+
+func TestBuildICal(t *testing.T) {
+	events := []models.ICalEvent{
+		{UID: "a@x", Start: icalDay(1, 26), End: icalDay(1, 26), Summary: "Republic Day; national, holiday"},
+		{UID: "b@x", Start: icalDay(3, 2), End: icalDay(3, 4), Summary: strings.Repeat("long ", 30), Description: "line one\nline two"},
+	}
+
+	feed := string(utils.BuildICal("Office", events))
+
+	assert.True(t, strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(feed, "END:VCALENDAR\r\n"))
+	assert.Contains(t, feed, "X-WR-CALNAME:Office\r\n")
+	assert.Contains(t, feed, "SUMMARY:Republic Day\\; national\\, holiday\r\n")
+	assert.Contains(t, feed, "DTSTART;VALUE=DATE:20260302\r\nDTEND;VALUE=DATE:20260305\r\n")
+	assert.Contains(t, feed, "DESCRIPTION:line one\\nline two\r\n")
+
+	for _, line := range strings.Split(feed, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+
+	// what is written reads back the same
+	parsed, err := utils.ParseICalEvents(strings.NewReader(feed))
+	assert.NoError(t, err)
+	assert.Len(t, parsed, 2)
+	for i := range events {
+		assert.Equal(t, events[i].UID, parsed[i].UID)
+		assert.Equal(t, events[i].Start, parsed[i].Start)
+		assert.Equal(t, events[i].End, parsed[i].End)
+		assert.Equal(t, strings.TrimSpace(events[i].Summary), parsed[i].Summary)
+		assert.Equal(t, events[i].Description, parsed[i].Description)
+	}
+}
+
+func TestLeaveEvent(t *testing.T) {
+	leave := models.FeedLeave{
+		ID:           7,
+		EmployeeName: "Asha",
+		LeaveType:    "SICK",
+		FromDate:     icalDay(5, 4),
+		ToDate:       icalDay(5, 4),
+		FromSession:  "FULL",
+		ToSession:    "FULL",
+		Days:         1,
+	}
+
+	event := utils.LeaveEvent(leave)
+	assert.Equal(t, "leave-7@rule-based-approval-engine", event.UID)
+	assert.Equal(t, "Asha - SICK leave", event.Summary)
+	assert.Equal(t, "1 day(s) of SICK leave", event.Description)
+
+	half := leave
+	half.FromSession, half.ToSession, half.Days = "SECOND_HALF", "SECOND_HALF", 0.5
+	assert.Equal(t, "Asha - SICK leave (second half)", utils.LeaveEvent(half).Summary)
+
+	hours := leave
+	hours.Hours, hours.Days = 3, 0.375
+	assert.Equal(t, "Asha - SICK leave (3h)", utils.LeaveEvent(hours).Summary)
+
+	span := leave
+	span.ToDate, span.FromSession, span.ToSession, span.Days = icalDay(5, 6), "SECOND_HALF", "FIRST_HALF", 2
+	assert.Equal(t, "2 day(s) of SICK leave, from the second half of 2026-05-04, until the first half of 2026-05-06",
+		utils.LeaveEvent(span).Description)
+}
+
+func TestFeedToken(t *testing.T) {
+	token, hash, err := utils.GenerateFeedToken()
+	assert.NoError(t, err)
+	assert.Len(t, token, 64)
+	assert.Equal(t, hash, utils.HashFeedToken(token))
+	assert.NotEqual(t, token, hash)
+
+	other, _, err := utils.GenerateFeedToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,26 @@ func TestMiscUtils_ApprovalRules(t *testing.T) {
 		// Invalid role combos
 		assert.ErrorIs(t, utils.ValidateApproverRole("INVALID", constants.RoleEmployee), apperrors.ErrUnauthorizedApproval)
 	})
+
+	t.Run("ValidateRequestApprover", func(t *testing.T) {
+		routedUser := int64(7)
+
+		// Unrouted requests use the role hierarchy
+		assert.NoError(t, utils.ValidateRequestApprover(constants.RoleManager, 1, constants.RoleEmployee, "", nil))
+		assert.ErrorIs(t, utils.ValidateRequestApprover(constants.RoleManager, 1, constants.RoleManager, "", nil), apperrors.ErrManagerNeedsAdmin)
+
+		// Routed to a user: only that user or an admin
+		assert.NoError(t, utils.ValidateRequestApprover(constants.RoleManager, 7, constants.RoleManager, "", &routedUser))
+		assert.ErrorIs(t, utils.ValidateRequestApprover(constants.RoleManager, 8, constants.RoleEmployee, "", &routedUser), apperrors.ErrNotRoutedApprover)
+		assert.NoError(t, utils.ValidateRequestApprover(constants.RoleAdmin, 1, constants.RoleEmployee, "", &routedUser))
+
+		// Routed to a role
+		assert.ErrorIs(t, utils.ValidateRequestApprover(constants.RoleManager, 1, constants.RoleEmployee, constants.RoleAdmin, nil), apperrors.ErrNotRoutedApprover)
+		assert.NoError(t, utils.ValidateRequestApprover(constants.RoleManager, 1, constants.RoleEmployee, constants.RoleManager, nil))
+		assert.ErrorIs(t, utils.ValidateRequestApprover(constants.RoleManager, 1, constants.RoleManager, constants.RoleManager, nil), apperrors.ErrManagerNeedsAdmin)
+		assert.NoError(t, utils.ValidateRequestApprover(constants.RoleAdmin, 1, constants.RoleManager, constants.RoleManager, nil))
+		assert.ErrorIs(t, utils.ValidateRequestApprover(constants.RoleEmployee, 7, constants.RoleEmployee, "", &routedUser), apperrors.ErrEmployeeCannotApprove)
+	})
 }
 
 func TestMiscUtils_DateAndWorkingDays(t *testing.T) {
@@ -45,18 +66,28 @@ func TestMiscUtils_DateAndWorkingDays(t *testing.T) {
 		assert.Equal(t, 3, utils.CalculateLeaveDays(from, to))
 	})
 
+	weekdays := models.WorkCalendar{
+		WorkingDays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	}
+
 	t.Run("CountWorkingDays", func(t *testing.T) {
 		from := time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC) // Friday
 		to := time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC)   // Monday
 		// Fri, Sat, Sun, Mon -> Working: Fri, Mon = 2
 
-		assert.Equal(t, 2, utils.CountWorkingDays(from, to, nil))
+		assert.Equal(t, 2, utils.CountWorkingDays(from, to, weekdays))
 
 		// With holiday
-		isHoliday := func(d time.Time) bool {
-			return d.Day() == 9 // Monday is holiday
+		withHoliday := weekdays
+		withHoliday.Holidays = map[string]string{"2023-01-09": "Monday is holiday"}
+		assert.Equal(t, 1, utils.CountWorkingDays(from, to, withHoliday))
+
+		// Fri, Sat on a Sunday to Thursday week -> no working days
+		sundayWeek := models.WorkCalendar{
+			WorkingDays: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday},
 		}
-		assert.Equal(t, 1, utils.CountWorkingDays(from, to, isHoliday))
+		assert.Equal(t, 0, utils.CountWorkingDays(from, from.AddDate(0, 0, 1), sundayWeek))
+		assert.Equal(t, 1, utils.CountWorkingDays(from, from.AddDate(0, 0, 1), weekdays))
 	})
 
 	t.Run("CalculateLeaveDays - Edge Cases", func(t *testing.T) {
@@ -74,6 +105,139 @@ func TestMiscUtils_DateAndWorkingDays(t *testing.T) {
 		from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)   // Sunday
 		to := time.Date(2023, 1, 31, 23, 59, 59, 0, time.UTC) // 31 days
 		// 31 days: 4 weeks (20 working) + 3 days (Sun, Mon, Tue -> 2 working) = 22 working days
-		assert.Equal(t, 22, utils.CountWorkingDays(from, to, nil))
+		assert.Equal(t, 22, utils.CountWorkingDays(from, to, weekdays))
+	})
+}
+
+func TestMiscUtils_NewLeavePeriod(t *testing.T) {
+	mon := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	wed := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		from, to    time.Time
+		fromSession string
+		toSession   string
+		hours       int
+		expected    models.LeavePeriod
+		expectedErr error
+	}{
+		{
+			name: "Full Days", from: mon, to: wed,
+			expected: models.LeavePeriod{FromDate: mon, ToDate: wed, FromSession: constants.SessionFull, ToSession: constants.SessionFull, Days: 3},
+		},
+		{
+			name: "Half Days At Both Ends", from: mon, to: wed, fromSession: "second_half", toSession: constants.SessionFirstHalf,
+			expected: models.LeavePeriod{FromDate: mon, ToDate: wed, FromSession: constants.SessionSecondHalf, ToSession: constants.SessionFirstHalf, Days: 2},
+		},
+		{
+			name: "Single Half Day", from: mon, to: mon, toSession: constants.SessionSecondHalf,
+			expected: models.LeavePeriod{FromDate: mon, ToDate: mon, FromSession: constants.SessionSecondHalf, ToSession: constants.SessionSecondHalf, Days: 0.5},
+		},
+		{
+			name: "Hours", from: mon, to: mon, hours: 3,
+			expected: models.LeavePeriod{FromDate: mon, ToDate: mon, FromSession: constants.SessionFull, ToSession: constants.SessionFull, Hours: 3, Days: 0.375},
+		},
+		{name: "Starts On First Half", from: mon, to: wed, fromSession: constants.SessionFirstHalf, expectedErr: apperrors.ErrInvalidLeaveSession},
+		{name: "Both Halves Of One Day", from: mon, to: mon, fromSession: constants.SessionFirstHalf, toSession: constants.SessionSecondHalf, expectedErr: apperrors.ErrInvalidLeaveSession},
+		{name: "Unknown Session", from: mon, to: mon, fromSession: "EVENING", expectedErr: apperrors.ErrInvalidLeaveSession},
+		{name: "Hours Over Several Days", from: mon, to: wed, hours: 2, expectedErr: apperrors.ErrInvalidLeaveHours},
+		{name: "Hours Of A Whole Day", from: mon, to: mon, hours: constants.LeaveHoursPerDay, expectedErr: apperrors.ErrInvalidLeaveHours},
+		{name: "Hours With A Session", from: mon, to: mon, fromSession: constants.SessionFirstHalf, hours: 2, expectedErr: apperrors.ErrInvalidLeaveHours},
+		{name: "Backward Dates", from: wed, to: mon, expectedErr: apperrors.ErrInvalidLeaveDays},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := utils.NewLeavePeriod(tt.from, tt.to, tt.fromSession, tt.toSession, tt.hours)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, period)
+		})
+	}
+
+	t.Run("IsWholeLeaveHours", func(t *testing.T) {
+		assert.True(t, utils.IsWholeLeaveHours(2))
+		assert.True(t, utils.IsWholeLeaveHours(-0.5))
+		assert.True(t, utils.IsWholeLeaveHours(0.125))
+		assert.False(t, utils.IsWholeLeaveHours(0.1))
 	})
 }
+
+func TestMiscUtils_ChargeLeavePeriod(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	calendar := models.WorkCalendar{
+		WorkingDays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		Holidays:    map[string]string{"2026-03-10": "Holi"},
+	}
+	period := func(from, to time.Time, fromSession, toSession string, hours int) models.LeavePeriod {
+		p, err := utils.NewLeavePeriod(from, to, fromSession, toSession, hours)
+		assert.NoError(t, err)
+		return p
+	}
+
+	tests := []struct {
+		name       string
+		period     models.LeavePeriod
+		sandwich   bool
+		days       float64
+		excluded   []string
+		sandwiched []string
+	}{
+		// 6 March 2026 is a Friday
+		{name: "Friday To Monday", period: period(day(6), day(9), "", "", 0), days: 2, excluded: []string{"2026-03-07", "2026-03-08"}},
+		{name: "Friday To Monday Sandwiched", period: period(day(6), day(9), "", "", 0), sandwich: true, days: 4, sandwiched: []string{"2026-03-07", "2026-03-08"}},
+		{name: "Holiday Is Never Sandwiched", period: period(day(9), day(11), "", "", 0), sandwich: true, days: 2, excluded: []string{"2026-03-10"}},
+		{name: "Weekend At The Edge Is Not Sandwiched", period: period(day(6), day(8), "", "", 0), sandwich: true, days: 1, excluded: []string{"2026-03-07", "2026-03-08"}},
+		{name: "Half Day On A Weekend", period: period(day(6), day(7), "", constants.SessionFirstHalf, 0), days: 1, excluded: []string{"2026-03-07"}},
+		{name: "Half Days At Both Ends", period: period(day(6), day(9), constants.SessionSecondHalf, constants.SessionFirstHalf, 0), days: 1, excluded: []string{"2026-03-07", "2026-03-08"}},
+		{name: "Hours", period: period(day(9), day(9), "", "", 4), days: 0.5},
+		{name: "Only Days Off", period: period(day(7), day(8), "", "", 0), sandwich: true, days: 0, excluded: []string{"2026-03-07", "2026-03-08"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			charged := utils.ChargeLeavePeriod(tt.period, calendar, tt.sandwich)
+			assert.Equal(t, tt.days, charged.Days)
+
+			excluded := []string{}
+			for _, e := range charged.Excluded {
+				excluded = append(excluded, e.Date)
+			}
+			if tt.excluded == nil {
+				tt.excluded = []string{}
+			}
+			if tt.sandwiched == nil {
+				tt.sandwiched = []string{}
+			}
+			assert.Equal(t, tt.excluded, excluded)
+			assert.Equal(t, tt.sandwiched, charged.Sandwiched)
+		})
+	}
+
+	t.Run("Excluded Reasons", func(t *testing.T) {
+		charged := utils.ChargeLeavePeriod(period(day(8), day(10), "", "", 0), calendar, false)
+		assert.Equal(t, []models.ExcludedLeaveDay{
+			{Date: "2026-03-08", Reason: constants.DayOffWeekend},
+			{Date: "2026-03-10", Reason: constants.DayOffHoliday, Description: "Holi"},
+		}, charged.Excluded)
+	})
+}
+
+func TestMiscUtils_ParseWorkWeek(t *testing.T) {
+	days, err := utils.ParseWorkWeek([]string{" saturday", "Friday", "SUNDAY"})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Weekday{time.Sunday, time.Friday, time.Saturday}, days)
+
+	_, err = utils.ParseWorkWeek([]string{"Monday", "monday"})
+	assert.ErrorIs(t, err, apperrors.ErrInvalidWorkWeek)
+
+	_, err = utils.ParseWorkWeek([]string{"Mon"})
+	assert.ErrorIs(t, err, apperrors.ErrInvalidWorkWeek)
+
+	_, err = utils.ParseWorkWeek(nil)
+	assert.ErrorIs(t, err, apperrors.ErrInvalidWorkWeek)
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleAnalysis_AnalyzeRuleSet(t *testing.T) {
+	rule := func(id int64, priority int, action string, condition map[string]interface{}) models.Rule {
+		return models.Rule{ID: id, Priority: priority, Action: action, Condition: condition}
+	}
+	daysAtMost := func(days float64) map[string]interface{} {
+		return map[string]interface{}{"field": "days", "op": "lte", "value": days}
+	}
+	sickLeave := map[string]interface{}{"field": "leave_type", "op": "eq", "value": "SICK"}
+
+	tests := []struct {
+		name     string
+		mode     string
+		rules    []models.Rule
+		expected []models.RuleIssue
+	}{
+		{
+			name: "Disjoint Rules",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, daysAtMost(2)),
+				rule(2, 20, constants.ActionAutoReject, map[string]interface{}{"field": "days", "op": "gt", "value": 10.0}),
+			},
+		},
+		{
+			name: "Condition Never True",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, map[string]interface{}{"all": []interface{}{
+					map[string]interface{}{"field": "days", "op": "gt", "value": 5.0},
+					map[string]interface{}{"field": "days", "op": "lt", "value": 3.0},
+				}}),
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueUnreachable, Severity: constants.SeverityError, RuleIDs: []int64{1}},
+			},
+		},
+		{
+			name: "Shadowed By Higher Priority Rule",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, daysAtMost(5)),
+				rule(2, 20, constants.ActionManual, daysAtMost(2)),
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueUnreachable, Severity: constants.SeverityWarning, RuleIDs: []int64{2, 1}},
+			},
+		},
+		{
+			name: "Tiered Rules Overlap",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, daysAtMost(2)),
+				rule(2, 20, constants.ActionManual, daysAtMost(5)),
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueOverlap, Severity: constants.SeverityWarning, RuleIDs: []int64{1, 2}},
+			},
+		},
+		{
+			name: "Same Priority Different Actions",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, daysAtMost(2)),
+				rule(2, 10, constants.ActionAutoReject, sickLeave),
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueContradiction, Severity: constants.SeverityError, RuleIDs: []int64{1, 2}},
+			},
+		},
+		{
+			name: "All Match Rule Always Overruled",
+			mode: constants.RuleModeAllMatch,
+			rules: []models.Rule{
+				rule(1, 10, constants.ActionAutoReject, daysAtMost(5)),
+				rule(2, 20, constants.StatusAutoApprove, daysAtMost(2)),
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueUnreachable, Severity: constants.SeverityWarning, RuleIDs: []int64{2, 1}},
+			},
+		},
+		{
+			name: "Default Rule Never Used",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, daysAtMost(2)),
+				rule(2, 20, constants.ActionManual, map[string]interface{}{"field": "days", "op": "gt", "value": 2.0}),
+				{ID: 3, IsDefault: true, Action: constants.ActionManual},
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueUnreachable, Severity: constants.SeverityWarning, RuleIDs: []int64{3}},
+			},
+		},
+		{
+			name: "Broken Stored Condition",
+			rules: []models.Rule{
+				rule(1, 10, constants.StatusAutoApprove, map[string]interface{}{"field": "amount", "op": "lte", "value": 10.0}),
+			},
+			expected: []models.RuleIssue{
+				{Kind: constants.IssueUnreachable, Severity: constants.SeverityError, RuleIDs: []int64{1}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode := tt.mode
+			if mode == "" {
+				mode = constants.RuleModeFirstMatch
+			}
+
+			issues := utils.AnalyzeRuleSet(models.RuleSet{
+				RequestType:    "LEAVE",
+				GradeID:        1,
+				EvaluationMode: mode,
+				Rules:          tt.rules,
+			})
+
+			assert.Len(t, issues, len(tt.expected))
+			for i, expected := range tt.expected {
+				if i >= len(issues) {
+					break
+				}
+				assert.Equal(t, expected.Kind, issues[i].Kind)
+				assert.Equal(t, expected.Severity, issues[i].Severity)
+				assert.Equal(t, expected.RuleIDs, issues[i].RuleIDs)
+				assert.Equal(t, "LEAVE", issues[i].RequestType)
+				assert.NotEmpty(t, issues[i].Message)
+			}
+		})
+	}
+}
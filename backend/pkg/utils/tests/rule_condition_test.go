@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleCondition_Evaluate(t *testing.T) {
+	travelUnder8000 := map[string]interface{}{
+		"all": []interface{}{
+			map[string]interface{}{"field": "category", "op": "eq", "value": "travel"},
+			map[string]interface{}{"field": "amount", "op": "lte", "value": 8000.0},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		requestType string
+		condition   map[string]interface{}
+		facts       utils.RequestFacts
+		expected    bool
+	}{
+		{
+			name:        "All - Both Match",
+			requestType: "EXPENSE",
+			condition:   travelUnder8000,
+			facts:       utils.RequestFacts{Category: "Travel", Amount: 7500},
+			expected:    true,
+		},
+		{
+			name:        "All - Amount Too High",
+			requestType: "EXPENSE",
+			condition:   travelUnder8000,
+			facts:       utils.RequestFacts{Category: "travel", Amount: 9000},
+			expected:    false,
+		},
+		{
+			name:        "All - Wrong Category",
+			requestType: "EXPENSE",
+			condition:   travelUnder8000,
+			facts:       utils.RequestFacts{Category: "food", Amount: 100},
+			expected:    false,
+		},
+		{
+			name:        "Any - Second Branch Matches",
+			requestType: "LEAVE",
+			condition: map[string]interface{}{
+				"any": []interface{}{
+					map[string]interface{}{"field": "days", "op": "lte", "value": 1.0},
+					map[string]interface{}{"field": "leave_type", "op": "in", "value": []interface{}{"SICK", "BEREAVEMENT"}},
+				},
+			},
+			facts:    utils.RequestFacts{Days: 4, LeaveType: "sick"},
+			expected: true,
+		},
+		{
+			name:        "Not - Excludes Weekday",
+			requestType: "LEAVE",
+			condition: map[string]interface{}{
+				"not": map[string]interface{}{"field": "day_of_week", "op": "eq", "value": "Friday"},
+			},
+			facts:    utils.RequestFacts{DayOfWeek: time.Friday},
+			expected: false,
+		},
+		{
+			name:        "Requester Role And Tenure",
+			requestType: "DISCOUNT",
+			condition: map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"field": "role", "op": "neq", "value": "MANAGER"},
+					map[string]interface{}{"field": "tenure_days", "op": "gte", "value": 180.0},
+					map[string]interface{}{"field": "percent", "op": "lt", "value": 15.0},
+				},
+			},
+			facts:    utils.RequestFacts{Role: "EMPLOYEE", TenureDays: 365, Percent: 10},
+			expected: true,
+		},
+		{
+			name:        "Legacy Shorthand",
+			requestType: "LEAVE",
+			condition:   map[string]interface{}{"max_days": 3.0},
+			facts:       utils.RequestFacts{Days: 3},
+			expected:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, err := utils.ParseCondition(tt.requestType, tt.condition)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cond.Evaluate(tt.facts))
+		})
+	}
+}
+
+func TestRuleCondition_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestType   string
+		condition     map[string]interface{}
+		expectedError error
+	}{
+		{
+			name:          "Unknown Request Type",
+			requestType:   "TRAVEL",
+			condition:     map[string]interface{}{"max_days": 3.0},
+			expectedError: apperrors.ErrInvalidRequestType,
+		},
+		{
+			name:          "Empty Condition",
+			requestType:   "LEAVE",
+			condition:     map[string]interface{}{},
+			expectedError: apperrors.ErrConditionRequired,
+		},
+		{
+			name:          "Field Not Valid For Type",
+			requestType:   "LEAVE",
+			condition:     map[string]interface{}{"field": "amount", "op": "lte", "value": 10.0},
+			expectedError: apperrors.ErrUnknownConditionField,
+		},
+		{
+			name:          "Legacy Key For Wrong Type",
+			requestType:   "EXPENSE",
+			condition:     map[string]interface{}{"max_days": 3.0},
+			expectedError: apperrors.ErrUnknownConditionField,
+		},
+		{
+			name:          "Ordering Operator On Text Field",
+			requestType:   "EXPENSE",
+			condition:     map[string]interface{}{"field": "category", "op": "lt", "value": "travel"},
+			expectedError: apperrors.ErrUnsupportedConditionOperator,
+		},
+		{
+			name:          "Unknown Operator",
+			requestType:   "EXPENSE",
+			condition:     map[string]interface{}{"field": "amount", "op": "between", "value": 10.0},
+			expectedError: apperrors.ErrUnsupportedConditionOperator,
+		},
+		{
+			name:          "String Value For Numeric Field",
+			requestType:   "EXPENSE",
+			condition:     map[string]interface{}{"field": "amount", "op": "lte", "value": "8000"},
+			expectedError: apperrors.ErrConditionTypeMismatch,
+		},
+		{
+			name:          "In Without List",
+			requestType:   "LEAVE",
+			condition:     map[string]interface{}{"field": "leave_type", "op": "in", "value": "SICK"},
+			expectedError: apperrors.ErrConditionTypeMismatch,
+		},
+		{
+			name:          "Invalid Weekday",
+			requestType:   "LEAVE",
+			condition:     map[string]interface{}{"field": "day_of_week", "op": "eq", "value": "Funday"},
+			expectedError: apperrors.ErrConditionTypeMismatch,
+		},
+		{
+			name:          "Mixed Node Keys",
+			requestType:   "LEAVE",
+			condition:     map[string]interface{}{"all": []interface{}{}, "field": "days"},
+			expectedError: apperrors.ErrInvalidCondition,
+		},
+		{
+			name:          "Empty All",
+			requestType:   "LEAVE",
+			condition:     map[string]interface{}{"all": []interface{}{}},
+			expectedError: apperrors.ErrInvalidCondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := utils.ParseCondition(tt.requestType, tt.condition)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestRuleCondition_PinnedLeaveType(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "Single Type",
+			condition: map[string]interface{}{"field": "leave_type", "op": "eq", "value": "sick"},
+			expected:  "SICK",
+		},
+		{
+			name: "All With Type",
+			condition: map[string]interface{}{"all": []interface{}{
+				map[string]interface{}{"field": "days", "op": "lte", "value": 5.0},
+				map[string]interface{}{"field": "leave_type", "op": "in", "value": []interface{}{"SICK"}},
+			}},
+			expected: "SICK",
+		},
+		{
+			name: "Any Of Two Types",
+			condition: map[string]interface{}{"any": []interface{}{
+				map[string]interface{}{"field": "leave_type", "op": "eq", "value": "SICK"},
+				map[string]interface{}{"field": "leave_type", "op": "eq", "value": "CASUAL"},
+			}},
+		},
+		{
+			name:      "Excluded Type",
+			condition: map[string]interface{}{"not": map[string]interface{}{"field": "leave_type", "op": "eq", "value": "SICK"}},
+		},
+		{
+			name:      "No Type",
+			condition: map[string]interface{}{"field": "days", "op": "lte", "value": 5.0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, err := utils.ParseCondition("LEAVE", tt.condition)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, utils.PinnedLeaveType(cond))
+		})
+	}
+}
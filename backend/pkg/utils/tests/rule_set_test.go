@@ -0,0 +1,328 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestRuleSet_MakeRuleSetDecision(t *testing.T) {
+	smallTravel := models.Rule{
+		ID:        1,
+		Priority:  10,
+		Action:    constants.StatusAutoApprove,
+		Condition: map[string]interface{}{"field": "amount", "op": "lte", "value": 5000.0},
+	}
+	largeTravel := models.Rule{
+		ID:        2,
+		Priority:  20,
+		Action:    constants.ActionManual,
+		Condition: map[string]interface{}{"field": "category", "op": "eq", "value": "travel"},
+	}
+	luxuryRejected := models.Rule{
+		ID:        4,
+		Priority:  5,
+		Action:    constants.ActionAutoReject,
+		Message:   "Luxury items are not reimbursable",
+		Condition: map[string]interface{}{"field": "category", "op": "eq", "value": "luxury"},
+	}
+	routeToFinance := models.Rule{
+		ID:            5,
+		Priority:      30,
+		Action:        constants.ActionRouteTo,
+		RouteToUserID: int64Ptr(42),
+		Condition:     map[string]interface{}{"field": "amount", "op": "gt", "value": 20000.0},
+	}
+	fallback := models.Rule{
+		ID:        3,
+		Priority:  100,
+		Action:    constants.StatusAutoApprove,
+		IsDefault: true,
+	}
+
+	tests := []struct {
+		name     string
+		mode     string
+		rules    []models.Rule
+		facts    utils.RequestFacts
+		expected utils.DecisionResult
+	}{
+		{
+			name:  "First Match - Highest Priority Wins",
+			mode:  constants.RuleModeFirstMatch,
+			rules: []models.Rule{smallTravel, largeTravel},
+			facts: utils.RequestFacts{Amount: 1000, Category: "travel"},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusAutoApproved,
+				Message:        "EXPENSE approved by system",
+				Action:         constants.StatusAutoApprove,
+				RuleID:         int64Ptr(1),
+				MatchedRuleIDs: []int64{1},
+			},
+		},
+		{
+			name:  "First Match - Falls Through To Next Rule",
+			mode:  constants.RuleModeFirstMatch,
+			rules: []models.Rule{smallTravel, largeTravel},
+			facts: utils.RequestFacts{Amount: 9000, Category: "travel"},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusPending,
+				Message:        "EXPENSE submitted for approval",
+				Action:         constants.ActionManual,
+				RuleID:         int64Ptr(2),
+				MatchedRuleIDs: []int64{2},
+			},
+		},
+		{
+			name:  "All Match - Most Restrictive Action Wins",
+			mode:  constants.RuleModeAllMatch,
+			rules: []models.Rule{smallTravel, largeTravel},
+			facts: utils.RequestFacts{Amount: 1000, Category: "travel"},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusPending,
+				Message:        "EXPENSE submitted for approval",
+				Action:         constants.ActionManual,
+				RuleID:         int64Ptr(2),
+				MatchedRuleIDs: []int64{1, 2},
+			},
+		},
+		{
+			name:  "Default Rule Used When Nothing Matches",
+			mode:  constants.RuleModeFirstMatch,
+			rules: []models.Rule{smallTravel, largeTravel, fallback},
+			facts: utils.RequestFacts{Amount: 9000, Category: "food"},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusAutoApproved,
+				Message: "EXPENSE approved by system",
+				Action:  constants.StatusAutoApprove,
+				RuleID:  int64Ptr(3),
+			},
+		},
+		{
+			name:  "No Match And No Default Goes To Review",
+			mode:  constants.RuleModeFirstMatch,
+			rules: []models.Rule{smallTravel, largeTravel},
+			facts: utils.RequestFacts{Amount: 9000, Category: "food"},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusPending,
+				Message: "EXPENSE submitted for approval",
+				Action:  constants.ActionManual,
+			},
+		},
+		{
+			name:  "Auto Reject With Policy Message",
+			mode:  constants.RuleModeFirstMatch,
+			rules: []models.Rule{luxuryRejected, smallTravel},
+			facts: utils.RequestFacts{Amount: 100, Category: "luxury"},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusAutoRejected,
+				Message:        "Luxury items are not reimbursable",
+				Action:         constants.ActionAutoReject,
+				RuleID:         int64Ptr(4),
+				MatchedRuleIDs: []int64{4},
+			},
+		},
+		{
+			name:  "All Match - Route To Beats Manual",
+			mode:  constants.RuleModeAllMatch,
+			rules: []models.Rule{largeTravel, routeToFinance},
+			facts: utils.RequestFacts{Amount: 25000, Category: "travel"},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusPending,
+				Message:        "EXPENSE submitted for approval",
+				Action:         constants.ActionRouteTo,
+				RuleID:         int64Ptr(5),
+				MatchedRuleIDs: []int64{2, 5},
+				RouteToUserID:  int64Ptr(42),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := models.RuleSet{RequestType: "EXPENSE", EvaluationMode: tt.mode, Rules: tt.rules}
+			result := utils.MakeRuleSetDecision(set, tt.facts)
+			if assert.NotNil(t, result.Trace) {
+				assert.Equal(t, tt.expected.Status, result.Trace.Outcome)
+				assert.Equal(t, tt.expected.RuleID, result.Trace.RuleID)
+			}
+			result.Trace = nil
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestRuleSet_DecisionTrace(t *testing.T) {
+	set := models.RuleSet{
+		RequestType:    "EXPENSE",
+		EvaluationMode: constants.RuleModeFirstMatch,
+		Rules: []models.Rule{
+			{
+				ID:     1,
+				Action: constants.StatusAutoApprove,
+				Condition: map[string]interface{}{
+					"all": []interface{}{
+						map[string]interface{}{"field": "category", "op": "in", "value": []interface{}{"travel", "food"}},
+						map[string]interface{}{"field": "amount", "op": "lte", "value": 8000.0},
+					},
+				},
+			},
+			{ID: 2, Action: constants.ActionManual, IsDefault: true},
+		},
+	}
+
+	result := utils.MakeRuleSetDecision(set, utils.RequestFacts{Category: "travel", Amount: 9500})
+
+	expected := &models.DecisionTrace{
+		RequestType:    "EXPENSE",
+		EvaluationMode: constants.RuleModeFirstMatch,
+		Rules: []models.RuleTrace{
+			{
+				RuleID: 1,
+				Action: constants.StatusAutoApprove,
+				Conditions: []models.ConditionTrace{
+					{Field: "category", Op: "in", Actual: "travel", Threshold: []string{"travel", "food"}, Matched: true},
+					{Field: "amount", Op: "lte", Actual: 9500.0, Threshold: 8000.0, Matched: false},
+				},
+			},
+			{RuleID: 2, Action: constants.ActionManual, IsDefault: true, Matched: true},
+		},
+		RuleID:  int64Ptr(2),
+		Action:  constants.ActionManual,
+		Outcome: constants.StatusPending,
+		Reason:  "no rule matched, default rule 2 applied",
+	}
+	assert.Equal(t, expected, result.Trace)
+}
+
+func TestRuleSet_LegacyConditions(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestType string
+		condition   map[string]interface{}
+		facts       utils.RequestFacts
+		expected    utils.DecisionResult
+		ruleError   bool
+	}{
+		{
+			name:        "Leave Within Max Days",
+			requestType: "LEAVE",
+			condition:   map[string]interface{}{"max_days": 5.0},
+			facts:       utils.RequestFacts{Days: 3},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusAutoApproved,
+				Message:        "LEAVE approved by system",
+				Action:         constants.StatusAutoApprove,
+				RuleID:         int64Ptr(1),
+				MatchedRuleIDs: []int64{1},
+			},
+		},
+		{
+			name:        "Leave Over Max Days",
+			requestType: "LEAVE",
+			condition:   map[string]interface{}{"max_days": 5.0},
+			facts:       utils.RequestFacts{Days: 6},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusPending,
+				Message: "LEAVE submitted for approval",
+				Action:  constants.ActionManual,
+			},
+		},
+		{
+			name:        "Expense Within Max Amount",
+			requestType: "EXPENSE",
+			condition:   map[string]interface{}{"max_amount": 100.0},
+			facts:       utils.RequestFacts{Amount: 50},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusAutoApproved,
+				Message:        "EXPENSE approved by system",
+				Action:         constants.StatusAutoApprove,
+				RuleID:         int64Ptr(1),
+				MatchedRuleIDs: []int64{1},
+			},
+		},
+		{
+			name:        "Expense Over Max Amount",
+			requestType: "EXPENSE",
+			condition:   map[string]interface{}{"max_amount": 100.0},
+			facts:       utils.RequestFacts{Amount: 150},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusPending,
+				Message: "EXPENSE submitted for approval",
+				Action:  constants.ActionManual,
+			},
+		},
+		{
+			name:        "Discount Within Max Percent",
+			requestType: "DISCOUNT",
+			condition:   map[string]interface{}{"max_percent": 20.0},
+			facts:       utils.RequestFacts{Percent: 15},
+			expected: utils.DecisionResult{
+				Status:         constants.StatusAutoApproved,
+				Message:        "DISCOUNT approved by system",
+				Action:         constants.StatusAutoApprove,
+				RuleID:         int64Ptr(1),
+				MatchedRuleIDs: []int64{1},
+			},
+		},
+		{
+			name:        "Discount Over Max Percent",
+			requestType: "DISCOUNT",
+			condition:   map[string]interface{}{"max_percent": 20.0},
+			facts:       utils.RequestFacts{Percent: 25},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusPending,
+				Message: "DISCOUNT submitted for approval",
+				Action:  constants.ActionManual,
+			},
+		},
+		{
+			name:        "Malformed Condition",
+			requestType: "LEAVE",
+			condition:   map[string]interface{}{"max_days": "invalid"},
+			facts:       utils.RequestFacts{Days: 3},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusPending,
+				Message: "LEAVE submitted for approval",
+				Action:  constants.ActionManual,
+			},
+			ruleError: true,
+		},
+		{
+			name:        "Unknown Request Type",
+			requestType: "UNKNOWN",
+			condition:   map[string]interface{}{"max_days": 5.0},
+			facts:       utils.RequestFacts{Days: 3},
+			expected: utils.DecisionResult{
+				Status:  constants.StatusPending,
+				Message: "UNKNOWN submitted for approval",
+				Action:  constants.ActionManual,
+			},
+			ruleError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := models.RuleSet{
+				RequestType:    tt.requestType,
+				EvaluationMode: constants.RuleModeFirstMatch,
+				Rules:          []models.Rule{{ID: 1, Action: constants.StatusAutoApprove, Condition: tt.condition}},
+			}
+			result := utils.MakeRuleSetDecision(set, tt.facts)
+			if assert.NotNil(t, result.Trace) && assert.Len(t, result.Trace.Rules, 1) {
+				assert.Equal(t, tt.expected.Status, result.Trace.Outcome)
+				assert.Equal(t, tt.ruleError, result.Trace.Rules[0].Error != "")
+			}
+			result.Trace = nil
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
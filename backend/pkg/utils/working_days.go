@@ -1,18 +1,28 @@
 package utils
 
 import (
+	"strings"
 	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 )
 
-// CountWorkingDays counts business days between two dates, skipping weekends and holidays
-func CountWorkingDays(from, to time.Time, isHoliday func(time.Time) bool) int {
-	days := 0
+// CountWorkingDays counts the working days between two dates on a work calendar,
+// skipping its days off and holidays
+func CountWorkingDays(from, to time.Time, calendar models.WorkCalendar) int {
+	working := map[time.Weekday]bool{}
+	for _, d := range calendar.WorkingDays {
+		working[d] = true
+	}
 
+	days := 0
 	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
-		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+		if !working[d.Weekday()] {
 			continue
 		}
-		if isHoliday != nil && isHoliday(d) {
+		if _, ok := calendar.Holidays[d.Format("2006-01-02")]; ok {
 			continue
 		}
 		days++
@@ -20,3 +30,113 @@ func CountWorkingDays(from, to time.Time, isHoliday func(time.Time) bool) int {
 
 	return days
 }
+
+// ParseWorkWeek reads weekday names (any case) into the working days of a week, in
+// week order. A week needs at least one working day and names each day once.
+func ParseWorkWeek(names []string) ([]time.Weekday, error) {
+	working := map[time.Weekday]bool{}
+	for _, name := range names {
+		day, ok := parseWeekday(name)
+		if !ok || working[day] {
+			return nil, apperrors.ErrInvalidWorkWeek
+		}
+		working[day] = true
+	}
+
+	if len(working) == 0 {
+		return nil, apperrors.ErrInvalidWorkWeek
+	}
+
+	days := make([]time.Weekday, 0, len(working))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if working[d] {
+			days = append(days, d)
+		}
+	}
+	return days, nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.TrimSpace(name)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// ChargeLeavePeriod works out what a leave period costs on a work calendar: days off in
+// the working week and holidays are not charged. Under the sandwich rule, days off in
+// the working week that fall between two charged dates of the period are charged as
+// full days; holidays never are. A half-day session or hours on a date that is not
+// charged cost nothing.
+func ChargeLeavePeriod(period models.LeavePeriod, calendar models.WorkCalendar, sandwich bool) models.LeavePeriod {
+	working := map[time.Weekday]bool{}
+	for _, d := range calendar.WorkingDays {
+		working[d] = true
+	}
+
+	type leaveDate struct {
+		date     time.Time
+		charge   float64
+		excluded *models.ExcludedLeaveDay
+	}
+
+	var dates []leaveDate
+	first, last := -1, -1
+	for d := period.FromDate; !d.After(period.ToDate); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		entry := leaveDate{date: d}
+
+		if description, ok := calendar.Holidays[key]; ok {
+			entry.excluded = &models.ExcludedLeaveDay{Date: key, Reason: constants.DayOffHoliday, Description: description}
+		} else if !working[d.Weekday()] {
+			entry.excluded = &models.ExcludedLeaveDay{Date: key, Reason: constants.DayOffWeekend}
+		} else {
+			entry.charge = dateCharge(period, d)
+			if first < 0 {
+				first = len(dates)
+			}
+			last = len(dates)
+		}
+
+		dates = append(dates, entry)
+	}
+
+	period.Days = 0
+	period.Excluded = []models.ExcludedLeaveDay{}
+	period.Sandwiched = []string{}
+	for i, entry := range dates {
+		if entry.excluded == nil {
+			period.Days += entry.charge
+			continue
+		}
+
+		if sandwich && entry.excluded.Reason == constants.DayOffWeekend && first < i && i < last {
+			period.Days++
+			period.Sandwiched = append(period.Sandwiched, entry.excluded.Date)
+			continue
+		}
+		period.Excluded = append(period.Excluded, *entry.excluded)
+	}
+
+	return period
+}
+
+// dateCharge is what a working date of a period costs: a session takes half of it and
+// hours their share of a working day
+func dateCharge(period models.LeavePeriod, d time.Time) float64 {
+	if period.Hours > 0 {
+		return float64(period.Hours) / constants.LeaveHoursPerDay
+	}
+
+	charge := 1.0
+	if d.Equal(period.FromDate) && period.FromSession != constants.SessionFull {
+		charge = 0.5
+	}
+	if d.Equal(period.ToDate) && period.ToSession != constants.SessionFull {
+		charge = 0.5
+	}
+	return charge
+}
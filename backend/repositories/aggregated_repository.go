@@ -12,44 +12,47 @@ import (
 
 const (
 	aggQueryFetchAllLeaves = `
-		SELECT id, leave_type, from_date, to_date, status::TEXT, reason, approval_comment, created_at
+		SELECT id, leave_type, from_date, to_date, from_session, to_session, hours, days::FLOAT8, status::TEXT, reason, approval_comment, created_at, decision_trace
 		FROM leave_requests WHERE employee_id = $1
 	`
 	aggQueryFetchAllExpenses = `
-		SELECT id, amount, category, status::TEXT, reason, approval_comment, created_at
+		SELECT id, amount, category, status::TEXT, reason, approval_comment, created_at, decision_trace
 		FROM expense_requests WHERE employee_id = $1
 	`
 	aggQueryFetchAllDiscounts = `
-		SELECT id, discount_percentage, status::TEXT, reason, approval_comment, created_at
+		SELECT id, discount_percentage, status::TEXT, reason, approval_comment, created_at, decision_trace
 		FROM discount_requests WHERE employee_id = $1
 	`
 	aggQueryFetchPendingLeavesForManager = `
-		SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.status::TEXT, lr.created_at
+		SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.from_session, lr.to_session, lr.hours, lr.days::FLOAT8, lr.leave_type, lr.reason, lr.status::TEXT, lr.created_at, lr.decision_trace
 		FROM leave_requests lr JOIN users u ON lr.employee_id = u.id
-		WHERE lr.status = 'PENDING' AND u.manager_id = $1
+		WHERE lr.status = 'PENDING'
+		  AND ((lr.routed_to_role IS NULL AND lr.routed_to_user_id IS NULL AND u.manager_id = ANY($1)) OR lr.routed_to_user_id = ANY($1) OR (lr.routed_to_role = 'MANAGER' AND u.role = 'EMPLOYEE'))
 	`
 	aggQueryFetchPendingLeavesForAdmin = `
-		SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.status::TEXT, lr.created_at
+		SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.from_session, lr.to_session, lr.hours, lr.days::FLOAT8, lr.leave_type, lr.reason, lr.status::TEXT, lr.created_at, lr.decision_trace
 		FROM leave_requests lr JOIN users u ON lr.employee_id = u.id
 		WHERE lr.status = 'PENDING'
 	`
 	aggQueryFetchPendingExpensesForManager = `
-		SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.status::TEXT, er.created_at
+		SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.status::TEXT, er.created_at, er.decision_trace
 		FROM expense_requests er JOIN users u ON er.employee_id = u.id
-		WHERE er.status = 'PENDING' AND u.manager_id = $1
+		WHERE er.status = 'PENDING'
+		  AND ((er.routed_to_role IS NULL AND er.routed_to_user_id IS NULL AND u.manager_id = ANY($1)) OR er.routed_to_user_id = ANY($1) OR (er.routed_to_role = 'MANAGER' AND u.role = 'EMPLOYEE'))
 	`
 	aggQueryFetchPendingExpensesForAdmin = `
-		SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.status::TEXT, er.created_at
+		SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.status::TEXT, er.created_at, er.decision_trace
 		FROM expense_requests er JOIN users u ON er.employee_id = u.id
 		WHERE er.status = 'PENDING'
 	`
 	aggQueryFetchPendingDiscountsForManager = `
-		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.status::TEXT, dr.created_at
+		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.status::TEXT, dr.created_at, dr.decision_trace
 		FROM discount_requests dr JOIN users u ON dr.employee_id = u.id
-		WHERE dr.status = 'PENDING' AND u.manager_id = $1
+		WHERE dr.status = 'PENDING'
+		  AND ((dr.routed_to_role IS NULL AND dr.routed_to_user_id IS NULL AND u.manager_id = ANY($1)) OR dr.routed_to_user_id = ANY($1) OR (dr.routed_to_role = 'MANAGER' AND u.role = 'EMPLOYEE'))
 	`
 	aggQueryFetchPendingDiscountsForAdmin = `
-		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.status::TEXT, dr.created_at
+		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.status::TEXT, dr.created_at, dr.decision_trace
 		FROM discount_requests dr JOIN users u ON dr.employee_id = u.id
 		WHERE dr.status = 'PENDING'
 	`
@@ -69,7 +72,7 @@ type aggCombinedReq struct {
 	reqType   string
 }
 
-func (r *aggregatedRepository) GetPendingAllRequests(ctx context.Context, role string, approverID int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error) {
+func (r *aggregatedRepository) GetPendingAllRequests(ctx context.Context, role string, approverIDs []int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error) {
 	leaves = []map[string]interface{}{}
 	expenses = []map[string]interface{}{}
 	discounts = []map[string]interface{}{}
@@ -77,28 +80,28 @@ func (r *aggregatedRepository) GetPendingAllRequests(ctx context.Context, role s
 	var lReqs, eReqs, dReqs []aggCombinedReq
 
 	if role == constants.RoleAdmin {
-		lReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingLeavesForAdmin, "LEAVE", 0)
+		lReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingLeavesForAdmin, "LEAVE", nil)
 		if err != nil {
 			return
 		}
-		eReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingExpensesForAdmin, "EXPENSE", 0)
+		eReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingExpensesForAdmin, "EXPENSE", nil)
 		if err != nil {
 			return
 		}
-		dReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingDiscountsForAdmin, "DISCOUNT", 0)
+		dReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingDiscountsForAdmin, "DISCOUNT", nil)
 		if err != nil {
 			return
 		}
 	} else {
-		lReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingLeavesForManager, "LEAVE", approverID)
+		lReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingLeavesForManager, "LEAVE", approverIDs)
 		if err != nil {
 			return
 		}
-		eReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingExpensesForManager, "EXPENSE", approverID)
+		eReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingExpensesForManager, "EXPENSE", approverIDs)
 		if err != nil {
 			return
 		}
-		dReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingDiscountsForManager, "DISCOUNT", approverID)
+		dReqs, err = r.fetchPendingRequests(ctx, aggQueryFetchPendingDiscountsForManager, "DISCOUNT", approverIDs)
 		if err != nil {
 			return
 		}
@@ -138,11 +141,11 @@ func (r *aggregatedRepository) GetPendingAllRequests(ctx context.Context, role s
 	return
 }
 
-func (r *aggregatedRepository) fetchPendingRequests(ctx context.Context, query, reqType string, approverID int64) ([]aggCombinedReq, error) {
+func (r *aggregatedRepository) fetchPendingRequests(ctx context.Context, query, reqType string, approverIDs []int64) ([]aggCombinedReq, error) {
 	var rows interfaces.Rows
 	var err error
-	if approverID > 0 {
-		rows, err = r.db.Query(ctx, query, approverID)
+	if len(approverIDs) > 0 {
+		rows, err = r.db.Query(ctx, query, approverIDs)
 	} else {
 		rows, err = r.db.Query(ctx, query)
 	}
@@ -160,27 +163,37 @@ func (r *aggregatedRepository) fetchPendingRequests(ctx context.Context, query,
 				employeeID int64
 				name       string
 				from, to   time.Time
+				fromSess   string
+				toSess     string
+				hours      int
+				days       float64
 				lType      string
 				reason     string
 				status     string
 				created    time.Time
+				trace      []byte
 			)
-			if err := rows.Scan(&id, &employeeID, &name, &from, &to, &lType, &reason, &status, &created); err != nil {
+			if err := rows.Scan(&id, &employeeID, &name, &from, &to, &fromSess, &toSess, &hours, &days, &lType, &reason, &status, &created, &trace); err != nil {
 				return nil, utils.MapPgError(err)
 			}
 			res = append(res, aggCombinedReq{
 				reqType:   "LEAVE",
 				createdAt: created,
 				data: map[string]interface{}{
-					"id":         id,
-					"user_id":    employeeID,
-					"employee":   name,
-					"from_date":  from.Format("2006-01-02"),
-					"to_date":    to.Format("2006-01-02"),
-					"leave_type": lType,
-					"reason":     reason,
-					"status":     status,
-					"created_at": created.Format(time.RFC3339),
+					"id":             id,
+					"user_id":        employeeID,
+					"employee":       name,
+					"from_date":      from.Format("2006-01-02"),
+					"to_date":        to.Format("2006-01-02"),
+					"from_session":   fromSess,
+					"to_session":     toSess,
+					"hours":          hours,
+					"days":           days,
+					"leave_type":     lType,
+					"reason":         reason,
+					"status":         status,
+					"created_at":     created.Format(time.RFC3339),
+					"decision_trace": decisionTraceValue(trace),
 				},
 			})
 		case "EXPENSE":
@@ -193,22 +206,24 @@ func (r *aggregatedRepository) fetchPendingRequests(ctx context.Context, query,
 				reason     *string
 				status     string
 				created    time.Time
+				trace      []byte
 			)
-			if err := rows.Scan(&id, &employeeID, &name, &amount, &cat, &reason, &status, &created); err != nil {
+			if err := rows.Scan(&id, &employeeID, &name, &amount, &cat, &reason, &status, &created, &trace); err != nil {
 				return nil, utils.MapPgError(err)
 			}
 			res = append(res, aggCombinedReq{
 				reqType:   "EXPENSE",
 				createdAt: created,
 				data: map[string]interface{}{
-					"id":         id,
-					"user_id":    employeeID,
-					"employee":   name,
-					"amount":     amount,
-					"category":   cat,
-					"reason":     reason,
-					"status":     status,
-					"created_at": created.Format(time.RFC3339),
+					"id":             id,
+					"user_id":        employeeID,
+					"employee":       name,
+					"amount":         amount,
+					"category":       cat,
+					"reason":         reason,
+					"status":         status,
+					"created_at":     created.Format(time.RFC3339),
+					"decision_trace": decisionTraceValue(trace),
 				},
 			})
 		case "DISCOUNT":
@@ -220,8 +235,9 @@ func (r *aggregatedRepository) fetchPendingRequests(ctx context.Context, query,
 				reason     string
 				status     string
 				created    interface{}
+				trace      []byte
 			)
-			if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &status, &created); err != nil {
+			if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &status, &created, &trace); err != nil {
 				return nil, utils.MapPgError(err)
 			}
 
@@ -246,6 +262,7 @@ func (r *aggregatedRepository) fetchPendingRequests(ctx context.Context, query,
 					"reason":              reason,
 					"status":              status,
 					"created_at":          createdAt.Format(time.RFC3339),
+					"decision_trace":      decisionTraceValue(trace),
 				},
 			})
 		}
@@ -266,12 +283,17 @@ func (r *aggregatedRepository) fetchAllLeaves(ctx context.Context, userID int64)
 			id       int64
 			lType    string
 			from, to time.Time
+			fromSess string
+			toSess   string
+			hours    int
+			days     float64
 			status   string
 			reason   string
 			comment  *string
 			created  time.Time
+			trace    []byte
 		)
-		if err := rows.Scan(&id, &lType, &from, &to, &status, &reason, &comment, &created); err != nil {
+		if err := rows.Scan(&id, &lType, &from, &to, &fromSess, &toSess, &hours, &days, &status, &reason, &comment, &created, &trace); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		res = append(res, aggCombinedReq{
@@ -282,10 +304,15 @@ func (r *aggregatedRepository) fetchAllLeaves(ctx context.Context, userID int64)
 				"leave_type":       lType,
 				"from_date":        from.Format("2006-01-02"),
 				"to_date":          to.Format("2006-01-02"),
+				"from_session":     fromSess,
+				"to_session":       toSess,
+				"hours":            hours,
+				"days":             days,
 				"status":           status,
 				"reason":           reason,
 				"approval_comment": comment,
 				"created_at":       created.Format(time.RFC3339),
+				"decision_trace":   decisionTraceValue(trace),
 			},
 		})
 	}
@@ -309,8 +336,9 @@ func (r *aggregatedRepository) fetchAllExpenses(ctx context.Context, userID int6
 			reason  string
 			comment *string
 			created time.Time
+			trace   []byte
 		)
-		if err := rows.Scan(&id, &amount, &cat, &status, &reason, &comment, &created); err != nil {
+		if err := rows.Scan(&id, &amount, &cat, &status, &reason, &comment, &created, &trace); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		res = append(res, aggCombinedReq{
@@ -324,6 +352,7 @@ func (r *aggregatedRepository) fetchAllExpenses(ctx context.Context, userID int6
 				"reason":           reason,
 				"approval_comment": comment,
 				"created_at":       created.Format(time.RFC3339),
+				"decision_trace":   decisionTraceValue(trace),
 			},
 		})
 	}
@@ -346,8 +375,9 @@ func (r *aggregatedRepository) fetchAllDiscounts(ctx context.Context, userID int
 			reason  string
 			comment *string
 			created time.Time
+			trace   []byte
 		)
-		if err := rows.Scan(&id, &percent, &status, &reason, &comment, &created); err != nil {
+		if err := rows.Scan(&id, &percent, &status, &reason, &comment, &created, &trace); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		res = append(res, aggCombinedReq{
@@ -360,6 +390,7 @@ func (r *aggregatedRepository) fetchAllDiscounts(ctx context.Context, userID int
 				"reason":              reason,
 				"approval_comment":    comment,
 				"created_at":          created.Format(time.RFC3339),
+				"decision_trace":      decisionTraceValue(trace),
 			},
 		})
 	}
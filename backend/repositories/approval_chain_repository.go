@@ -0,0 +1,441 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	chainQueryCreate = `INSERT INTO approval_chains (name, request_type, min_amount, max_amount, active)
+		 VALUES ($1, $2, $3, $4, TRUE)
+		 RETURNING id, created_at`
+	chainQueryCreateStep = `INSERT INTO approval_chain_steps
+		 (chain_id, step_order, approver_type, approver_role, approver_user_id)
+		 VALUES ($1, $2, $3, NULLIF($4, '')::user_role, $5)`
+	chainQueryGetActive = `SELECT id, name, request_type::TEXT, min_amount::FLOAT8, max_amount::FLOAT8, active, created_at
+		 FROM approval_chains
+		 WHERE active
+		 ORDER BY request_type, min_amount`
+	chainQueryGetActiveForAmount = `SELECT id, name, request_type::TEXT, min_amount::FLOAT8, max_amount::FLOAT8, active, created_at
+		 FROM approval_chains
+		 WHERE active
+		   AND request_type = $1
+		   AND min_amount <= $2
+		   AND (max_amount IS NULL OR $2 < max_amount)
+		 ORDER BY min_amount DESC
+		 LIMIT 1`
+	// new chains of a request type are checked against its active bands one at a time
+	chainQueryLockType = `SELECT pg_advisory_xact_lock(hashtext('approval_chains:' || $1))`
+	chainQueryGetActiveByType = `SELECT id, name, request_type::TEXT, min_amount::FLOAT8, max_amount::FLOAT8, active, created_at
+		 FROM approval_chains
+		 WHERE active AND request_type = $1
+		 ORDER BY min_amount`
+	chainQueryGetSteps = `SELECT chain_id, step_order, approver_type, COALESCE(approver_role::TEXT, ''), approver_user_id
+		 FROM approval_chain_steps
+		 WHERE chain_id = ANY($1)
+		 ORDER BY chain_id, step_order`
+	chainQueryDeactivate = `UPDATE approval_chains SET active = FALSE WHERE id = $1 AND active`
+
+	chainQueryCreateRequestStep = `INSERT INTO request_approval_steps
+		 (request_type, request_id, chain_id, step_order, approver_type, approver_role, approver_user_id, status)
+		 VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::user_role, $7, $8)`
+	chainQueryGetRequestSteps = `SELECT id, request_type::TEXT, request_id, chain_id, step_order, approver_type,
+		        COALESCE(approver_role::TEXT, ''), approver_user_id, status, decided_by, on_behalf_of_id, delegation_id,
+		        COALESCE(comment, ''), decided_at
+		 FROM request_approval_steps
+		 WHERE request_type = $1 AND request_id = $2
+		 ORDER BY step_order`
+	chainQueryGetPendingRequestSteps = `SELECT id, request_type::TEXT, request_id, chain_id, step_order, approver_type,
+		        COALESCE(approver_role::TEXT, ''), approver_user_id, status, decided_by, on_behalf_of_id, delegation_id,
+		        COALESCE(comment, ''), decided_at
+		 FROM request_approval_steps
+		 WHERE request_type = $1 AND request_id = $2 AND status = 'PENDING'
+		 ORDER BY step_order
+		 FOR UPDATE`
+	chainQueryHasRequestSteps = `SELECT EXISTS (
+		   SELECT 1 FROM request_approval_steps WHERE request_type = $1 AND request_id = $2
+		 )`
+	// the users who approved a step, themselves or through the delegate acting for them
+	chainQueryGetStepApprovers = `SELECT DISTINCT approver.id
+		 FROM request_approval_steps s
+		 CROSS JOIN LATERAL (VALUES (s.decided_by), (s.on_behalf_of_id)) AS approver(id)
+		 WHERE s.request_type = $1 AND s.request_id = $2 AND s.status = 'APPROVED'
+		   AND approver.id IS NOT NULL`
+	chainQueryDecideStep = `UPDATE request_approval_steps
+		 SET status = $1,
+		     decided_by = $2,
+		     on_behalf_of_id = $3,
+		     delegation_id = $4,
+		     comment = NULLIF($5, ''),
+		     decided_at = NOW()
+		 WHERE id = $6`
+	chainQuerySkipPendingSteps = `UPDATE request_approval_steps
+		 SET status = 'SKIPPED',
+		     decided_at = NOW()
+		 WHERE request_type = $1 AND request_id = $2 AND status = 'PENDING'`
+	chainQuerySkipPendingStepsForRequests = `UPDATE request_approval_steps
+		 SET status = 'SKIPPED',
+		     decided_at = NOW()
+		 WHERE request_type = $1 AND request_id = ANY($2) AND status = 'PENDING'`
+
+	chainQueryRouteLeave    = `UPDATE leave_requests SET routed_to_role = NULLIF($1, '')::user_role, routed_to_user_id = $2 WHERE id = $3`
+	chainQueryRouteExpense  = `UPDATE expense_requests SET routed_to_role = NULLIF($1, '')::user_role, routed_to_user_id = $2 WHERE id = $3`
+	chainQueryRouteDiscount = `UPDATE discount_requests SET routed_to_role = NULLIF($1, '')::user_role, routed_to_user_id = $2 WHERE id = $3`
+	chainQueryLeaveOwner    = `SELECT employee_id FROM leave_requests WHERE id = $1`
+	chainQueryExpenseOwner  = `SELECT employee_id FROM expense_requests WHERE id = $1`
+	chainQueryDiscountOwner = `SELECT employee_id FROM discount_requests WHERE id = $1`
+)
+
+type approvalChainRepository struct {
+	db interfaces.DB
+}
+
+// NewApprovalChainRepository creates a new instance
+func NewApprovalChainRepository(ctx context.Context, db interfaces.DB) interfaces.ApprovalChainRepository {
+	return &approvalChainRepository{db: db}
+}
+
+// Create inserts the chain and its steps and sets chain.ID
+func (r *approvalChainRepository) Create(ctx context.Context, tx interfaces.Tx, chain *models.ApprovalChain) error {
+	err := tx.QueryRow(
+		ctx,
+		chainQueryCreate,
+		chain.Name,
+		chain.RequestType,
+		chain.MinAmount,
+		chain.MaxAmount,
+	).Scan(&chain.ID, &chain.CreatedAt)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	for _, step := range chain.Steps {
+		_, err := tx.Exec(
+			ctx,
+			chainQueryCreateStep,
+			chain.ID,
+			step.StepOrder,
+			step.ApproverType,
+			step.ApproverRole,
+			step.ApproverUserID,
+		)
+		if err != nil {
+			return utils.MapPgError(err)
+		}
+	}
+
+	chain.Active = true
+	return nil
+}
+
+// GetAll lists the active chains with their steps
+func (r *approvalChainRepository) GetAll(ctx context.Context) ([]models.ApprovalChain, error) {
+	rows, err := r.db.Query(ctx, chainQueryGetActive)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	chains, err := scanChains(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadSteps(ctx, r.db, chains); err != nil {
+		return nil, err
+	}
+
+	return chains, nil
+}
+
+// GetActiveForAmount returns the active chain whose band contains amount
+func (r *approvalChainRepository) GetActiveForAmount(ctx context.Context, tx interfaces.Tx, requestType string, amount float64) (*models.ApprovalChain, error) {
+	rows, err := tx.Query(ctx, chainQueryGetActiveForAmount, requestType, amount)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	chains, err := scanChains(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chains) == 0 {
+		return nil, apperrors.ErrApprovalChainNotFound
+	}
+
+	if err := r.loadSteps(ctx, tx, chains); err != nil {
+		return nil, err
+	}
+
+	return &chains[0], nil
+}
+
+// LockActiveByType takes the chain lock of the request type until tx ends and returns its
+// active chains, without their steps
+func (r *approvalChainRepository) LockActiveByType(ctx context.Context, tx interfaces.Tx, requestType string) ([]models.ApprovalChain, error) {
+	if _, err := tx.Exec(ctx, chainQueryLockType, requestType); err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	rows, err := tx.Query(ctx, chainQueryGetActiveByType, requestType)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanChains(rows)
+}
+
+// Deactivate retires a chain; requests already in flight keep their steps
+func (r *approvalChainRepository) Deactivate(ctx context.Context, chainID int64) error {
+	tag, err := r.db.Exec(ctx, chainQueryDeactivate, chainID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrApprovalChainNotFound
+	}
+
+	return nil
+}
+
+func (r *approvalChainRepository) CreateRequestSteps(ctx context.Context, tx interfaces.Tx, steps []models.RequestApprovalStep) error {
+	for _, step := range steps {
+		_, err := tx.Exec(
+			ctx,
+			chainQueryCreateRequestStep,
+			step.RequestType,
+			step.RequestID,
+			step.ChainID,
+			step.StepOrder,
+			step.ApproverType,
+			step.ApproverRole,
+			step.ApproverUserID,
+			step.Status,
+		)
+		if err != nil {
+			return utils.MapPgError(err)
+		}
+	}
+
+	return nil
+}
+
+// GetRequestSteps lists every step of a request's chain in order
+func (r *approvalChainRepository) GetRequestSteps(ctx context.Context, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	rows, err := r.db.Query(ctx, chainQueryGetRequestSteps, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanRequestSteps(rows)
+}
+
+// GetPendingRequestSteps locks and returns the undecided steps of a request, current step first
+func (r *approvalChainRepository) GetPendingRequestSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	rows, err := tx.Query(ctx, chainQueryGetPendingRequestSteps, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanRequestSteps(rows)
+}
+
+// HasRequestSteps reports whether the request was routed through a chain at all
+func (r *approvalChainRepository) HasRequestSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (bool, error) {
+	var exists bool
+	if err := tx.QueryRow(ctx, chainQueryHasRequestSteps, requestType, requestID).Scan(&exists); err != nil {
+		return false, utils.MapPgError(err)
+	}
+
+	return exists, nil
+}
+
+// GetStepApprovers lists the users who approved a step of the request: who decided it and
+// the delegator they decided it for
+func (r *approvalChainRepository) GetStepApprovers(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) ([]int64, error) {
+	rows, err := tx.Query(ctx, chainQueryGetStepApprovers, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var approvers []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		approvers = append(approvers, id)
+	}
+
+	return approvers, utils.MapPgError(rows.Err())
+}
+
+// DecideStep records the decision on a step, and the delegation it was made under if any
+func (r *approvalChainRepository) DecideStep(ctx context.Context, tx interfaces.Tx, stepID int64, status string, decidedBy int64, delegation *models.ApprovalDelegation, comment string) error {
+	var onBehalfOf, delegationID *int64
+	if delegation != nil {
+		onBehalfOf, delegationID = &delegation.DelegatorID, &delegation.ID
+	}
+
+	_, err := tx.Exec(ctx, chainQueryDecideStep, status, decidedBy, onBehalfOf, delegationID, comment, stepID)
+	return utils.MapPgError(err)
+}
+
+func (r *approvalChainRepository) SkipPendingSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	_, err := tx.Exec(ctx, chainQuerySkipPendingSteps, requestType, requestID)
+	return utils.MapPgError(err)
+}
+
+// SkipPendingStepsForRequests skips the pending steps of many requests of one type at once
+func (r *approvalChainRepository) SkipPendingStepsForRequests(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64) error {
+	_, err := tx.Exec(ctx, chainQuerySkipPendingStepsForRequests, requestType, requestIDs)
+	return utils.MapPgError(err)
+}
+
+// RouteRequest points the request at the approver of its current step
+func (r *approvalChainRepository) RouteRequest(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, role string, userID *int64) error {
+	var query string
+	switch requestType {
+	case "LEAVE":
+		query = chainQueryRouteLeave
+	case "EXPENSE":
+		query = chainQueryRouteExpense
+	case "DISCOUNT":
+		query = chainQueryRouteDiscount
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
+
+	_, err := tx.Exec(ctx, query, role, userID, requestID)
+	return utils.MapPgError(err)
+}
+
+// GetRequestOwner returns the employee who raised the request
+func (r *approvalChainRepository) GetRequestOwner(ctx context.Context, requestType string, requestID int64) (int64, error) {
+	var query string
+	var notFound error
+	switch requestType {
+	case "LEAVE":
+		query, notFound = chainQueryLeaveOwner, apperrors.ErrLeaveRequestNotFound
+	case "EXPENSE":
+		query, notFound = chainQueryExpenseOwner, apperrors.ErrExpenseRequestNotFound
+	case "DISCOUNT":
+		query, notFound = chainQueryDiscountOwner, apperrors.ErrDiscountRequestNotFound
+	default:
+		return 0, apperrors.ErrInvalidRequestType
+	}
+
+	var ownerID int64
+	err := r.db.QueryRow(ctx, query, requestID).Scan(&ownerID)
+	if err == pgx.ErrNoRows {
+		return 0, notFound
+	}
+	if err != nil {
+		return 0, utils.MapPgError(err)
+	}
+
+	return ownerID, nil
+}
+
+// chainQuerier is satisfied by both interfaces.DB and interfaces.Tx
+type chainQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// loadSteps fills the steps of the given chains
+func (r *approvalChainRepository) loadSteps(ctx context.Context, q chainQuerier, chains []models.ApprovalChain) error {
+	if len(chains) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(chains))
+	byID := make(map[int64]int, len(chains))
+	for i, chain := range chains {
+		ids[i] = chain.ID
+		byID[chain.ID] = i
+		chains[i].Steps = []models.ApprovalChainStep{}
+	}
+
+	rows, err := q.Query(ctx, chainQueryGetSteps, ids)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var chainID int64
+		var step models.ApprovalChainStep
+		if err := rows.Scan(&chainID, &step.StepOrder, &step.ApproverType, &step.ApproverRole, &step.ApproverUserID); err != nil {
+			return utils.MapPgError(err)
+		}
+
+		i := byID[chainID]
+		chains[i].Steps = append(chains[i].Steps, step)
+	}
+
+	return utils.MapPgError(rows.Err())
+}
+
+func scanChains(rows interfaces.Rows) ([]models.ApprovalChain, error) {
+	var chains []models.ApprovalChain
+
+	for rows.Next() {
+		var chain models.ApprovalChain
+		if err := rows.Scan(
+			&chain.ID,
+			&chain.Name,
+			&chain.RequestType,
+			&chain.MinAmount,
+			&chain.MaxAmount,
+			&chain.Active,
+			&chain.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		chains = append(chains, chain)
+	}
+
+	return chains, utils.MapPgError(rows.Err())
+}
+
+func scanRequestSteps(rows interfaces.Rows) ([]models.RequestApprovalStep, error) {
+	steps := []models.RequestApprovalStep{}
+
+	for rows.Next() {
+		var step models.RequestApprovalStep
+		if err := rows.Scan(
+			&step.ID,
+			&step.RequestType,
+			&step.RequestID,
+			&step.ChainID,
+			&step.StepOrder,
+			&step.ApproverType,
+			&step.ApproverRole,
+			&step.ApproverUserID,
+			&step.Status,
+			&step.DecidedBy,
+			&step.OnBehalfOfID,
+			&step.DelegationID,
+			&step.Comment,
+			&step.DecidedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, utils.MapPgError(rows.Err())
+}
@@ -0,0 +1,279 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+const (
+	balancePolicyQueryGetPolicies = `SELECT id, balance_type::TEXT, reset_cycle, fiscal_start_month, accrual,
+		        carry_forward_cap::FLOAT8, carry_forward_expiry_months, prorate_joiners,
+		        active, updated_by, updated_at
+		 FROM balance_policies
+		 WHERE active
+		 ORDER BY balance_type`
+	balancePolicyQueryRetire = `UPDATE balance_policies SET active = FALSE WHERE balance_type = $1 AND active`
+	balancePolicyQueryInsert = `INSERT INTO balance_policies
+		 (balance_type, reset_cycle, fiscal_start_month, accrual, carry_forward_cap,
+		  carry_forward_expiry_months, prorate_joiners, updated_by)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id, active, updated_at`
+	balancePolicyQuerySaveCycle = `INSERT INTO balance_cycles
+		 (user_id, balance_type, leave_type, cycle_start, allocated, carried_over, carry_expires_on, carry_expired)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 ON CONFLICT (user_id, balance_type, leave_type) DO UPDATE
+		 SET cycle_start = EXCLUDED.cycle_start,
+		     allocated = EXCLUDED.allocated,
+		     carried_over = EXCLUDED.carried_over,
+		     carry_expires_on = EXCLUDED.carry_expires_on,
+		     carry_expired = EXCLUDED.carry_expired,
+		     updated_at = NOW()`
+
+	// balancePolicyQueryAccounts runs over the wallets b of one type ($1) with the grade
+	// limit of their owner, their cycle state and what requests took since the cycle started
+	balancePolicyQueryAccounts = `
+		SELECT b.user_id, b.leave_type, u.created_at, b.annual_limit::FLOAT8, b.total::FLOAT8, b.remaining::FLOAT8,
+		       COALESCE((SELECT -SUM(l.amount) FROM balance_ledger l
+		                 WHERE l.user_id = b.user_id AND l.balance_type = $1 AND l.leave_type = b.leave_type
+		                   AND l.entry_type IN ('DEDUCTION', 'RESTORATION')
+		                   AND l.created_at >= c.cycle_start), 0)::FLOAT8,
+		       c.cycle_start, c.allocated::FLOAT8, c.carried_over::FLOAT8, c.carry_expires_on, c.carry_expired
+		FROM b
+		JOIN users u ON u.id = b.user_id
+		LEFT JOIN balance_cycles c ON c.user_id = b.user_id AND c.balance_type = $1 AND c.leave_type = b.leave_type
+		WHERE ($2::BIGINT IS NULL OR b.user_id = $2) AND ($3::VARCHAR IS NULL OR b.leave_type = $3)
+		ORDER BY b.user_id, b.leave_type`
+	// leave wallets take the allocation of their leave type to the grade
+	balancePolicyQueryLeaveAccounts = `WITH b AS (
+		SELECT l.user_id, l.leave_type, COALESCE(a.annual_days, 0) AS annual_limit,
+		       l.total_allocated AS total, l.remaining_count AS remaining
+		FROM leaves l JOIN users u ON u.id = l.user_id
+		LEFT JOIN leave_type_allocations a ON a.leave_type = l.leave_type AND a.grade_id = u.grade_id)` + balancePolicyQueryAccounts
+	balancePolicyQueryExpenseAccounts = `WITH b AS (
+		SELECT e.user_id, ''::VARCHAR AS leave_type, g.annual_expense_limit AS annual_limit,
+		       e.total_amount AS total, e.remaining_amount AS remaining
+		FROM expense e JOIN users u ON u.id = e.user_id JOIN grades g ON g.id = u.grade_id)` + balancePolicyQueryAccounts
+	balancePolicyQueryDiscountAccounts = `WITH b AS (
+		SELECT d.user_id, ''::VARCHAR AS leave_type, g.discount_limit_percent AS annual_limit,
+		       d.total_discount AS total, d.remaining_discount AS remaining
+		FROM discount d JOIN users u ON u.id = d.user_id JOIN grades g ON g.id = u.grade_id)` + balancePolicyQueryAccounts
+
+	// the wallet and its cycle are locked before they are read again, so the read sees
+	// what a run holding them committed
+	balancePolicyQueryLockLeave    = `SELECT 1 FROM leaves WHERE user_id = $1 AND leave_type = $2 FOR UPDATE`
+	balancePolicyQueryLockExpense  = `SELECT 1 FROM expense WHERE user_id = $1 FOR UPDATE`
+	balancePolicyQueryLockDiscount = `SELECT 1 FROM discount WHERE user_id = $1 FOR UPDATE`
+	balancePolicyQueryLockCycle    = `SELECT 1 FROM balance_cycles WHERE user_id = $1 AND balance_type = $2 AND leave_type = $3 FOR UPDATE`
+)
+
+type balancePolicyRepository struct {
+	db interfaces.DB
+}
+
+// NewBalancePolicyRepository creates a new instance
+func NewBalancePolicyRepository(ctx context.Context, db interfaces.DB) interfaces.BalancePolicyRepository {
+	return &balancePolicyRepository{db: db}
+}
+
+// GetPolicies lists the active balance policies, at most one per balance type
+func (r *balancePolicyRepository) GetPolicies(ctx context.Context) ([]models.BalancePolicy, error) {
+	rows, err := r.db.Query(ctx, balancePolicyQueryGetPolicies)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	policies := []models.BalancePolicy{}
+	for rows.Next() {
+		var p models.BalancePolicy
+		if err := rows.Scan(
+			&p.ID,
+			&p.BalanceType,
+			&p.ResetCycle,
+			&p.FiscalStartMonth,
+			&p.Accrual,
+			&p.CarryForwardCap,
+			&p.CarryForwardExpiryMonths,
+			&p.ProrateJoiners,
+			&p.Active,
+			&p.UpdatedBy,
+			&p.UpdatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, utils.MapPgError(rows.Err())
+}
+
+// ReplacePolicy retires the active policy of the balance type, if any, and stores the
+// new one in its place. Retired policies are kept for the record.
+func (r *balancePolicyRepository) ReplacePolicy(ctx context.Context, tx interfaces.Tx, policy *models.BalancePolicy) error {
+	if _, err := tx.Exec(ctx, balancePolicyQueryRetire, policy.BalanceType); err != nil {
+		return utils.MapPgError(err)
+	}
+
+	err := tx.QueryRow(
+		ctx,
+		balancePolicyQueryInsert,
+		policy.BalanceType,
+		policy.ResetCycle,
+		policy.FiscalStartMonth,
+		policy.Accrual,
+		policy.CarryForwardCap,
+		policy.CarryForwardExpiryMonths,
+		policy.ProrateJoiners,
+		policy.UpdatedBy,
+	).Scan(&policy.ID, &policy.Active, &policy.UpdatedAt)
+
+	return utils.MapPgError(err)
+}
+
+func (r *balancePolicyRepository) DeactivatePolicy(ctx context.Context, balanceType string) error {
+	tag, err := r.db.Exec(ctx, balancePolicyQueryRetire, balanceType)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrBalancePolicyNotFound
+	}
+
+	return nil
+}
+
+// GetAccounts lists the wallets of a balance type with what the policy job needs to plan them
+func (r *balancePolicyRepository) GetAccounts(ctx context.Context, balanceType string) ([]models.BalanceAccount, error) {
+	query, err := balanceAccountsQuery(balanceType)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, balanceType, nil, nil)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanBalanceAccounts(rows, balanceType)
+}
+
+// LockAccount locks a wallet and its cycle state for the rest of the transaction and
+// reads them again, as GetAccounts does
+func (r *balancePolicyRepository) LockAccount(ctx context.Context, tx interfaces.Tx, balanceType string, userID int64, leaveType string) (*models.BalanceAccount, error) {
+	query, err := balanceAccountsQuery(balanceType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch balanceType {
+	case "LEAVE":
+		_, err = tx.Exec(ctx, balancePolicyQueryLockLeave, userID, leaveType)
+	case "EXPENSE":
+		_, err = tx.Exec(ctx, balancePolicyQueryLockExpense, userID)
+	case "DISCOUNT":
+		_, err = tx.Exec(ctx, balancePolicyQueryLockDiscount, userID)
+	}
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	if _, err := tx.Exec(ctx, balancePolicyQueryLockCycle, userID, balanceType, leaveType); err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	rows, err := tx.Query(ctx, query, balanceType, userID, leaveType)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	accounts, err := scanBalanceAccounts(rows, balanceType)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 {
+		return nil, apperrors.ErrBalanceFetchFailed
+	}
+
+	return &accounts[0], nil
+}
+
+func balanceAccountsQuery(balanceType string) (string, error) {
+	switch balanceType {
+	case "LEAVE":
+		return balancePolicyQueryLeaveAccounts, nil
+	case "EXPENSE":
+		return balancePolicyQueryExpenseAccounts, nil
+	case "DISCOUNT":
+		return balancePolicyQueryDiscountAccounts, nil
+	default:
+		return "", apperrors.ErrInvalidRequestType
+	}
+}
+
+func scanBalanceAccounts(rows interfaces.Rows, balanceType string) ([]models.BalanceAccount, error) {
+	var accounts []models.BalanceAccount
+	for rows.Next() {
+		var a models.BalanceAccount
+		cycle := models.BalanceCycle{BalanceType: balanceType}
+		var cycleStart *time.Time
+		var allocated, carriedOver *float64
+		var carryExpired *bool
+
+		if err := rows.Scan(
+			&a.UserID,
+			&a.LeaveType,
+			&a.JoinedAt,
+			&a.AnnualLimit,
+			&a.Total,
+			&a.Remaining,
+			&a.UsedInCycle,
+			&cycleStart,
+			&allocated,
+			&carriedOver,
+			&cycle.CarryExpiresOn,
+			&carryExpired,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		if cycleStart != nil {
+			cycle.UserID = a.UserID
+			cycle.LeaveType = a.LeaveType
+			cycle.CycleStart = *cycleStart
+			cycle.Allocated = *allocated
+			cycle.CarriedOver = *carriedOver
+			cycle.CarryExpired = *carryExpired
+			a.Cycle = &cycle
+		}
+		accounts = append(accounts, a)
+	}
+
+	return accounts, utils.MapPgError(rows.Err())
+}
+
+// SaveCycle stores the cycle state of a wallet
+func (r *balancePolicyRepository) SaveCycle(ctx context.Context, tx interfaces.Tx, cycle models.BalanceCycle) error {
+	_, err := tx.Exec(
+		ctx,
+		balancePolicyQuerySaveCycle,
+		cycle.UserID,
+		cycle.BalanceType,
+		cycle.LeaveType,
+		cycle.CycleStart,
+		cycle.Allocated,
+		cycle.CarriedOver,
+		cycle.CarryExpiresOn,
+		cycle.CarryExpired,
+	)
+
+	return utils.MapPgError(err)
+}
@@ -3,49 +3,157 @@ package repositories
 import (
 	"context"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 const (
-	balanceQueryGetLeave        = `SELECT remaining_count FROM leaves WHERE user_id=$1`
-	balanceQueryGetLeaveFull    = `SELECT total_allocated, remaining_count FROM leaves WHERE user_id=$1`
-	balanceQueryGetExpense      = `SELECT remaining_amount FROM expense WHERE user_id=$1`
-	balanceQueryGetExpenseFull  = `SELECT total_amount, remaining_amount FROM expense WHERE user_id=$1`
-	balanceQueryGetDiscount     = `SELECT remaining_discount FROM discount WHERE user_id=$1`
-	balanceQueryGetDiscountFull = `SELECT total_discount, remaining_discount FROM discount WHERE user_id=$1`
-	balanceQueryDeductLeave     = `UPDATE leaves
-		 SET remaining_count = remaining_count - $1
-		 WHERE user_id=$2`
-	balanceQueryDeductExpense = `UPDATE expense
-		 SET remaining_amount = remaining_amount - $1
-		 WHERE user_id=$2`
-	balanceQueryDeductDiscount = `UPDATE discount
-		 SET remaining_discount = remaining_discount - $1
-		 WHERE user_id=$2`
-	balanceQueryRestoreLeave = `UPDATE leaves 
-		 SET remaining_count = remaining_count + $1
-		 WHERE user_id=$2`
-	balanceQueryRestoreExpense = `UPDATE expense
-		 SET remaining_amount = remaining_amount + $1
-		 WHERE user_id=$2`
-	balanceQueryRestoreDiscount = `UPDATE discount
-		 SET remaining_discount = remaining_discount + $1
-		 WHERE user_id=$2`
-	balanceQueryGetLimits = `SELECT annual_leave_limit, annual_expense_limit, discount_limit_percent
+	// the get queries return the available balance and lock the wallet, so the request
+	// that checked it reserves or deducts before any other request of the user checks it
+	balanceQueryGetLeave    = `SELECT (remaining_count - reserved_count)::FLOAT8 FROM leaves WHERE user_id=$1 AND leave_type=$2 FOR UPDATE`
+	balanceQueryGetExpense  = `SELECT remaining_amount - reserved_amount FROM expense WHERE user_id=$1 FOR UPDATE`
+	balanceQueryGetDiscount = `SELECT remaining_discount - reserved_discount FROM discount WHERE user_id=$1 FOR UPDATE`
+
+	balanceQueryGetLeaveFull = `SELECT total_allocated::FLOAT8, remaining_count::FLOAT8, reserved_count::FLOAT8
+		 FROM leaves WHERE user_id=$1 AND leave_type=$2`
+	balanceQueryGetLeaveWallets = `SELECT leave_type, total_allocated::FLOAT8, remaining_count::FLOAT8, reserved_count::FLOAT8
+		 FROM leaves WHERE user_id=$1 ORDER BY leave_type`
+	balanceQueryGetExpenseFull = `SELECT total_amount::FLOAT8, remaining_amount::FLOAT8, reserved_amount::FLOAT8
+		 FROM expense WHERE user_id=$1`
+	balanceQueryGetDiscountFull = `SELECT total_discount::FLOAT8, remaining_discount::FLOAT8, reserved_discount::FLOAT8
+		 FROM discount WHERE user_id=$1`
+
+	// the reserve queries hold $2 of a wallet for a pending request
+	balanceQueryReserveLeave    = `UPDATE leaves SET reserved_count = reserved_count + $2 WHERE user_id = $1 AND leave_type = $3`
+	balanceQueryReserveExpense  = `UPDATE expense SET reserved_amount = reserved_amount + $2 WHERE user_id = $1`
+	balanceQueryReserveDiscount = `UPDATE discount SET reserved_discount = reserved_discount + $2 WHERE user_id = $1`
+
+	// the release queries free $2 of the reservations of a wallet; a wallet holding less
+	// is left as it is
+	balanceQueryReleaseLeave = `UPDATE leaves SET reserved_count = reserved_count - $2
+		 WHERE user_id = $1 AND leave_type = $3 AND reserved_count >= $2`
+	balanceQueryReleaseExpense = `UPDATE expense SET reserved_amount = reserved_amount - $2
+		 WHERE user_id = $1 AND reserved_amount >= $2`
+	balanceQueryReleaseDiscount = `UPDATE discount SET reserved_discount = reserved_discount - $2
+		 WHERE user_id = $1 AND reserved_discount >= $2`
+
+	// the bulk release queries free what the requests of one type with ids $1 reserved and
+	// return how many of their wallets did not hold it
+	balanceQueryReleaseLeaves = `WITH r AS (
+		     SELECT employee_id, COALESCE(balance_leave_type, leave_type) AS leave_type, SUM(days) AS reserved
+		     FROM leave_requests WHERE id = ANY($1) AND NOT balance_exempt
+		     GROUP BY employee_id, 2
+		 ), released AS (
+		     UPDATE leaves b SET reserved_count = b.reserved_count - r.reserved
+		     FROM r
+		     WHERE b.user_id = r.employee_id AND b.leave_type = r.leave_type AND b.reserved_count >= r.reserved
+		     RETURNING b.user_id
+		 )
+		 SELECT (SELECT COUNT(*) FROM r) - (SELECT COUNT(*) FROM released)`
+	balanceQueryReleaseExpenses = `WITH r AS (
+		     SELECT employee_id, SUM(amount) AS reserved
+		     FROM expense_requests WHERE id = ANY($1) GROUP BY employee_id
+		 ), released AS (
+		     UPDATE expense b SET reserved_amount = b.reserved_amount - r.reserved
+		     FROM r
+		     WHERE b.user_id = r.employee_id AND b.reserved_amount >= r.reserved
+		     RETURNING b.user_id
+		 )
+		 SELECT (SELECT COUNT(*) FROM r) - (SELECT COUNT(*) FROM released)`
+	balanceQueryReleaseDiscounts = `WITH r AS (
+		     SELECT employee_id, SUM(discount_percentage) AS reserved
+		     FROM discount_requests WHERE id = ANY($1) GROUP BY employee_id
+		 ), released AS (
+		     UPDATE discount b SET reserved_discount = b.reserved_discount - r.reserved
+		     FROM r
+		     WHERE b.user_id = r.employee_id AND b.reserved_discount >= r.reserved
+		     RETURNING b.user_id
+		 )
+		 SELECT (SELECT COUNT(*) FROM r) - (SELECT COUNT(*) FROM released)`
+
+	balanceQueryLedgerInsert = `INSERT INTO balance_ledger
+		 (user_id, balance_type, leave_type, entry_type, amount, balance_after, request_id, actor_id, reason)`
+
+	// the change queries move a balance by $3 and record it in the ledger as entry type
+	// $2; $7 is the leave type of leave wallets and empty for the others
+	balanceQueryChangeLeave = `WITH changed AS (
+		 UPDATE leaves SET remaining_count = remaining_count + $3 WHERE user_id = $1 AND leave_type = $7
+		 RETURNING remaining_count)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT $1, 'LEAVE', $7, $2, $3, remaining_count, $4, $5, $6 FROM changed`
+	balanceQueryChangeExpense = `WITH changed AS (
+		 UPDATE expense SET remaining_amount = remaining_amount + $3 WHERE user_id = $1
+		 RETURNING remaining_amount)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT $1, 'EXPENSE', $7, $2, $3, remaining_amount, $4, $5, $6 FROM changed`
+	balanceQueryChangeDiscount = `WITH changed AS (
+		 UPDATE discount SET remaining_discount = remaining_discount + $3 WHERE user_id = $1
+		 RETURNING remaining_discount)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT $1, 'DISCOUNT', $7, $2, $3, remaining_discount, $4, $5, $6 FROM changed`
+	balanceQuerySetTotalLeave    = `UPDATE leaves SET total_allocated = $2 WHERE user_id = $1 AND leave_type = $3`
+	balanceQuerySetTotalExpense  = `UPDATE expense SET total_amount = $2 WHERE user_id = $1`
+	balanceQuerySetTotalDiscount = `UPDATE discount SET total_discount = $2 WHERE user_id = $1`
+	balanceQueryGetLimits        = `SELECT annual_expense_limit, discount_limit_percent
 		 FROM grades WHERE id=$1`
-	balanceQueryInitLeave = `INSERT INTO leaves (user_id, total_allocated, remaining_count)
-		 VALUES ($1,$2,$2)
-		 ON CONFLICT (user_id) DO NOTHING`
-	balanceQueryInitExpense = `INSERT INTO expense (user_id, total_amount, remaining_amount)
+
+	// the init queries open a wallet and record its allocation, unless it already exists.
+	// Users get a leave wallet for every leave type allocated to their grade that does not
+	// bypass the balance.
+	balanceQueryInitLeave = `WITH inserted AS (
+		 INSERT INTO leaves (user_id, leave_type, total_allocated, remaining_count)
+		 SELECT $1, a.leave_type, a.annual_days, a.annual_days
+		 FROM leave_type_allocations a
+		 JOIN leave_types t ON t.code = a.leave_type
+		 WHERE a.grade_id = $2 AND t.active AND NOT t.bypass_balance
+		 ON CONFLICT (user_id, leave_type) DO NOTHING
+		 RETURNING leave_type, remaining_count)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT $1, 'LEAVE', leave_type, 'ALLOCATION', remaining_count, remaining_count, NULL, NULL, $3 FROM inserted`
+
+	// opens the wallet of leave type $1 for every user of a grade it is allocated to
+	balanceQueryOpenLeaveWallets = `WITH inserted AS (
+		 INSERT INTO leaves (user_id, leave_type, total_allocated, remaining_count)
+		 SELECT u.id, a.leave_type, a.annual_days, a.annual_days
+		 FROM leave_type_allocations a
+		 JOIN users u ON u.grade_id = a.grade_id
+		 WHERE a.leave_type = $1
+		 ON CONFLICT (user_id, leave_type) DO NOTHING
+		 RETURNING user_id, leave_type, remaining_count)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT user_id, 'LEAVE', leave_type, 'ALLOCATION', remaining_count, remaining_count, NULL, NULL, $2 FROM inserted`
+	balanceQueryInitExpense = `WITH inserted AS (
+		 INSERT INTO expense (user_id, total_amount, remaining_amount)
 		 VALUES ($1,$2,$2)
-		 ON CONFLICT (user_id) DO NOTHING`
-	balanceQueryInitDiscount = `INSERT INTO discount (user_id, total_discount, remaining_discount)
+		 ON CONFLICT (user_id) DO NOTHING
+		 RETURNING remaining_amount)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT $1, 'EXPENSE', '', 'ALLOCATION', remaining_amount, remaining_amount, NULL, NULL, $3 FROM inserted`
+	balanceQueryInitDiscount = `WITH inserted AS (
+		 INSERT INTO discount (user_id, total_discount, remaining_discount)
 		 VALUES ($1,$2,$2)
-		 ON CONFLICT (user_id) DO NOTHING`
+		 ON CONFLICT (user_id) DO NOTHING
+		 RETURNING remaining_discount)
+		 ` + balanceQueryLedgerInsert + `
+		 SELECT $1, 'DISCOUNT', '', 'ALLOCATION', remaining_discount, remaining_discount, NULL, NULL, $3 FROM inserted`
+
+	balanceQueryGetLedger = `SELECT id, user_id, balance_type::TEXT, leave_type, entry_type, amount, balance_after,
+		        request_id, actor_id, reason, created_at
+		 FROM balance_ledger
+		 WHERE user_id = $1 AND ($2 = '' OR balance_type::TEXT = $2)
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $3 OFFSET $4`
+)
+
+const (
+	balanceInitialAllocationReason   = "Initial allocation for grade"
+	balanceLeaveTypeAllocationReason = "Allocation for new leave type"
 )
 
 type balanceRepository struct {
@@ -57,14 +165,16 @@ func NewBalanceRepository(ctx context.Context, db interfaces.DB) interfaces.Bala
 	return &balanceRepository{db: db}
 }
 
-func (r *balanceRepository) GetLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64) (int, error) {
-	var remaining int
+// GetLeaveBalance returns the days of a leave type available to apply for and locks
+// the wallet for the rest of the transaction
+func (r *balanceRepository) GetLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string) (float64, error) {
+	var available float64
 
 	err := tx.QueryRow(
 		ctx,
 		balanceQueryGetLeave,
-		userID,
-	).Scan(&remaining)
+		userID, leaveType,
+	).Scan(&available)
 
 	if err == pgx.ErrNoRows {
 		return 0, apperrors.ErrLeaveBalanceMissing
@@ -73,36 +183,50 @@ func (r *balanceRepository) GetLeaveBalance(ctx context.Context, tx interfaces.T
 		return 0, apperrors.ErrBalanceFetchFailed
 	}
 
-	return remaining, nil
+	return available, nil
 }
 
-func (r *balanceRepository) GetLeaveFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (int, int, error) {
-	var total, remaining int
-
-	err := tx.QueryRow(
-		ctx,
-		balanceQueryGetLeaveFull,
-		userID,
-	).Scan(&total, &remaining)
+func (r *balanceRepository) GetLeaveFullBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string) (models.Balance, error) {
+	balance, err := r.getFullBalance(ctx, tx, balanceQueryGetLeaveFull, apperrors.ErrLeaveBalanceMissing, userID, leaveType)
+	balance.LeaveType = leaveType
+	return balance, err
+}
 
-	if err == pgx.ErrNoRows {
-		return 0, 0, apperrors.ErrLeaveBalanceMissing
-	}
+// GetLeaveBalances returns the wallets of every leave type of a user
+func (r *balanceRepository) GetLeaveBalances(ctx context.Context, tx interfaces.Tx, userID int64) ([]models.Balance, error) {
+	rows, err := tx.Query(ctx, balanceQueryGetLeaveWallets, userID)
 	if err != nil {
-		return 0, 0, apperrors.ErrBalanceFetchFailed
+		return nil, apperrors.ErrBalanceFetchFailed
+	}
+	defer rows.Close()
+
+	balances := []models.Balance{}
+	for rows.Next() {
+		var balance models.Balance
+		if err := rows.Scan(&balance.LeaveType, &balance.Total, &balance.Remaining, &balance.Reserved); err != nil {
+			return nil, apperrors.ErrBalanceFetchFailed
+		}
+		balance.Consumed = balance.Total - balance.Remaining
+		balance.Available = balance.Remaining - balance.Reserved
+		balances = append(balances, balance)
 	}
 
-	return total, remaining, nil
+	if rows.Err() != nil {
+		return nil, apperrors.ErrBalanceFetchFailed
+	}
+	return balances, nil
 }
 
+// GetExpenseBalance returns the expense amount available to claim and locks the wallet
+// for the rest of the transaction
 func (r *balanceRepository) GetExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64) (float64, error) {
-	var remaining float64
+	var available float64
 
 	err := tx.QueryRow(
 		ctx,
 		balanceQueryGetExpense,
 		userID,
-	).Scan(&remaining)
+	).Scan(&available)
 
 	if err == pgx.ErrNoRows {
 		return 0, apperrors.ErrExpenseBalanceMissing
@@ -111,36 +235,23 @@ func (r *balanceRepository) GetExpenseBalance(ctx context.Context, tx interfaces
 		return 0, apperrors.ErrBalanceFetchFailed
 	}
 
-	return remaining, nil
+	return available, nil
 }
 
-func (r *balanceRepository) GetExpenseFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (float64, float64, error) {
-	var total, remaining float64
-
-	err := tx.QueryRow(
-		ctx,
-		balanceQueryGetExpenseFull,
-		userID,
-	).Scan(&total, &remaining)
-
-	if err == pgx.ErrNoRows {
-		return 0, 0, apperrors.ErrExpenseBalanceMissing
-	}
-	if err != nil {
-		return 0, 0, apperrors.ErrBalanceFetchFailed
-	}
-
-	return total, remaining, nil
+func (r *balanceRepository) GetExpenseFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (models.Balance, error) {
+	return r.getFullBalance(ctx, tx, balanceQueryGetExpenseFull, apperrors.ErrExpenseBalanceMissing, userID)
 }
 
+// GetDiscountBalance returns the discount available to request and locks the wallet
+// for the rest of the transaction
 func (r *balanceRepository) GetDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64) (float64, error) {
-	var remaining float64
+	var available float64
 
 	err := tx.QueryRow(
 		ctx,
 		balanceQueryGetDiscount,
 		userID,
-	).Scan(&remaining)
+	).Scan(&available)
 
 	if err == pgx.ErrNoRows {
 		return 0, apperrors.ErrBalanceFetchFailed // Or a specific ErrDiscountBalanceMissing if added
@@ -149,102 +260,211 @@ func (r *balanceRepository) GetDiscountBalance(ctx context.Context, tx interface
 		return 0, apperrors.ErrBalanceFetchFailed
 	}
 
-	return remaining, nil
+	return available, nil
+}
+
+func (r *balanceRepository) GetDiscountFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (models.Balance, error) {
+	return r.getFullBalance(ctx, tx, balanceQueryGetDiscountFull, apperrors.ErrBalanceFetchFailed, userID)
 }
 
-func (r *balanceRepository) GetDiscountFullBalance(ctx context.Context, tx interfaces.Tx, userID int64) (float64, float64, error) {
-	var total, remaining float64
+// getFullBalance reads the total, remaining and reserved balance of a wallet and
+// splits it into consumed, reserved and available
+func (r *balanceRepository) getFullBalance(ctx context.Context, tx interfaces.Tx, query string, errMissing error, args ...any) (models.Balance, error) {
+	var balance models.Balance
 
 	err := tx.QueryRow(
 		ctx,
-		balanceQueryGetDiscountFull,
-		userID,
-	).Scan(&total, &remaining)
+		query,
+		args...,
+	).Scan(&balance.Total, &balance.Remaining, &balance.Reserved)
 
 	if err == pgx.ErrNoRows {
-		return 0, 0, apperrors.ErrBalanceFetchFailed
+		return models.Balance{}, errMissing
 	}
 	if err != nil {
-		return 0, 0, apperrors.ErrBalanceFetchFailed
+		return models.Balance{}, apperrors.ErrBalanceFetchFailed
 	}
 
-	return total, remaining, nil
+	balance.Consumed = balance.Total - balance.Remaining
+	balance.Available = balance.Remaining - balance.Reserved
+
+	return balance, nil
 }
 
-func (r *balanceRepository) DeductLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, days int) error {
-	_, err := tx.Exec(
-		ctx,
-		balanceQueryDeductLeave,
-		days, userID,
-	)
+func (r *balanceRepository) DeductLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string, days float64, change models.BalanceChange) error {
+	return r.changeBalance(ctx, tx, balanceQueryChangeLeave, userID, leaveType, constants.LedgerDeduction, -days, change)
+}
 
-	if err != nil {
-		return utils.MapPgError(err)
-	}
+func (r *balanceRepository) DeductExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, change models.BalanceChange) error {
+	return r.changeBalance(ctx, tx, balanceQueryChangeExpense, userID, "", constants.LedgerDeduction, -amount, change)
+}
 
-	return nil
+func (r *balanceRepository) DeductDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64, percent float64, change models.BalanceChange) error {
+	return r.changeBalance(ctx, tx, balanceQueryChangeDiscount, userID, "", constants.LedgerDeduction, -percent, change)
+}
+
+func (r *balanceRepository) RestoreLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, leaveType string, days float64, change models.BalanceChange) error {
+	return r.changeBalance(ctx, tx, balanceQueryChangeLeave, userID, leaveType, constants.LedgerRestoration, days, change)
+}
+
+func (r *balanceRepository) RestoreExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, change models.BalanceChange) error {
+	return r.changeBalance(ctx, tx, balanceQueryChangeExpense, userID, "", constants.LedgerRestoration, amount, change)
 }
 
-func (r *balanceRepository) DeductExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64, amount float64) error {
+func (r *balanceRepository) RestoreDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64, percent float64, change models.BalanceChange) error {
+	return r.changeBalance(ctx, tx, balanceQueryChangeDiscount, userID, "", constants.LedgerRestoration, percent, change)
+}
+
+// changeBalance moves a balance by delta and appends the matching ledger entry in the
+// same statement
+func (r *balanceRepository) changeBalance(ctx context.Context, tx interfaces.Tx, query string, userID int64, leaveType, entryType string, delta any, change models.BalanceChange) error {
 	_, err := tx.Exec(
 		ctx,
-		balanceQueryDeductExpense,
-		amount, userID,
+		query,
+		userID, entryType, delta, change.RequestID, change.ActorID, change.Reason, leaveType,
 	)
 
+	return utils.MapPgError(err)
+}
+
+// ReserveBalance holds amount of a wallet for a pending request. leaveType picks the
+// wallet of leave balances.
+func (r *balanceRepository) ReserveBalance(ctx context.Context, tx interfaces.Tx, userID int64, balanceType, leaveType string, amount float64) error {
+	var err error
+	switch balanceType {
+	case "LEAVE":
+		_, err = tx.Exec(ctx, balanceQueryReserveLeave, userID, amount, leaveType)
+	case "EXPENSE":
+		_, err = tx.Exec(ctx, balanceQueryReserveExpense, userID, amount)
+	case "DISCOUNT":
+		_, err = tx.Exec(ctx, balanceQueryReserveDiscount, userID, amount)
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
+
+	return utils.MapPgError(err)
+}
+
+// ReleaseReservation frees what a pending request held of a wallet. A wallet holding
+// less than amount is not changed and gives ErrReservationNotHeld.
+func (r *balanceRepository) ReleaseReservation(ctx context.Context, tx interfaces.Tx, userID int64, balanceType, leaveType string, amount float64) error {
+	var tag pgconn.CommandTag
+	var err error
+	switch balanceType {
+	case "LEAVE":
+		tag, err = tx.Exec(ctx, balanceQueryReleaseLeave, userID, amount, leaveType)
+	case "EXPENSE":
+		tag, err = tx.Exec(ctx, balanceQueryReleaseExpense, userID, amount)
+	case "DISCOUNT":
+		tag, err = tx.Exec(ctx, balanceQueryReleaseDiscount, userID, amount)
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
 	if err != nil {
 		return utils.MapPgError(err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrReservationNotHeld
+	}
+
 	return nil
 }
 
-func (r *balanceRepository) DeductDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64, percent float64) error {
-	_, err := tx.Exec(
-		ctx,
-		balanceQueryDeductDiscount,
-		percent, userID,
-	)
+// ReleaseReservations frees what many requests of one type held, in one statement.
+// Leave requests that bypass the balance held nothing. If a wallet holds less than its
+// requests reserved, ErrReservationNotHeld is returned for the caller to roll back.
+func (r *balanceRepository) ReleaseReservations(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64) error {
+	var query string
+	switch requestType {
+	case "LEAVE":
+		query = balanceQueryReleaseLeaves
+	case "EXPENSE":
+		query = balanceQueryReleaseExpenses
+	case "DISCOUNT":
+		query = balanceQueryReleaseDiscounts
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
 
-	if err != nil {
+	var unreleased int64
+	if err := tx.QueryRow(ctx, query, requestIDs).Scan(&unreleased); err != nil {
 		return utils.MapPgError(err)
 	}
 
+	if unreleased > 0 {
+		return apperrors.ErrReservationNotHeld
+	}
+
 	return nil
 }
 
-func (r *balanceRepository) RestoreLeaveBalance(ctx context.Context, tx interfaces.Tx, userID int64, days int) error {
-	_, err := tx.Exec(
-		ctx,
-		balanceQueryRestoreLeave,
-		days, userID,
-	)
-
-	return utils.MapPgError(err)
+// AdjustBalance moves a balance of any type by amount, recorded as entryType. leaveType
+// picks the wallet of leave balances.
+func (r *balanceRepository) AdjustBalance(ctx context.Context, tx interfaces.Tx, userID int64, balanceType, leaveType, entryType string, amount float64, change models.BalanceChange) error {
+	switch balanceType {
+	case "LEAVE":
+		return r.changeBalance(ctx, tx, balanceQueryChangeLeave, userID, leaveType, entryType, amount, change)
+	case "EXPENSE":
+		return r.changeBalance(ctx, tx, balanceQueryChangeExpense, userID, "", entryType, amount, change)
+	case "DISCOUNT":
+		return r.changeBalance(ctx, tx, balanceQueryChangeDiscount, userID, "", entryType, amount, change)
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
 }
 
-func (r *balanceRepository) RestoreExpenseBalance(ctx context.Context, tx interfaces.Tx, userID int64, amount float64) error {
-	_, err := tx.Exec(
-		ctx,
-		balanceQueryRestoreExpense,
-		amount, userID,
-	)
+// SetBalanceTotal sets the total allocated to a wallet; the remaining balance stays
+func (r *balanceRepository) SetBalanceTotal(ctx context.Context, tx interfaces.Tx, userID int64, balanceType, leaveType string, total float64) error {
+	var err error
+	switch balanceType {
+	case "LEAVE":
+		_, err = tx.Exec(ctx, balanceQuerySetTotalLeave, userID, total, leaveType)
+	case "EXPENSE":
+		_, err = tx.Exec(ctx, balanceQuerySetTotalExpense, userID, total)
+	case "DISCOUNT":
+		_, err = tx.Exec(ctx, balanceQuerySetTotalDiscount, userID, total)
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
 
 	return utils.MapPgError(err)
 }
 
-func (r *balanceRepository) RestoreDiscountBalance(ctx context.Context, tx interfaces.Tx, userID int64, percent float64) error {
-	_, err := tx.Exec(
-		ctx,
-		balanceQueryRestoreDiscount,
-		percent, userID,
-	)
+// GetLedger lists the ledger entries of a user, newest first, of one balance type when
+// balanceType is set
+func (r *balanceRepository) GetLedger(ctx context.Context, userID int64, balanceType string, limit, offset int) ([]models.BalanceLedgerEntry, error) {
+	rows, err := r.db.Query(ctx, balanceQueryGetLedger, userID, balanceType, limit, offset)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	entries := []models.BalanceLedgerEntry{}
+	for rows.Next() {
+		var e models.BalanceLedgerEntry
+		if err := rows.Scan(
+			&e.ID,
+			&e.UserID,
+			&e.BalanceType,
+			&e.LeaveType,
+			&e.EntryType,
+			&e.Amount,
+			&e.BalanceAfter,
+			&e.RequestID,
+			&e.ActorID,
+			&e.Reason,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		entries = append(entries, e)
+	}
 
-	return utils.MapPgError(err)
+	return entries, utils.MapPgError(rows.Err())
 }
 
 func (r *balanceRepository) InitializeBalances(ctx context.Context, tx interfaces.Tx, userID int64, gradeID int64) error {
-	var leaveLimit int
 	var expenseLimit float64
 	var discountLimit float64
 
@@ -252,17 +472,17 @@ func (r *balanceRepository) InitializeBalances(ctx context.Context, tx interface
 		ctx,
 		balanceQueryGetLimits,
 		gradeID,
-	).Scan(&leaveLimit, &expenseLimit, &discountLimit)
+	).Scan(&expenseLimit, &discountLimit)
 
 	if err != nil {
 		return apperrors.ErrQueryFailed
 	}
 
-	// Leave wallet
+	// Leave wallets
 	_, err = tx.Exec(
 		ctx,
 		balanceQueryInitLeave,
-		userID, leaveLimit,
+		userID, gradeID, balanceInitialAllocationReason,
 	)
 	if err != nil {
 		return utils.MapPgError(err)
@@ -272,7 +492,7 @@ func (r *balanceRepository) InitializeBalances(ctx context.Context, tx interface
 	_, err = tx.Exec(
 		ctx,
 		balanceQueryInitExpense,
-		userID, expenseLimit,
+		userID, expenseLimit, balanceInitialAllocationReason,
 	)
 	if err != nil {
 		return utils.MapPgError(err)
@@ -282,7 +502,7 @@ func (r *balanceRepository) InitializeBalances(ctx context.Context, tx interface
 	_, err = tx.Exec(
 		ctx,
 		balanceQueryInitDiscount,
-		userID, discountLimit,
+		userID, discountLimit, balanceInitialAllocationReason,
 	)
 	if err != nil {
 		return utils.MapPgError(err)
@@ -290,3 +510,14 @@ func (r *balanceRepository) InitializeBalances(ctx context.Context, tx interface
 
 	return nil
 }
+
+// OpenLeaveBalances opens the wallet of a leave type for every user of a grade the type
+// is allocated to and has no wallet of it yet. It returns the number of wallets opened.
+func (r *balanceRepository) OpenLeaveBalances(ctx context.Context, tx interfaces.Tx, leaveType string) (int64, error) {
+	tag, err := tx.Exec(ctx, balanceQueryOpenLeaveWallets, leaveType, balanceLeaveTypeAllocationReason)
+	if err != nil {
+		return 0, utils.MapPgError(err)
+	}
+
+	return tag.RowsAffected(), nil
+}
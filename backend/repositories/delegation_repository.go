@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+const (
+	// delegationInEffect matches delegations d that apply today; out-of-office
+	// delegations only while the delegator has approved leave covering today
+	delegationInEffect = `(d.active
+		   AND d.start_date <= CURRENT_DATE
+		   AND (d.end_date IS NULL OR CURRENT_DATE <= d.end_date)
+		   AND (NOT d.on_leave_only OR EXISTS (
+		         SELECT 1 FROM leave_requests lr
+		         WHERE lr.employee_id = d.delegator_id
+		           AND lr.status IN ('APPROVED', 'AUTO_APPROVED')
+		           AND lr.from_date <= CURRENT_DATE
+		           AND CURRENT_DATE <= lr.to_date)))`
+	delegationColumns = `d.id, d.delegator_id, d.delegate_id, d.start_date, d.end_date, d.on_leave_only, d.active,
+		        ` + delegationInEffect + `, COALESCE(d.created_by, 0), d.created_at`
+
+	delegationQueryCreate = `INSERT INTO approval_delegations
+		 (delegator_id, delegate_id, start_date, end_date, on_leave_only, created_by)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, active, created_at`
+	delegationQueryGetByID = `SELECT ` + delegationColumns + `
+		 FROM approval_delegations d
+		 WHERE d.id = $1`
+	delegationQueryGetForUser = `SELECT ` + delegationColumns + `
+		 FROM approval_delegations d
+		 WHERE d.active AND (d.delegator_id = $1 OR d.delegate_id = $1)
+		 ORDER BY d.start_date, d.id`
+	delegationQueryGetAll = `SELECT ` + delegationColumns + `
+		 FROM approval_delegations d
+		 WHERE d.active
+		 ORDER BY d.start_date, d.id`
+	delegationQueryRevoke      = `UPDATE approval_delegations SET active = FALSE WHERE id = $1 AND active`
+	delegationQueryGetInEffect = `SELECT ` + delegationColumns + `
+		 FROM approval_delegations d
+		 WHERE d.delegator_id = $1 AND d.delegate_id = $2 AND ` + delegationInEffect + `
+		 ORDER BY d.id
+		 LIMIT 1`
+	delegationQueryGetDelegators = `SELECT DISTINCT d.delegator_id
+		 FROM approval_delegations d
+		 WHERE d.delegate_id = $1 AND ` + delegationInEffect
+
+	delegationQueryRecordLeave    = `UPDATE leave_requests SET on_behalf_of_id = $1, delegation_id = $2 WHERE id = $3`
+	delegationQueryRecordExpense  = `UPDATE expense_requests SET on_behalf_of_id = $1, delegation_id = $2 WHERE id = $3`
+	delegationQueryRecordDiscount = `UPDATE discount_requests SET on_behalf_of_id = $1, delegation_id = $2 WHERE id = $3`
+)
+
+type delegationRepository struct {
+	db interfaces.DB
+}
+
+// NewDelegationRepository creates a new instance
+func NewDelegationRepository(ctx context.Context, db interfaces.DB) interfaces.DelegationRepository {
+	return &delegationRepository{db: db}
+}
+
+func (r *delegationRepository) Create(ctx context.Context, delegation *models.ApprovalDelegation) error {
+	err := r.db.QueryRow(
+		ctx,
+		delegationQueryCreate,
+		delegation.DelegatorID,
+		delegation.DelegateID,
+		delegation.StartDate,
+		delegation.EndDate,
+		delegation.OnLeaveOnly,
+		delegation.CreatedBy,
+	).Scan(&delegation.ID, &delegation.Active, &delegation.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+func (r *delegationRepository) GetByID(ctx context.Context, delegationID int64) (*models.ApprovalDelegation, error) {
+	rows, err := r.db.Query(ctx, delegationQueryGetByID, delegationID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	delegations, err := scanDelegations(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(delegations) == 0 {
+		return nil, apperrors.ErrDelegationNotFound
+	}
+
+	return &delegations[0], nil
+}
+
+// GetForUser lists the active delegations the user gave or received
+func (r *delegationRepository) GetForUser(ctx context.Context, userID int64) ([]models.ApprovalDelegation, error) {
+	rows, err := r.db.Query(ctx, delegationQueryGetForUser, userID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanDelegations(rows)
+}
+
+func (r *delegationRepository) GetAll(ctx context.Context) ([]models.ApprovalDelegation, error) {
+	rows, err := r.db.Query(ctx, delegationQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanDelegations(rows)
+}
+
+func (r *delegationRepository) Revoke(ctx context.Context, delegationID int64) error {
+	tag, err := r.db.Exec(ctx, delegationQueryRevoke, delegationID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrDelegationNotFound
+	}
+
+	return nil
+}
+
+// GetInEffect returns a delegation from delegatorID to delegateID that applies today
+func (r *delegationRepository) GetInEffect(ctx context.Context, tx interfaces.Tx, delegatorID, delegateID int64) (*models.ApprovalDelegation, error) {
+	rows, err := tx.Query(ctx, delegationQueryGetInEffect, delegatorID, delegateID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	delegations, err := scanDelegations(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(delegations) == 0 {
+		return nil, apperrors.ErrDelegationNotFound
+	}
+
+	return &delegations[0], nil
+}
+
+// GetDelegators lists the users whose approvals delegateID can make today
+func (r *delegationRepository) GetDelegators(ctx context.Context, delegateID int64) ([]int64, error) {
+	rows, err := r.db.Query(ctx, delegationQueryGetDelegators, delegateID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var delegators []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		delegators = append(delegators, id)
+	}
+
+	return delegators, utils.MapPgError(rows.Err())
+}
+
+// RecordDecision stores on the request which delegation its decision was made under
+func (r *delegationRepository) RecordDecision(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, delegation *models.ApprovalDelegation) error {
+	var query string
+	switch requestType {
+	case "LEAVE":
+		query = delegationQueryRecordLeave
+	case "EXPENSE":
+		query = delegationQueryRecordExpense
+	case "DISCOUNT":
+		query = delegationQueryRecordDiscount
+	default:
+		return apperrors.ErrInvalidRequestType
+	}
+
+	_, err := tx.Exec(ctx, query, delegation.DelegatorID, delegation.ID, requestID)
+	return utils.MapPgError(err)
+}
+
+func scanDelegations(rows interfaces.Rows) ([]models.ApprovalDelegation, error) {
+	delegations := []models.ApprovalDelegation{}
+
+	for rows.Next() {
+		var d models.ApprovalDelegation
+		if err := rows.Scan(
+			&d.ID,
+			&d.DelegatorID,
+			&d.DelegateID,
+			&d.StartDate,
+			&d.EndDate,
+			&d.OnLeaveOnly,
+			&d.Active,
+			&d.InEffect,
+			&d.CreatedBy,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		delegations = append(delegations, d)
+	}
+
+	return delegations, utils.MapPgError(rows.Err())
+}
@@ -14,23 +14,28 @@ import (
 
 const (
 	discountQueryCreate = `INSERT INTO discount_requests
-		 (employee_id, discount_percentage, reason, status, rule_id)
-		 VALUES ($1, $2, $3, $4, $5)`
-	discountQueryGetByID = `SELECT id, employee_id, discount_percentage, reason, status, rule_id, approved_by_id, created_at
-		 FROM discount_requests WHERE id=$1`
+		 (employee_id, discount_percentage, reason, status, rule_id,
+		  decision_action, routed_to_role, routed_to_user_id, approval_comment, decision_trace)
+		 VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, '')::user_role, $8,
+		         COALESCE(NULLIF($9, ''), 'Not Updated by manager'), $10)
+		 RETURNING id`
+	discountQueryGetByID = `SELECT id, employee_id, discount_percentage, reason, status, rule_id, approved_by_id, created_at,
+		        COALESCE(decision_action, ''), COALESCE(routed_to_role::TEXT, ''), routed_to_user_id
+		 FROM discount_requests WHERE id=$1
+		 FOR UPDATE`
 	discountQueryUpdateStatus = `UPDATE discount_requests
 		 SET status=$1, approved_by_id=$2, approval_comment=$3
 		 WHERE id=$4`
 	discountQueryGetPendingForManager = `
-		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.created_at
+		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.created_at, dr.decision_trace
 		FROM discount_requests dr
 		JOIN users u ON dr.employee_id = u.id
-		WHERE dr.status='PENDING' AND u.manager_id=$1
+		WHERE dr.status='PENDING' AND ((dr.routed_to_role IS NULL AND dr.routed_to_user_id IS NULL AND u.manager_id=ANY($1)) OR dr.routed_to_user_id=ANY($1) OR (dr.routed_to_role='MANAGER' AND u.role='EMPLOYEE'))
 		ORDER BY dr.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 	discountQueryGetPendingForAdmin = `
-		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.created_at
+		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.created_at, dr.decision_trace
 		FROM discount_requests dr
 		JOIN users u ON dr.employee_id = u.id
 		WHERE dr.status='PENDING'
@@ -39,7 +44,7 @@ const (
 	`
 	discountQueryCancel                 = `UPDATE discount_requests SET status='CANCELLED' WHERE id=$1`
 	discountQueryGetPendingRequests     = "SELECT id, created_at FROM discount_requests WHERE status='PENDING'"
-	discountQueryCountPendingForManager = `SELECT COUNT(*) FROM discount_requests dr JOIN users u ON dr.employee_id = u.id WHERE dr.status='PENDING' AND u.manager_id=$1`
+	discountQueryCountPendingForManager = `SELECT COUNT(*) FROM discount_requests dr JOIN users u ON dr.employee_id = u.id WHERE dr.status='PENDING' AND ((dr.routed_to_role IS NULL AND dr.routed_to_user_id IS NULL AND u.manager_id=ANY($1)) OR dr.routed_to_user_id=ANY($1) OR (dr.routed_to_role='MANAGER' AND u.role='EMPLOYEE'))`
 	discountQueryCountPendingForAdmin   = `SELECT COUNT(*) FROM discount_requests WHERE status='PENDING'`
 )
 
@@ -52,21 +57,29 @@ func NewDiscountRequestRepository(ctx context.Context, db interfaces.DB) interfa
 }
 
 func (r *discountRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.DiscountRequest) error {
-	_, err := tx.Exec(
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return apperrors.ErrInsertFailed
+	}
+
+	err = tx.QueryRow(
 		ctx,
 		discountQueryCreate,
 		req.EmployeeID, req.DiscountPercentage, req.Reason, req.Status, req.RuleID,
-	)
+		req.DecisionAction, req.RoutedToRole, req.RoutedToUserID, req.ApprovalComment, traceJSON,
+	).Scan(&req.ID)
 	return utils.MapPgError(err)
 }
 
+// GetByID locks and returns the request so concurrent decisions on it run one at a time
 func (r *discountRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.DiscountRequest, error) {
 	reqObj := &models.DiscountRequest{}
 	err := tx.QueryRow(
 		ctx,
 		discountQueryGetByID,
 		requestID,
-	).Scan(&reqObj.ID, &reqObj.EmployeeID, &reqObj.DiscountPercentage, &reqObj.Reason, &reqObj.Status, &reqObj.RuleID, &reqObj.ApprovedByID, &reqObj.CreatedAt)
+	).Scan(&reqObj.ID, &reqObj.EmployeeID, &reqObj.DiscountPercentage, &reqObj.Reason, &reqObj.Status, &reqObj.RuleID, &reqObj.ApprovedByID, &reqObj.CreatedAt,
+		&reqObj.DecisionAction, &reqObj.RoutedToRole, &reqObj.RoutedToUserID)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -86,14 +99,14 @@ func (r *discountRequestRepository) UpdateStatus(ctx context.Context, tx interfa
 	return utils.MapPgError(err)
 }
 
-func (r *discountRequestRepository) GetPendingForManager(ctx context.Context, managerID int64, limit, offset int) ([]map[string]interface{}, int, error) {
+func (r *discountRequestRepository) GetPendingForManager(ctx context.Context, managerIDs []int64, limit, offset int) ([]map[string]interface{}, int, error) {
 	var total int
-	err := r.db.QueryRow(ctx, discountQueryCountPendingForManager, managerID).Scan(&total)
+	err := r.db.QueryRow(ctx, discountQueryCountPendingForManager, managerIDs).Scan(&total)
 	if err != nil {
 		return nil, 0, utils.MapPgError(err)
 	}
 
-	rows, err := r.db.Query(ctx, discountQueryGetPendingForManager, managerID, limit, offset)
+	rows, err := r.db.Query(ctx, discountQueryGetPendingForManager, managerIDs, limit, offset)
 	if err != nil {
 		return nil, total, utils.MapPgError(err)
 	}
@@ -108,8 +121,9 @@ func (r *discountRequestRepository) GetPendingForManager(ctx context.Context, ma
 			reason     string
 			percent    float64
 			created    interface{}
+			trace      []byte
 		)
-		if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &created); err != nil {
+		if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &created, &trace); err != nil {
 			return nil, total, utils.MapPgError(err)
 		}
 
@@ -131,6 +145,7 @@ func (r *discountRequestRepository) GetPendingForManager(ctx context.Context, ma
 			"reason":              reason,
 			"status":              "PENDING",
 			"created_at":          createdAt.Format(time.RFC3339),
+			"decision_trace":      decisionTraceValue(trace),
 		})
 	}
 	return result, total, nil
@@ -158,8 +173,9 @@ func (r *discountRequestRepository) GetPendingForAdmin(ctx context.Context, limi
 			reason     string
 			percent    float64
 			created    interface{}
+			trace      []byte
 		)
-		if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &created); err != nil {
+		if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &created, &trace); err != nil {
 			return nil, total, utils.MapPgError(err)
 		}
 
@@ -181,6 +197,7 @@ func (r *discountRequestRepository) GetPendingForAdmin(ctx context.Context, limi
 			"reason":              reason,
 			"status":              "PENDING",
 			"created_at":          createdAt.Format(time.RFC3339),
+			"decision_trace":      decisionTraceValue(trace),
 		})
 	}
 	return result, total, nil
@@ -0,0 +1,367 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	slaPolicyColumns = `id, request_type::TEXT, grade_id, reminder_after_days, escalate_after_days, reject_after_days,
+		        active, updated_by, updated_at`
+
+	escalationQueryGetPolicies = `SELECT ` + slaPolicyColumns + `
+		 FROM sla_policies
+		 WHERE active
+		 ORDER BY request_type, grade_id NULLS FIRST`
+	escalationQueryGetPolicyByID = `SELECT ` + slaPolicyColumns + `
+		 FROM sla_policies
+		 WHERE id = $1 AND active`
+	escalationQueryCreatePolicy = `INSERT INTO sla_policies
+		 (request_type, grade_id, reminder_after_days, escalate_after_days, reject_after_days, updated_by)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, active, updated_at`
+	escalationQueryUpdatePolicy = `UPDATE sla_policies
+		 SET reminder_after_days = $1,
+		     escalate_after_days = $2,
+		     reject_after_days = $3,
+		     updated_by = $4,
+		     updated_at = NOW()
+		 WHERE id = $5 AND active
+		 RETURNING updated_at`
+	escalationQueryDeactivatePolicy = `UPDATE sla_policies SET active = FALSE WHERE id = $1 AND active`
+
+	// escalationQueryCandidates runs over the pending requests r of one type ($1), with the
+	// work calendar of their requester. The current approver is the routed user, or the
+	// requester's manager for unrouted requests; requests routed to a role have no
+	// approver with a manager to escalate to.
+	escalationQueryCandidates = `
+		SELECT r.id, r.employee_id, u.grade_id,
+		       COALESCE(u.calendar_id, (SELECT id FROM work_calendars WHERE is_default)),
+		       r.created_at,
+		       CASE WHEN r.routed_to_user_id IS NOT NULL THEN approver.manager_id
+		            WHEN r.routed_to_role IS NULL THEN manager.manager_id
+		       END,
+		       EXISTS (SELECT 1 FROM request_escalations e
+		               WHERE e.request_type = $1 AND e.request_id = r.id AND e.step = 'REMINDER'),
+		       EXISTS (SELECT 1 FROM request_escalations e
+		               WHERE e.request_type = $1 AND e.request_id = r.id AND e.step = 'ESCALATED')
+		FROM r
+		JOIN users u ON u.id = r.employee_id
+		LEFT JOIN users approver ON approver.id = r.routed_to_user_id
+		LEFT JOIN users manager ON manager.id = u.manager_id
+		ORDER BY r.id`
+	escalationQueryLeaveCandidates = `WITH r AS (
+		SELECT id, employee_id, created_at, routed_to_role, routed_to_user_id
+		FROM leave_requests WHERE status = 'PENDING')` + escalationQueryCandidates
+	escalationQueryExpenseCandidates = `WITH r AS (
+		SELECT id, employee_id, created_at, routed_to_role, routed_to_user_id
+		FROM expense_requests WHERE status = 'PENDING')` + escalationQueryCandidates
+	escalationQueryDiscountCandidates = `WITH r AS (
+		SELECT id, employee_id, created_at, routed_to_role, routed_to_user_id
+		FROM discount_requests WHERE status = 'PENDING')` + escalationQueryCandidates
+
+	escalationQueryRecordStep = `INSERT INTO request_escalations
+		 (request_type, request_id, step, working_days, escalated_to_role, escalated_to_user_id, sla_policy_id)
+		 VALUES ($1, $2, $3, $4, NULLIF($5, '')::user_role, $6, $7)
+		 ON CONFLICT (request_type, request_id, step) DO NOTHING
+		 RETURNING id, created_at`
+	escalationQueryGetRequestEscalations = `SELECT id, request_type::TEXT, request_id, step, working_days,
+		        COALESCE(escalated_to_role::TEXT, ''), escalated_to_user_id, sla_policy_id, created_at
+		 FROM request_escalations
+		 WHERE request_type = $1 AND request_id = $2
+		 ORDER BY created_at, id`
+
+	// escalationQueryRecordAutoRejections records the AUTO_REJECTED step of many requests
+	// of one type ($1) from parallel arrays of request ids, working days and policy ids
+	escalationQueryRecordAutoRejections = `INSERT INTO request_escalations
+		 (request_type, request_id, step, working_days, sla_policy_id)
+		 SELECT $1, v.request_id, 'AUTO_REJECTED', v.working_days, v.sla_policy_id
+		 FROM UNNEST($2::BIGINT[], $3::INT[], $4::BIGINT[]) AS v(request_id, working_days, sla_policy_id)
+		 ON CONFLICT (request_type, request_id, step) DO NOTHING`
+
+	// the auto-reject queries only touch requests that are still pending, so a request
+	// decided since the candidates were read is left alone and not returned
+	escalationQueryAutoRejectLeaves = `UPDATE leave_requests
+		 SET status = 'AUTO_REJECTED',
+		     approved_by_id = NULL,
+		     approval_comment = $2,
+		     sla_policy_id = $3
+		 WHERE id = ANY($1) AND status = 'PENDING'
+		 RETURNING id`
+	escalationQueryAutoRejectExpenses = `UPDATE expense_requests
+		 SET status = 'AUTO_REJECTED',
+		     approved_by_id = NULL,
+		     approval_comment = $2,
+		     sla_policy_id = $3
+		 WHERE id = ANY($1) AND status = 'PENDING'
+		 RETURNING id`
+	escalationQueryAutoRejectDiscounts = `UPDATE discount_requests
+		 SET status = 'AUTO_REJECTED',
+		     approved_by_id = NULL,
+		     approval_comment = $2,
+		     sla_policy_id = $3
+		 WHERE id = ANY($1) AND status = 'PENDING'
+		 RETURNING id`
+)
+
+type escalationRepository struct {
+	db interfaces.DB
+}
+
+// NewEscalationRepository creates a new instance
+func NewEscalationRepository(ctx context.Context, db interfaces.DB) interfaces.EscalationRepository {
+	return &escalationRepository{db: db}
+}
+
+// GetPolicies lists the active SLA policies, type-wide ones before grade overrides
+func (r *escalationRepository) GetPolicies(ctx context.Context) ([]models.SLAPolicy, error) {
+	rows, err := r.db.Query(ctx, escalationQueryGetPolicies)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanSLAPolicies(rows)
+}
+
+func (r *escalationRepository) GetPolicyByID(ctx context.Context, policyID int64) (*models.SLAPolicy, error) {
+	rows, err := r.db.Query(ctx, escalationQueryGetPolicyByID, policyID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	policies, err := scanSLAPolicies(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, apperrors.ErrSLAPolicyNotFound
+	}
+
+	return &policies[0], nil
+}
+
+func (r *escalationRepository) CreatePolicy(ctx context.Context, policy *models.SLAPolicy) error {
+	err := r.db.QueryRow(
+		ctx,
+		escalationQueryCreatePolicy,
+		policy.RequestType,
+		policy.GradeID,
+		policy.ReminderAfterDays,
+		policy.EscalateAfterDays,
+		policy.RejectAfterDays,
+		policy.UpdatedBy,
+	).Scan(&policy.ID, &policy.Active, &policy.UpdatedAt)
+
+	err = utils.MapPgError(err)
+	if err == apperrors.ErrDuplicateEntry {
+		return apperrors.ErrSLAPolicyExists
+	}
+	return err
+}
+
+// UpdatePolicy replaces the thresholds of an active policy
+func (r *escalationRepository) UpdatePolicy(ctx context.Context, policy *models.SLAPolicy) error {
+	err := r.db.QueryRow(
+		ctx,
+		escalationQueryUpdatePolicy,
+		policy.ReminderAfterDays,
+		policy.EscalateAfterDays,
+		policy.RejectAfterDays,
+		policy.UpdatedBy,
+		policy.ID,
+	).Scan(&policy.UpdatedAt)
+
+	if err == pgx.ErrNoRows {
+		return apperrors.ErrSLAPolicyNotFound
+	}
+	return utils.MapPgError(err)
+}
+
+func (r *escalationRepository) DeactivatePolicy(ctx context.Context, policyID int64) error {
+	tag, err := r.db.Exec(ctx, escalationQueryDeactivatePolicy, policyID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrSLAPolicyNotFound
+	}
+
+	return nil
+}
+
+// GetCandidates lists the pending requests of a type with their escalation state
+func (r *escalationRepository) GetCandidates(ctx context.Context, requestType string) ([]models.EscalationCandidate, error) {
+	var query string
+	switch requestType {
+	case "LEAVE":
+		query = escalationQueryLeaveCandidates
+	case "EXPENSE":
+		query = escalationQueryExpenseCandidates
+	case "DISCOUNT":
+		query = escalationQueryDiscountCandidates
+	default:
+		return nil, apperrors.ErrInvalidRequestType
+	}
+
+	rows, err := r.db.Query(ctx, query, requestType)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var candidates []models.EscalationCandidate
+	for rows.Next() {
+		var c models.EscalationCandidate
+		if err := rows.Scan(
+			&c.RequestID,
+			&c.EmployeeID,
+			&c.GradeID,
+			&c.CalendarID,
+			&c.CreatedAt,
+			&c.ApproverManagerID,
+			&c.Reminded,
+			&c.Escalated,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, utils.MapPgError(rows.Err())
+}
+
+// RecordStep stores an escalation step on the request. It reports false when the
+// request already went through that step.
+func (r *escalationRepository) RecordStep(ctx context.Context, tx interfaces.Tx, escalation *models.RequestEscalation) (bool, error) {
+	err := tx.QueryRow(
+		ctx,
+		escalationQueryRecordStep,
+		escalation.RequestType,
+		escalation.RequestID,
+		escalation.Step,
+		escalation.WorkingDays,
+		escalation.EscalatedToRole,
+		escalation.EscalatedToUserID,
+		escalation.SLAPolicyID,
+	).Scan(&escalation.ID, &escalation.CreatedAt)
+
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+
+	return true, nil
+}
+
+func (r *escalationRepository) GetRequestEscalations(ctx context.Context, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	rows, err := r.db.Query(ctx, escalationQueryGetRequestEscalations, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	escalations := []models.RequestEscalation{}
+	for rows.Next() {
+		var e models.RequestEscalation
+		if err := rows.Scan(
+			&e.ID,
+			&e.RequestType,
+			&e.RequestID,
+			&e.Step,
+			&e.WorkingDays,
+			&e.EscalatedToRole,
+			&e.EscalatedToUserID,
+			&e.SLAPolicyID,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		escalations = append(escalations, e)
+	}
+
+	return escalations, utils.MapPgError(rows.Err())
+}
+
+// AutoRejectRequests rejects the still pending requests among requestIDs under one SLA
+// policy and returns the ids it rejected
+func (r *escalationRepository) AutoRejectRequests(ctx context.Context, tx interfaces.Tx, requestType string, requestIDs []int64, policyID int64, comment string) ([]int64, error) {
+	var query string
+	switch requestType {
+	case "LEAVE":
+		query = escalationQueryAutoRejectLeaves
+	case "EXPENSE":
+		query = escalationQueryAutoRejectExpenses
+	case "DISCOUNT":
+		query = escalationQueryAutoRejectDiscounts
+	default:
+		return nil, apperrors.ErrInvalidRequestType
+	}
+
+	rows, err := tx.Query(ctx, query, requestIDs, comment, policyID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	rejected := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		rejected = append(rejected, id)
+	}
+
+	return rejected, utils.MapPgError(rows.Err())
+}
+
+// RecordAutoRejections stores the AUTO_REJECTED step of many requests in one statement
+func (r *escalationRepository) RecordAutoRejections(ctx context.Context, tx interfaces.Tx, requestType string, items []models.AutoRejectItem) error {
+	requestIDs := make([]int64, len(items))
+	workingDays := make([]int32, len(items))
+	policyIDs := make([]int64, len(items))
+	for i, item := range items {
+		requestIDs[i] = item.RequestID
+		workingDays[i] = int32(item.WorkingDays)
+		policyIDs[i] = item.SLAPolicyID
+	}
+
+	_, err := tx.Exec(ctx, escalationQueryRecordAutoRejections, requestType, requestIDs, workingDays, policyIDs)
+	return utils.MapPgError(err)
+}
+
+func scanSLAPolicies(rows interfaces.Rows) ([]models.SLAPolicy, error) {
+	policies := []models.SLAPolicy{}
+
+	for rows.Next() {
+		var p models.SLAPolicy
+		if err := rows.Scan(
+			&p.ID,
+			&p.RequestType,
+			&p.GradeID,
+			&p.ReminderAfterDays,
+			&p.EscalateAfterDays,
+			&p.RejectAfterDays,
+			&p.Active,
+			&p.UpdatedBy,
+			&p.UpdatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, utils.MapPgError(rows.Err())
+}
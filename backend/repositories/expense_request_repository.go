@@ -14,23 +14,28 @@ import (
 
 const (
 	expenseQueryCreate = `INSERT INTO expense_requests
-		 (employee_id, amount, category, reason, status, rule_id)
-		 VALUES ($1, $2, $3, $4, $5, $6)`
-	expenseQueryGetByID = `SELECT employee_id, status, amount
+		 (employee_id, amount, category, reason, status, rule_id,
+		  decision_action, routed_to_role, routed_to_user_id, approval_comment, decision_trace)
+		 VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, '')::user_role, $9,
+		         COALESCE(NULLIF($10, ''), 'Not Updated by manager'), $11)
+		 RETURNING id`
+	expenseQueryGetByID = `SELECT employee_id, status, amount,
+		        COALESCE(routed_to_role::TEXT, ''), routed_to_user_id
 		 FROM expense_requests
-		 WHERE id=$1`
+		 WHERE id=$1
+		 FOR UPDATE`
 	expenseQueryUpdateStatus = `UPDATE expense_requests
 		 SET status=$1,
 		     approved_by_id=$2,
 		     approval_comment=$3
 		 WHERE id=$4`
-	expenseQueryGetPendingForManager = `SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.created_at 
+	expenseQueryGetPendingForManager = `SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.created_at, er.decision_trace 
 		 FROM expense_requests er
 		 JOIN users u ON er.employee_id = u.id
-		 WHERE er.status='PENDING' AND u.manager_id=$1
+		 WHERE er.status='PENDING' AND ((er.routed_to_role IS NULL AND er.routed_to_user_id IS NULL AND u.manager_id=ANY($1)) OR er.routed_to_user_id=ANY($1) OR (er.routed_to_role='MANAGER' AND u.role='EMPLOYEE'))
 		 ORDER BY er.created_at DESC
 		 LIMIT $2 OFFSET $3`
-	expenseQueryGetPendingForAdmin = `SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.created_at
+	expenseQueryGetPendingForAdmin = `SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.created_at, er.decision_trace
 		 FROM expense_requests er
 		 JOIN users u ON er.employee_id = u.id
 		 WHERE er.status='PENDING'
@@ -38,7 +43,7 @@ const (
 		 LIMIT $1 OFFSET $2`
 	expenseQueryCancel                 = `UPDATE expense_requests SET status='CANCELLED' WHERE id=$1`
 	expenseQueryGetPendingRequests     = "SELECT id, created_at FROM expense_requests WHERE status='PENDING'"
-	expenseQueryCountPendingForManager = `SELECT COUNT(*) FROM expense_requests er JOIN users u ON er.employee_id = u.id WHERE er.status='PENDING' AND u.manager_id=$1`
+	expenseQueryCountPendingForManager = `SELECT COUNT(*) FROM expense_requests er JOIN users u ON er.employee_id = u.id WHERE er.status='PENDING' AND ((er.routed_to_role IS NULL AND er.routed_to_user_id IS NULL AND u.manager_id=ANY($1)) OR er.routed_to_user_id=ANY($1) OR (er.routed_to_role='MANAGER' AND u.role='EMPLOYEE'))`
 	expenseQueryCountPendingForAdmin   = `SELECT COUNT(*) FROM expense_requests WHERE status='PENDING'`
 )
 
@@ -52,7 +57,12 @@ func NewExpenseRequestRepository(ctx context.Context, db interfaces.DB) interfac
 }
 
 func (r *expenseRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.ExpenseRequest) error {
-	_, err := tx.Exec(
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return apperrors.ErrInsertFailed
+	}
+
+	err = tx.QueryRow(
 		ctx,
 		expenseQueryCreate,
 		req.EmployeeID,
@@ -61,11 +71,17 @@ func (r *expenseRequestRepository) Create(ctx context.Context, tx interfaces.Tx,
 		req.Reason,
 		req.Status,
 		req.RuleID,
-	)
+		req.DecisionAction,
+		req.RoutedToRole,
+		req.RoutedToUserID,
+		req.ApprovalComment,
+		traceJSON,
+	).Scan(&req.ID)
 
 	return utils.MapPgError(err)
 }
 
+// GetByID locks and returns the request so concurrent decisions on it run one at a time
 func (r *expenseRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.ExpenseRequest, error) {
 	var req models.ExpenseRequest
 
@@ -73,7 +89,7 @@ func (r *expenseRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx
 		ctx,
 		expenseQueryGetByID,
 		requestID,
-	).Scan(&req.EmployeeID, &req.Status, &req.Amount)
+	).Scan(&req.EmployeeID, &req.Status, &req.Amount, &req.RoutedToRole, &req.RoutedToUserID)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -96,9 +112,9 @@ func (r *expenseRequestRepository) UpdateStatus(ctx context.Context, tx interfac
 	return utils.MapPgError(err)
 }
 
-func (r *expenseRequestRepository) GetPendingForManager(ctx context.Context, managerID int64, limit, offset int) ([]map[string]interface{}, int, error) {
+func (r *expenseRequestRepository) GetPendingForManager(ctx context.Context, managerIDs []int64, limit, offset int) ([]map[string]interface{}, int, error) {
 	var total int
-	err := r.db.QueryRow(ctx, expenseQueryCountPendingForManager, managerID).Scan(&total)
+	err := r.db.QueryRow(ctx, expenseQueryCountPendingForManager, managerIDs).Scan(&total)
 	if err != nil {
 		return nil, 0, utils.MapPgError(err)
 	}
@@ -106,7 +122,7 @@ func (r *expenseRequestRepository) GetPendingForManager(ctx context.Context, man
 	rows, err := r.db.Query(
 		ctx,
 		expenseQueryGetPendingForManager,
-		managerID, limit, offset,
+		managerIDs, limit, offset,
 	)
 	if err != nil {
 		return nil, total, utils.MapPgError(err)
@@ -124,21 +140,23 @@ func (r *expenseRequestRepository) GetPendingForManager(ctx context.Context, man
 			reason     *string
 			amount     float64
 			createdAt  time.Time
+			trace      []byte
 		)
 
-		if err := rows.Scan(&id, &employeeID, &name, &amount, &category, &reason, &createdAt); err != nil {
+		if err := rows.Scan(&id, &employeeID, &name, &amount, &category, &reason, &createdAt, &trace); err != nil {
 			return nil, total, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"user_id":    employeeID,
-			"employee":   name,
-			"amount":     amount,
-			"category":   category,
-			"reason":     reason,
-			"status":     "PENDING",
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":             id,
+			"user_id":        employeeID,
+			"employee":       name,
+			"amount":         amount,
+			"category":       category,
+			"reason":         reason,
+			"status":         "PENDING",
+			"created_at":     createdAt.Format(time.RFC3339),
+			"decision_trace": decisionTraceValue(trace),
 		})
 	}
 
@@ -173,21 +191,23 @@ func (r *expenseRequestRepository) GetPendingForAdmin(ctx context.Context, limit
 			reason     *string
 			amount     float64
 			createdAt  time.Time
+			trace      []byte
 		)
 
-		if err := rows.Scan(&id, &employeeID, &name, &amount, &category, &reason, &createdAt); err != nil {
+		if err := rows.Scan(&id, &employeeID, &name, &amount, &category, &reason, &createdAt, &trace); err != nil {
 			return nil, total, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"user_id":    employeeID,
-			"employee":   name,
-			"amount":     amount,
-			"category":   category,
-			"reason":     reason,
-			"status":     "PENDING",
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":             id,
+			"user_id":        employeeID,
+			"employee":       name,
+			"amount":         amount,
+			"category":       category,
+			"reason":         reason,
+			"status":         "PENDING",
+			"created_at":     createdAt.Format(time.RFC3339),
+			"decision_trace": decisionTraceValue(trace),
 		})
 	}
 
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	feedTokenColumns = `id, user_id, scope, created_at, last_used_at, revoked_at`
+
+	feedQueryCreate = `INSERT INTO calendar_feed_tokens (user_id, token_hash, scope)
+		 VALUES ($1, $2, $3)
+		 RETURNING id, created_at`
+	feedQueryGetByID = `SELECT ` + feedTokenColumns + `
+		 FROM calendar_feed_tokens
+		 WHERE id = $1`
+	feedQueryGetForUser = `SELECT ` + feedTokenColumns + `
+		 FROM calendar_feed_tokens
+		 WHERE user_id = $1 AND revoked_at IS NULL
+		 ORDER BY created_at, id`
+	feedQueryRevoke = `UPDATE calendar_feed_tokens SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
+	// with the current role of the owner, which decides whether a team feed may still be served
+	feedQueryUse = `UPDATE calendar_feed_tokens t SET last_used_at = NOW()
+		 FROM users u
+		 WHERE t.token_hash = $1 AND t.revoked_at IS NULL AND u.id = t.user_id
+		 RETURNING t.id, t.user_id, t.scope, t.created_at, t.last_used_at, t.revoked_at, u.role`
+	// a team feed has the leave of the user and of their direct reports
+	feedQueryGetApprovedLeaves = `SELECT lr.id, lr.employee_id, u.name, lr.leave_type, lr.from_date, lr.to_date,
+		        lr.from_session, lr.to_session, lr.hours, lr.days::FLOAT8
+		 FROM leave_requests lr JOIN users u ON lr.employee_id = u.id
+		 WHERE (lr.employee_id = $1 OR ($2 AND u.manager_id = $1))
+		   AND lr.status IN ('APPROVED', 'AUTO_APPROVED')
+		   AND lr.to_date >= $3
+		 ORDER BY lr.from_date, lr.id`
+)
+
+type feedRepository struct {
+	db interfaces.DB
+}
+
+// NewFeedRepository creates a new instance
+func NewFeedRepository(ctx context.Context, db interfaces.DB) interfaces.FeedRepository {
+	return &feedRepository{db: db}
+}
+
+func (r *feedRepository) Create(ctx context.Context, token *models.FeedToken) error {
+	err := r.db.QueryRow(
+		ctx,
+		feedQueryCreate,
+		token.UserID,
+		token.TokenHash,
+		token.Scope,
+	).Scan(&token.ID, &token.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+func (r *feedRepository) GetByID(ctx context.Context, tokenID int64) (*models.FeedToken, error) {
+	rows, err := r.db.Query(ctx, feedQueryGetByID, tokenID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return firstFeedToken(rows)
+}
+
+// GetForUser lists the tokens of the user that are not revoked
+func (r *feedRepository) GetForUser(ctx context.Context, userID int64) ([]models.FeedToken, error) {
+	rows, err := r.db.Query(ctx, feedQueryGetForUser, userID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanFeedTokens(rows)
+}
+
+func (r *feedRepository) Revoke(ctx context.Context, tokenID int64) error {
+	tag, err := r.db.Exec(ctx, feedQueryRevoke, tokenID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrFeedTokenNotFound
+	}
+
+	return nil
+}
+
+// Use returns the token with the hash unless it is revoked, recording that it was used
+func (r *feedRepository) Use(ctx context.Context, tokenHash string) (*models.FeedToken, error) {
+	var t models.FeedToken
+	err := r.db.QueryRow(ctx, feedQueryUse, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&t.Scope,
+		&t.CreatedAt,
+		&t.LastUsedAt,
+		&t.RevokedAt,
+		&t.OwnerRole,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, apperrors.ErrFeedTokenNotFound
+	}
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	return &t, nil
+}
+
+// GetApprovedLeaves lists the approved leave of the user, and of their direct reports for
+// a team, that ends on or after since
+func (r *feedRepository) GetApprovedLeaves(ctx context.Context, userID int64, team bool, since time.Time) ([]models.FeedLeave, error) {
+	rows, err := r.db.Query(ctx, feedQueryGetApprovedLeaves, userID, team, since)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	leaves := []models.FeedLeave{}
+	for rows.Next() {
+		var l models.FeedLeave
+		if err := rows.Scan(
+			&l.ID,
+			&l.EmployeeID,
+			&l.EmployeeName,
+			&l.LeaveType,
+			&l.FromDate,
+			&l.ToDate,
+			&l.FromSession,
+			&l.ToSession,
+			&l.Hours,
+			&l.Days,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		leaves = append(leaves, l)
+	}
+
+	return leaves, utils.MapPgError(rows.Err())
+}
+
+func firstFeedToken(rows interfaces.Rows) (*models.FeedToken, error) {
+	tokens, err := scanFeedTokens(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, apperrors.ErrFeedTokenNotFound
+	}
+
+	return &tokens[0], nil
+}
+
+func scanFeedTokens(rows interfaces.Rows) ([]models.FeedToken, error) {
+	tokens := []models.FeedToken{}
+
+	for rows.Next() {
+		var t models.FeedToken
+		if err := rows.Scan(
+			&t.ID,
+			&t.UserID,
+			&t.Scope,
+			&t.CreatedAt,
+			&t.LastUsedAt,
+			&t.RevokedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		tokens = append(tokens, t)
+	}
+
+	return tokens, utils.MapPgError(rows.Err())
+}
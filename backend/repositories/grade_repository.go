@@ -4,12 +4,23 @@ import (
 	"context"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 const (
 	gradeQueryGetLimits = `SELECT annual_leave_limit, annual_expense_limit, discount_limit_percent
 		 FROM grades WHERE id=$1`
+	gradeQueryGetAll = `SELECT id, name, annual_leave_limit, annual_expense_limit, discount_limit_percent
+		 FROM grades ORDER BY id`
+	gradeQueryUpsert = `INSERT INTO grades (name, annual_leave_limit, annual_expense_limit, discount_limit_percent)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (name)
+		 DO UPDATE SET
+		 	annual_leave_limit     = EXCLUDED.annual_leave_limit,
+		 	annual_expense_limit   = EXCLUDED.annual_expense_limit,
+		 	discount_limit_percent = EXCLUDED.discount_limit_percent
+		 RETURNING id`
 )
 
 type gradeRepository struct {
@@ -31,3 +42,45 @@ func (r *gradeRepository) GetLimits(ctx context.Context, tx interfaces.Tx, grade
 	err = utils.MapPgError(err)
 	return
 }
+
+func (r *gradeRepository) GetAll(ctx context.Context) ([]models.Grade, error) {
+	return getGrades(ctx, r.db)
+}
+
+// GetAllInTx lists the grades as the transaction sees them
+func (r *gradeRepository) GetAllInTx(ctx context.Context, tx interfaces.Tx) ([]models.Grade, error) {
+	return getGrades(ctx, tx)
+}
+
+func getGrades(ctx context.Context, q policyQuerier) ([]models.Grade, error) {
+	rows, err := q.Query(ctx, gradeQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var grades []models.Grade
+	for rows.Next() {
+		var g models.Grade
+		if err := rows.Scan(&g.ID, &g.Name, &g.AnnualLeaveLimit, &g.AnnualExpenseLimit, &g.DiscountLimitPercent); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		grades = append(grades, g)
+	}
+
+	return grades, utils.MapPgError(rows.Err())
+}
+
+// Upsert creates the grade or updates the limits of the grade with the same name
+func (r *gradeRepository) Upsert(ctx context.Context, tx interfaces.Tx, grade *models.Grade) error {
+	err := tx.QueryRow(
+		ctx,
+		gradeQueryUpsert,
+		grade.Name,
+		grade.AnnualLeaveLimit,
+		grade.AnnualExpenseLimit,
+		grade.DiscountLimitPercent,
+	).Scan(&grade.ID)
+
+	return utils.MapPgError(err)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -10,7 +11,7 @@ import (
 )
 
 const (
-	helperQueryGetMyLeaves = `SELECT id, leave_type, from_date, to_date, status, reason, approval_comment, created_at
+	helperQueryGetMyLeaves = `SELECT id, leave_type, from_date, to_date, from_session, to_session, hours, days::FLOAT8, status, reason, approval_comment, created_at
 		 FROM leave_requests
 		 WHERE employee_id = $1
 		 ORDER BY created_at DESC`
@@ -25,11 +26,17 @@ const (
 	helperQueryCountMyLeaves    = `SELECT COUNT(*) FROM leave_requests WHERE employee_id = $1`
 	helperQueryCountMyExpenses  = `SELECT COUNT(*) FROM expense_requests WHERE employee_id = $1`
 	helperQueryCountMyDiscounts = `SELECT COUNT(*) FROM discount_requests WHERE employee_id = $1`
-	helperQueryAddHoliday       = `INSERT INTO holidays (holiday_date, description, created_by)
-		 VALUES ($1,$2,$3)`
-	helperQueryGetHolidays   = `SELECT id, holiday_date, description FROM holidays ORDER BY holiday_date`
+	helperQueryAddHoliday       = `INSERT INTO holidays (calendar_id, holiday_date, description, created_by)
+		 VALUES ($1,$2,$3,$4)`
+	helperQueryGetHolidays = `SELECT id, holiday_date, description FROM holidays
+		 WHERE calendar_id=$1 ORDER BY holiday_date`
 	helperQueryDeleteHoliday = `DELETE FROM holidays WHERE id=$1`
-	helperQueryGetStatusDist = `
+	helperQueryUpsertHoliday = `INSERT INTO holidays (calendar_id, holiday_date, description, created_by)
+		 VALUES ($1,$2,$3,$4)
+		 ON CONFLICT (calendar_id, holiday_date)
+		 DO UPDATE SET description = EXCLUDED.description`
+	helperQueryDeleteHolidayByDate = `DELETE FROM holidays WHERE calendar_id=$1 AND holiday_date=$2`
+	helperQueryGetStatusDist       = `
 				SELECT status_text, COUNT(*) FROM (
 			SELECT status::text AS status_text FROM leave_requests
 		) l
@@ -66,7 +73,12 @@ const (
 		COUNT(*) FILTER (WHERE status_text='AUTO_APPROVED')
 	FROM (SELECT status::text AS status_text FROM discount_requests) d
 	`
-	helperQueryIsHoliday = `SELECT COUNT(*) FROM holidays WHERE holiday_date=$1`
+	helperQueryIsHoliday          = `SELECT COUNT(*) FROM holidays WHERE calendar_id=$1 AND holiday_date=$2`
+	helperQueryGetLeaveByCalendar = `SELECT c.id, c.name, COUNT(l.id), COALESCE(SUM(l.days), 0)::FLOAT8
+		 FROM work_calendars c
+		 LEFT JOIN leave_requests l ON l.calendar_id = c.id AND l.status IN ('APPROVED', 'AUTO_APPROVED')
+		 GROUP BY c.id, c.name
+		 ORDER BY c.name`
 )
 
 type myRequestsRepository struct {
@@ -103,6 +115,10 @@ func (r *myRequestsRepository) GetMyLeaveRequests(ctx context.Context, userID in
 			leaveType string
 			fromDate  time.Time
 			toDate    time.Time
+			fromSess  string
+			toSess    string
+			hours     int
+			days      float64
 			status    string
 			reason    string
 			comment   *string
@@ -114,6 +130,10 @@ func (r *myRequestsRepository) GetMyLeaveRequests(ctx context.Context, userID in
 			&leaveType,
 			&fromDate,
 			&toDate,
+			&fromSess,
+			&toSess,
+			&hours,
+			&days,
 			&status,
 			&reason,
 			&comment,
@@ -123,13 +143,17 @@ func (r *myRequestsRepository) GetMyLeaveRequests(ctx context.Context, userID in
 		}
 
 		response := map[string]interface{}{
-			"id":         id,
-			"leave_type": leaveType,
-			"from_date":  fromDate.Format("2006-01-02"),
-			"to_date":    toDate.Format("2006-01-02"),
-			"status":     status,
-			"reason":     reason,
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":           id,
+			"leave_type":   leaveType,
+			"from_date":    fromDate.Format("2006-01-02"),
+			"to_date":      toDate.Format("2006-01-02"),
+			"from_session": fromSess,
+			"to_session":   toSess,
+			"hours":        hours,
+			"days":         days,
+			"status":       status,
+			"reason":       reason,
+			"created_at":   createdAt.Format(time.RFC3339),
 		}
 
 		if comment != nil {
@@ -261,7 +285,7 @@ func (r *myRequestsRepository) GetMyAllRequests(ctx context.Context, userID int6
 	return nil, nil, nil, 0, nil
 }
 
-func (r *myRequestsRepository) GetPendingAllRequests(ctx context.Context, role string, approverID int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error) {
+func (r *myRequestsRepository) GetPendingAllRequests(ctx context.Context, role string, approverIDs []int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error) {
 	return nil, nil, nil, 0, nil
 }
 
@@ -271,19 +295,29 @@ type holidayRepository struct {
 	db interfaces.DB
 }
 
-func (r *holidayRepository) AddHoliday(ctx context.Context, date time.Time, desc string, adminID int64) error {
+func (r *holidayRepository) AddHoliday(ctx context.Context, calendarID int64, date time.Time, desc string, adminID int64) error {
 	_, err := r.db.Exec(
 		ctx,
 		helperQueryAddHoliday,
-		date, desc, adminID,
+		calendarID, date, desc, adminID,
 	)
 	return utils.MapPgError(err)
 }
 
-func (r *holidayRepository) GetHolidays(ctx context.Context) ([]map[string]interface{}, error) {
-	rows, err := r.db.Query(
+func (r *holidayRepository) GetHolidays(ctx context.Context, calendarID int64) ([]map[string]interface{}, error) {
+	return getHolidays(ctx, r.db, calendarID)
+}
+
+// GetHolidaysInTx lists the holidays of the calendar as the transaction sees them
+func (r *holidayRepository) GetHolidaysInTx(ctx context.Context, tx interfaces.Tx, calendarID int64) ([]map[string]interface{}, error) {
+	return getHolidays(ctx, tx, calendarID)
+}
+
+func getHolidays(ctx context.Context, q policyQuerier, calendarID int64) ([]map[string]interface{}, error) {
+	rows, err := q.Query(
 		ctx,
 		helperQueryGetHolidays,
+		calendarID,
 	)
 	if err != nil {
 		return nil, utils.MapPgError(err)
@@ -319,6 +353,25 @@ func (r *holidayRepository) DeleteHoliday(ctx context.Context, holidayID int64)
 	return utils.MapPgError(err)
 }
 
+// UpsertHoliday adds the holiday or updates the description of the holiday on that date
+func (r *holidayRepository) UpsertHoliday(ctx context.Context, tx interfaces.Tx, calendarID int64, date time.Time, desc string, adminID int64) error {
+	_, err := tx.Exec(
+		ctx,
+		helperQueryUpsertHoliday,
+		calendarID, date, desc, adminID,
+	)
+	return utils.MapPgError(err)
+}
+
+func (r *holidayRepository) DeleteHolidayByDate(ctx context.Context, tx interfaces.Tx, calendarID int64, date time.Time) error {
+	_, err := tx.Exec(
+		ctx,
+		helperQueryDeleteHolidayByDate,
+		calendarID, date,
+	)
+	return utils.MapPgError(err)
+}
+
 type reportRepository struct {
 	db interfaces.DB
 }
@@ -418,12 +471,32 @@ func (r *reportRepository) GetPendingDiscountCount(ctx context.Context) (int, er
 	return count, utils.MapPgError(err)
 }
 
-func (r *holidayRepository) IsHoliday(ctx context.Context, date time.Time) (bool, error) {
+// GetLeaveByCalendar totals the approved leave charged on each work calendar
+func (r *reportRepository) GetLeaveByCalendar(ctx context.Context) ([]models.CalendarLeaveReport, error) {
+	rows, err := r.db.Query(ctx, helperQueryGetLeaveByCalendar)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	reports := []models.CalendarLeaveReport{}
+	for rows.Next() {
+		var c models.CalendarLeaveReport
+		if err := rows.Scan(&c.CalendarID, &c.Calendar, &c.Requests, &c.Days); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		reports = append(reports, c)
+	}
+
+	return reports, utils.MapPgError(rows.Err())
+}
+
+func (r *holidayRepository) IsHoliday(ctx context.Context, calendarID int64, date time.Time) (bool, error) {
 	var count int
 	err := r.db.QueryRow(
 		ctx,
 		helperQueryIsHoliday,
-		date.Format("2006-01-02"),
+		calendarID, date.Format("2006-01-02"),
 	).Scan(&count)
 	return count > 0, utils.MapPgError(err)
 }
@@ -431,3 +504,19 @@ func (r *holidayRepository) IsHoliday(ctx context.Context, date time.Time) (bool
 func NewHolidayRepository(ctx context.Context, db interfaces.DB) interfaces.HolidayRepository {
 	return &holidayRepository{db: db}
 }
+
+// marshalDecisionTrace encodes a decision trace for a JSONB column (NULL when absent)
+func marshalDecisionTrace(trace *models.DecisionTrace) ([]byte, error) {
+	if trace == nil {
+		return nil, nil
+	}
+	return json.Marshal(trace)
+}
+
+// decisionTraceValue passes a stored decision trace through to the JSON response as-is
+func decisionTraceValue(raw []byte) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.RawMessage(raw)
+}
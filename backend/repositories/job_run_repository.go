@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+const (
+	// jobRunQueryTryLock takes a transaction-scoped advisory lock keyed by the job name,
+	// so it is released with the transaction even if the instance dies mid-run
+	jobRunQueryTryLock = `SELECT pg_try_advisory_xact_lock(hashtext($1))`
+	// only the holder of the job's lock runs it, so a run still RUNNING when the lock is
+	// taken was cut off by its instance dying
+	jobRunQueryFailStale = `UPDATE job_runs
+		 SET status = 'FAILED',
+		     error = 'run was interrupted before it finished',
+		     finished_at = NOW()
+		 WHERE job_name = $1 AND status = 'RUNNING'`
+
+	jobRunQueryStart = `INSERT INTO job_runs (job_name, status, triggered_by, instance)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id, started_at`
+	jobRunQueryFinish = `UPDATE job_runs
+		 SET status = $1,
+		     error = NULLIF($2, ''),
+		     counts = $3,
+		     finished_at = NOW()
+		 WHERE id = $4
+		 RETURNING finished_at`
+	jobRunQueryGetRecent = `SELECT id, job_name, status, started_at, finished_at, COALESCE(error, ''),
+		        counts, triggered_by, instance
+		 FROM job_runs
+		 WHERE $1 = '' OR job_name = $1
+		 ORDER BY started_at DESC, id DESC
+		 LIMIT $2`
+
+	jobRunQueryGetPaused = `SELECT job_name FROM scheduled_jobs WHERE paused`
+	jobRunQuerySetPaused = `INSERT INTO scheduled_jobs (job_name, paused, updated_by, updated_at)
+		 VALUES ($1, $2, $3, NOW())
+		 ON CONFLICT (job_name) DO UPDATE
+		 SET paused = EXCLUDED.paused,
+		     updated_by = EXCLUDED.updated_by,
+		     updated_at = EXCLUDED.updated_at`
+)
+
+type jobRunRepository struct {
+	db interfaces.DB
+}
+
+// NewJobRunRepository creates a new instance
+func NewJobRunRepository(ctx context.Context, db interfaces.DB) interfaces.JobRunRepository {
+	return &jobRunRepository{db: db}
+}
+
+// TryLock reports whether this instance got the lock of the job; the lock is held until tx ends
+func (r *jobRunRepository) TryLock(ctx context.Context, tx interfaces.Tx, jobName string) (bool, error) {
+	var locked bool
+	err := tx.QueryRow(ctx, jobRunQueryTryLock, jobName).Scan(&locked)
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+	return locked, nil
+}
+
+// FailStaleRuns marks the runs of the job left RUNNING by a crashed instance as FAILED
+func (r *jobRunRepository) FailStaleRuns(ctx context.Context, jobName string) error {
+	_, err := r.db.Exec(ctx, jobRunQueryFailStale, jobName)
+	return utils.MapPgError(err)
+}
+
+// Start records a run as running
+func (r *jobRunRepository) Start(ctx context.Context, run *models.JobRun) error {
+	err := r.db.QueryRow(
+		ctx,
+		jobRunQueryStart,
+		run.JobName,
+		run.Status,
+		run.TriggeredBy,
+		run.Instance,
+	).Scan(&run.ID, &run.StartedAt)
+
+	return utils.MapPgError(err)
+}
+
+// Finish records the outcome of a run
+func (r *jobRunRepository) Finish(ctx context.Context, run *models.JobRun) error {
+	counts := run.Counts
+	if counts == nil {
+		counts = map[string]int{}
+	}
+
+	err := r.db.QueryRow(
+		ctx,
+		jobRunQueryFinish,
+		run.Status,
+		run.Error,
+		counts,
+		run.ID,
+	).Scan(&run.FinishedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetRecent lists the latest runs, of one job when jobName is set
+func (r *jobRunRepository) GetRecent(ctx context.Context, jobName string, limit int) ([]models.JobRun, error) {
+	rows, err := r.db.Query(ctx, jobRunQueryGetRecent, jobName, limit)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	runs := []models.JobRun{}
+	for rows.Next() {
+		var run models.JobRun
+		if err := rows.Scan(
+			&run.ID,
+			&run.JobName,
+			&run.Status,
+			&run.StartedAt,
+			&run.FinishedAt,
+			&run.Error,
+			&run.Counts,
+			&run.TriggeredBy,
+			&run.Instance,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, utils.MapPgError(rows.Err())
+}
+
+// GetPausedJobs returns the names of the paused jobs
+func (r *jobRunRepository) GetPausedJobs(ctx context.Context) (map[string]bool, error) {
+	rows, err := r.db.Query(ctx, jobRunQueryGetPaused)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	paused := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		paused[name] = true
+	}
+
+	return paused, utils.MapPgError(rows.Err())
+}
+
+// SetPaused pauses or resumes a job on every instance
+func (r *jobRunRepository) SetPaused(ctx context.Context, jobName string, paused bool, adminID int64) error {
+	_, err := r.db.Exec(ctx, jobRunQuerySetPaused, jobName, paused, adminID)
+	return utils.MapPgError(err)
+}
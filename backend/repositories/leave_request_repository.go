@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 
@@ -15,39 +16,51 @@ import (
 
 const (
 	leaveQueryCreate = `INSERT INTO leave_requests
-		 (employee_id, from_date, to_date, reason, leave_type, status, rule_id)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7)`
-	leaveQueryGetByID = `SELECT employee_id, status, from_date, to_date
+		 (employee_id, from_date, to_date, reason, leave_type, status, rule_id,
+		  decision_action, routed_to_role, routed_to_user_id, approval_comment, decision_trace, balance_exempt,
+		  from_session, to_session, hours, days, calendar_id)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, '')::user_role, $10,
+		         COALESCE(NULLIF($11, ''), 'Not Updated by manager'), $12, $13, $14, $15, $16, $17, NULLIF($18::BIGINT, 0))
+		 RETURNING id`
+	leaveQueryGetByID = `SELECT employee_id, status, from_date, to_date, from_session, to_session,
+		        hours, days::FLOAT8, leave_type, COALESCE(balance_leave_type, leave_type), balance_exempt,
+		        COALESCE(routed_to_role::TEXT, ''), routed_to_user_id
 		 FROM leave_requests
-		 WHERE id=$1`
+		 WHERE id=$1
+		 FOR UPDATE`
 	leaveQueryUpdateStatus = `UPDATE leave_requests
 		 SET status=$1,
 		     approved_by_id=$2,
 		     approval_comment=$3
 		 WHERE id=$4`
-	leaveQueryGetPendingForManager = `SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.created_at 
+	leaveQueryGetPendingForManager = `SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.from_session, lr.to_session, lr.hours, lr.days::FLOAT8,
+		        lr.leave_type, lr.reason, lr.created_at, lr.decision_trace 
 		 FROM leave_requests lr
 		 JOIN users u ON lr.employee_id = u.id
 		 WHERE lr.status='PENDING'
-		   AND u.manager_id=$1
+		   AND ((lr.routed_to_role IS NULL AND lr.routed_to_user_id IS NULL AND u.manager_id=ANY($1)) OR lr.routed_to_user_id=ANY($1) OR (lr.routed_to_role='MANAGER' AND u.role='EMPLOYEE'))
 		 ORDER BY lr.created_at DESC
 		 LIMIT $2 OFFSET $3`
-	leaveQueryGetPendingForAdmin = `SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.created_at
+	leaveQueryGetPendingForAdmin = `SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.from_session, lr.to_session, lr.hours, lr.days::FLOAT8,
+		        lr.leave_type, lr.reason, lr.created_at, lr.decision_trace
 		 FROM leave_requests lr
 		 JOIN users u ON lr.employee_id = u.id
 		 WHERE lr.status='PENDING'
 		 ORDER BY lr.created_at DESC
 		 LIMIT $1 OFFSET $2`
+	// requests overlap when they share a half day: a request starts on the second half
+	// of its first date when it takes its SECOND_HALF and ends on the first half of its
+	// last date when it takes its FIRST_HALF (false sorts before true)
 	leaveQueryCheckOverlap = `SELECT 1
 		 FROM leave_requests
 		 WHERE employee_id = $1
 		   AND status IN ('PENDING', 'APPROVED', 'AUTO_APPROVED') 
-		   AND from_date <= $2
-		   AND to_date >= $3
+		   AND (from_date, from_session = 'SECOND_HALF') <= ($2, $3)
+		   AND (to_date, to_session <> 'FIRST_HALF') >= ($4, $5)
 		 LIMIT 1`
 	leaveQueryCancel                 = `UPDATE leave_requests SET status='CANCELLED' WHERE id=$1`
 	leaveQueryGetPendingRequests     = "SELECT id, created_at FROM leave_requests WHERE status='PENDING'"
-	leaveQueryCountPendingForManager = `SELECT COUNT(*) FROM leave_requests lr JOIN users u ON lr.employee_id = u.id WHERE lr.status='PENDING' AND u.manager_id=$1`
+	leaveQueryCountPendingForManager = `SELECT COUNT(*) FROM leave_requests lr JOIN users u ON lr.employee_id = u.id WHERE lr.status='PENDING' AND ((lr.routed_to_role IS NULL AND lr.routed_to_user_id IS NULL AND u.manager_id=ANY($1)) OR lr.routed_to_user_id=ANY($1) OR (lr.routed_to_role='MANAGER' AND u.role='EMPLOYEE'))`
 	leaveQueryCountPendingForAdmin   = `SELECT COUNT(*) FROM leave_requests WHERE status='PENDING'`
 )
 
@@ -60,7 +73,12 @@ func NewLeaveRequestRepository(ctx context.Context, db interfaces.DB) interfaces
 }
 
 func (r *leaveRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.LeaveRequest) error {
-	_, err := tx.Exec(
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return apperrors.ErrInsertFailed
+	}
+
+	err = tx.QueryRow(
 		ctx,
 		leaveQueryCreate,
 		req.EmployeeID,
@@ -70,11 +88,23 @@ func (r *leaveRequestRepository) Create(ctx context.Context, tx interfaces.Tx, r
 		req.LeaveType,
 		req.Status,
 		req.RuleID,
-	)
+		req.DecisionAction,
+		req.RoutedToRole,
+		req.RoutedToUserID,
+		req.ApprovalComment,
+		traceJSON,
+		req.BalanceExempt,
+		req.FromSession,
+		req.ToSession,
+		req.Hours,
+		req.Days,
+		req.CalendarID,
+	).Scan(&req.ID)
 
 	return utils.MapPgError(err)
 }
 
+// GetByID locks and returns the request so concurrent decisions on it run one at a time
 func (r *leaveRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.LeaveRequest, error) {
 	var req models.LeaveRequest
 
@@ -82,7 +112,10 @@ func (r *leaveRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx,
 		ctx,
 		leaveQueryGetByID,
 		requestID,
-	).Scan(&req.EmployeeID, &req.Status, &req.FromDate, &req.ToDate)
+	).Scan(
+		&req.EmployeeID, &req.Status, &req.FromDate, &req.ToDate, &req.FromSession, &req.ToSession,
+		&req.Hours, &req.Days, &req.LeaveType, &req.BalanceLeaveType, &req.BalanceExempt, &req.RoutedToRole, &req.RoutedToUserID,
+	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -105,9 +138,9 @@ func (r *leaveRequestRepository) UpdateStatus(ctx context.Context, tx interfaces
 	return utils.MapPgError(err)
 }
 
-func (r *leaveRequestRepository) GetPendingForManager(ctx context.Context, managerID int64, limit, offset int) ([]map[string]interface{}, int, error) {
+func (r *leaveRequestRepository) GetPendingForManager(ctx context.Context, managerIDs []int64, limit, offset int) ([]map[string]interface{}, int, error) {
 	var total int
-	err := r.db.QueryRow(ctx, leaveQueryCountPendingForManager, managerID).Scan(&total)
+	err := r.db.QueryRow(ctx, leaveQueryCountPendingForManager, managerIDs).Scan(&total)
 	if err != nil {
 		return nil, 0, utils.MapPgError(err)
 	}
@@ -115,7 +148,7 @@ func (r *leaveRequestRepository) GetPendingForManager(ctx context.Context, manag
 	rows, err := r.db.Query(
 		ctx,
 		leaveQueryGetPendingForManager,
-		managerID, limit, offset,
+		managerIDs, limit, offset,
 	)
 	if err != nil {
 		return nil, total, utils.MapPgError(err)
@@ -133,23 +166,33 @@ func (r *leaveRequestRepository) GetPendingForManager(ctx context.Context, manag
 			reason     string
 			fromDate   time.Time
 			toDate     time.Time
+			fromSess   string
+			toSess     string
+			hours      int
+			days       float64
 			createdAt  time.Time
+			trace      []byte
 		)
 
-		if err := rows.Scan(&id, &employeeID, &name, &fromDate, &toDate, &leaveType, &reason, &createdAt); err != nil {
+		if err := rows.Scan(&id, &employeeID, &name, &fromDate, &toDate, &fromSess, &toSess, &hours, &days, &leaveType, &reason, &createdAt, &trace); err != nil {
 			return nil, total, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"user_id":    employeeID,
-			"employee":   name,
-			"from_date":  fromDate.Format("2006-01-02"),
-			"to_date":    toDate.Format("2006-01-02"),
-			"leave_type": leaveType,
-			"reason":     reason,
-			"status":     "PENDING", // Since query filters by PENDING
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":             id,
+			"user_id":        employeeID,
+			"employee":       name,
+			"from_date":      fromDate.Format("2006-01-02"),
+			"to_date":        toDate.Format("2006-01-02"),
+			"from_session":   fromSess,
+			"to_session":     toSess,
+			"hours":          hours,
+			"days":           days,
+			"leave_type":     leaveType,
+			"reason":         reason,
+			"status":         "PENDING", // Since query filters by PENDING
+			"created_at":     createdAt.Format(time.RFC3339),
+			"decision_trace": decisionTraceValue(trace),
 		})
 	}
 
@@ -184,38 +227,50 @@ func (r *leaveRequestRepository) GetPendingForAdmin(ctx context.Context, limit,
 			reason     string
 			fromDate   time.Time
 			toDate     time.Time
+			fromSess   string
+			toSess     string
+			hours      int
+			days       float64
 			createdAt  time.Time
+			trace      []byte
 		)
 
-		if err := rows.Scan(&id, &employeeID, &name, &fromDate, &toDate, &leaveType, &reason, &createdAt); err != nil {
+		if err := rows.Scan(&id, &employeeID, &name, &fromDate, &toDate, &fromSess, &toSess, &hours, &days, &leaveType, &reason, &createdAt, &trace); err != nil {
 			return nil, total, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"user_id":    employeeID,
-			"employee":   name,
-			"from_date":  fromDate.Format("2006-01-02"),
-			"to_date":    toDate.Format("2006-01-02"),
-			"leave_type": leaveType,
-			"reason":     reason,
-			"status":     "PENDING",
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":             id,
+			"user_id":        employeeID,
+			"employee":       name,
+			"from_date":      fromDate.Format("2006-01-02"),
+			"to_date":        toDate.Format("2006-01-02"),
+			"from_session":   fromSess,
+			"to_session":     toSess,
+			"hours":          hours,
+			"days":           days,
+			"leave_type":     leaveType,
+			"reason":         reason,
+			"status":         "PENDING",
+			"created_at":     createdAt.Format(time.RFC3339),
+			"decision_trace": decisionTraceValue(trace),
 		})
 	}
 
 	return result, total, nil
 }
 
-func (r *leaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, fromDate, toDate time.Time) (bool, error) {
+func (r *leaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, period models.LeavePeriod) (bool, error) {
 	var dummy int
 
 	err := r.db.QueryRow(
 		ctx,
 		leaveQueryCheckOverlap,
 		userID,
-		toDate,
-		fromDate,
+		period.ToDate,
+		period.ToSession != constants.SessionFirstHalf,
+		period.FromDate,
+		period.FromSession == constants.SessionSecondHalf,
 	).Scan(&dummy)
 
 	// pgx NO ROWS = no overlap
@@ -0,0 +1,182 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	leaveTypeQueryGetAll = `SELECT t.code, t.name, t.bypass_balance, t.sandwich_rule, t.active, t.updated_by, t.updated_at,
+		        a.grade_id, a.annual_days
+		 FROM leave_types t
+		 LEFT JOIN leave_type_allocations a ON a.leave_type = t.code
+		 WHERE t.active
+		 ORDER BY t.code, a.grade_id`
+	leaveTypeQueryGetByCode = `SELECT code, name, bypass_balance, sandwich_rule, active, updated_by, updated_at
+		 FROM leave_types WHERE code = $1`
+	leaveTypeQueryGetAllocations = `SELECT grade_id, annual_days
+		 FROM leave_type_allocations WHERE leave_type = $1 ORDER BY grade_id`
+	leaveTypeQueryUpsert = `INSERT INTO leave_types (code, name, bypass_balance, sandwich_rule, active, updated_by)
+		 VALUES ($1, $2, $3, $4, TRUE, $5)
+		 ON CONFLICT (code) DO UPDATE
+		 SET name = EXCLUDED.name,
+		     bypass_balance = EXCLUDED.bypass_balance,
+		     sandwich_rule = EXCLUDED.sandwich_rule,
+		     active = TRUE,
+		     updated_by = EXCLUDED.updated_by,
+		     updated_at = NOW()
+		 RETURNING active, updated_at`
+	leaveTypeQueryDeleteAllocations = `DELETE FROM leave_type_allocations WHERE leave_type = $1`
+	leaveTypeQueryInsertAllocations = `INSERT INTO leave_type_allocations (leave_type, grade_id, annual_days)
+		 SELECT $1, grade_id, annual_days
+		 FROM UNNEST($2::BIGINT[], $3::INT[]) AS a(grade_id, annual_days)`
+	leaveTypeQueryDeactivate = `UPDATE leave_types SET active = FALSE, updated_at = NOW() WHERE code = $1 AND active`
+)
+
+type leaveTypeRepository struct {
+	db interfaces.DB
+}
+
+// NewLeaveTypeRepository creates a new instance
+func NewLeaveTypeRepository(ctx context.Context, db interfaces.DB) interfaces.LeaveTypeRepository {
+	return &leaveTypeRepository{db: db}
+}
+
+// GetAll lists the active leave types with their allocations
+func (r *leaveTypeRepository) GetAll(ctx context.Context) ([]models.LeaveType, error) {
+	rows, err := r.db.Query(ctx, leaveTypeQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	leaveTypes := []models.LeaveType{}
+	for rows.Next() {
+		var t models.LeaveType
+		var gradeID *int64
+		var annualDays *int
+
+		if err := rows.Scan(
+			&t.Code,
+			&t.Name,
+			&t.BypassBalance,
+			&t.SandwichRule,
+			&t.Active,
+			&t.UpdatedBy,
+			&t.UpdatedAt,
+			&gradeID,
+			&annualDays,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		// rows come ordered by code, one per allocation
+		if n := len(leaveTypes); n == 0 || leaveTypes[n-1].Code != t.Code {
+			t.Allocations = []models.LeaveTypeAllocation{}
+			leaveTypes = append(leaveTypes, t)
+		}
+		if gradeID != nil {
+			last := &leaveTypes[len(leaveTypes)-1]
+			last.Allocations = append(last.Allocations, models.LeaveTypeAllocation{
+				GradeID:    *gradeID,
+				AnnualDays: *annualDays,
+			})
+		}
+	}
+
+	return leaveTypes, utils.MapPgError(rows.Err())
+}
+
+// GetByCode returns a leave type, active or not, with its allocations
+func (r *leaveTypeRepository) GetByCode(ctx context.Context, code string) (*models.LeaveType, error) {
+	var t models.LeaveType
+
+	err := r.db.QueryRow(ctx, leaveTypeQueryGetByCode, code).Scan(
+		&t.Code,
+		&t.Name,
+		&t.BypassBalance,
+		&t.SandwichRule,
+		&t.Active,
+		&t.UpdatedBy,
+		&t.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, apperrors.ErrLeaveTypeNotFound
+	}
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	rows, err := r.db.Query(ctx, leaveTypeQueryGetAllocations, code)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	t.Allocations = []models.LeaveTypeAllocation{}
+	for rows.Next() {
+		var a models.LeaveTypeAllocation
+		if err := rows.Scan(&a.GradeID, &a.AnnualDays); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		t.Allocations = append(t.Allocations, a)
+	}
+
+	return &t, utils.MapPgError(rows.Err())
+}
+
+// Upsert creates the leave type or updates the one with the same code, which becomes
+// active again
+func (r *leaveTypeRepository) Upsert(ctx context.Context, tx interfaces.Tx, leaveType *models.LeaveType) error {
+	err := tx.QueryRow(
+		ctx,
+		leaveTypeQueryUpsert,
+		leaveType.Code,
+		leaveType.Name,
+		leaveType.BypassBalance,
+		leaveType.SandwichRule,
+		leaveType.UpdatedBy,
+	).Scan(&leaveType.Active, &leaveType.UpdatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// ReplaceAllocations sets the allocations of a leave type to the given ones
+func (r *leaveTypeRepository) ReplaceAllocations(ctx context.Context, tx interfaces.Tx, code string, allocations []models.LeaveTypeAllocation) error {
+	if _, err := tx.Exec(ctx, leaveTypeQueryDeleteAllocations, code); err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if len(allocations) == 0 {
+		return nil
+	}
+
+	gradeIDs := make([]int64, len(allocations))
+	annualDays := make([]int32, len(allocations))
+	for i, a := range allocations {
+		gradeIDs[i] = a.GradeID
+		annualDays[i] = int32(a.AnnualDays)
+	}
+
+	_, err := tx.Exec(ctx, leaveTypeQueryInsertAllocations, code, gradeIDs, annualDays)
+	return utils.MapPgError(err)
+}
+
+func (r *leaveTypeRepository) Deactivate(ctx context.Context, code string) error {
+	tag, err := r.db.Exec(ctx, leaveTypeQueryDeactivate, code)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrLeaveTypeNotFound
+	}
+
+	return nil
+}
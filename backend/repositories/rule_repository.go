@@ -3,36 +3,87 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
 )
 
 const (
-	ruleQueryGetByTypeAndGrade = `SELECT id, condition, action 
-		 FROM rules 
+	ruleSelectColumns = `SELECT id, request_type, condition, action, grade_id, active, priority, is_default,
+		        COALESCE(message, ''), COALESCE(route_to_role::TEXT, ''), route_to_user_id,
+		        rule_key, version, effective_from, effective_to
+		 FROM rules`
+	ruleQueryGetActiveByTypeAndGrade = ruleSelectColumns + `
 		 WHERE request_type=$1 AND grade_id=$2 AND active=true
-		 LIMIT 1`
-	ruleQueryCreate = `INSERT INTO rules (request_type, condition, action, grade_id, active)
-		 VALUES ($1, $2, $3, $4, $5)
+		   AND effective_from <= NOW()
+		   AND (effective_to IS NULL OR effective_to > NOW())
+		 ORDER BY priority, id`
+	ruleQueryCreate = `WITH next AS (SELECT nextval(pg_get_serial_sequence('rules', 'id')) AS id)
+		 INSERT INTO rules
+		 (id, rule_key, version, request_type, condition, action, grade_id, active, priority, is_default,
+		  message, route_to_role, route_to_user_id, effective_from)
+		 SELECT next.id, next.id, 1, $1, $2, $3, $4, $5, $6, $7,
+		        NULLIF($8, ''), NULLIF($9, '')::user_role, $10, COALESCE($11, NOW())
+		 FROM next
+		 RETURNING id, rule_key, version, effective_from`
+	ruleQueryInsertVersion = `INSERT INTO rules
+		 (rule_key, version, request_type, condition, action, grade_id, active, priority, is_default,
+		  message, route_to_role, route_to_user_id, effective_from)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), NULLIF($11, '')::user_role, $12, $13)
+		 RETURNING id`
+	// current and scheduled versions; closed history is served by ruleQueryGetHistory
+	ruleQueryGetAll = ruleSelectColumns + `
+		 WHERE effective_to IS NULL OR effective_to > NOW()
+		 ORDER BY request_type, grade_id, priority, id`
+	ruleQueryGetLatestVersion = ruleSelectColumns + `
+		 WHERE rule_key = (SELECT rule_key FROM rules WHERE id=$1)
+		 ORDER BY version DESC
+		 LIMIT 1
+		 FOR UPDATE`
+	ruleQueryGetHistory = ruleSelectColumns + `
+		 WHERE rule_key = (SELECT rule_key FROM rules WHERE id=$1)
+		 ORDER BY version`
+	// versions still effective at $1 or scheduled after it end there
+	ruleQueryCloseOpenVersions = `UPDATE rules SET effective_to=$1, updated_at=NOW()
+		 WHERE rule_key=$2 AND (effective_to IS NULL OR effective_to > $1)`
+	ruleQueryGetEvaluationMode = `SELECT mode FROM rule_evaluation_modes
+		 WHERE request_type=$1 AND grade_id=$2`
+	// taken by bundle imports: other imports and writers of the policy wait until the
+	// importing transaction ends, so the policy it read stays current
+	ruleQueryLockPolicy = `LOCK TABLE grades, work_calendars, holidays, rule_evaluation_modes, rules
+		 IN SHARE ROW EXCLUSIVE MODE`
+	ruleQueryGetEvaluationModes = `SELECT request_type::TEXT, grade_id, mode FROM rule_evaluation_modes
+		 ORDER BY request_type, grade_id`
+	ruleQuerySetEvaluationMode = `INSERT INTO rule_evaluation_modes (request_type, grade_id, mode)
+		 VALUES ($1, $2, $3)
 		 ON CONFLICT (request_type, grade_id)
 		 DO UPDATE SET
-		 	condition  = EXCLUDED.condition,
-		 	action     = EXCLUDED.action,
-		 	active     = EXCLUDED.active,
+		 	mode       = EXCLUDED.mode,
 		 	updated_at = NOW()`
-	ruleQueryGetAll = `SELECT id, request_type, condition, action, grade_id, active
-		 FROM rules`
-	ruleQueryUpdate = `UPDATE rules
-		 SET request_type=$1,
-		     condition=$2,
-		     action=$3,
-		     grade_id=$4,
-		     active=$5
-		 WHERE id=$6`
-	ruleQueryDelete = `DELETE FROM rules WHERE id=$1`
+	ruleQuerySimulationLeaves = `SELECT lr.id, lr.status::TEXT, lr.created_at, lr.from_date, lr.to_date, lr.days::FLOAT8, lr.leave_type,
+		        u.role::TEXT, u.grade_id, u.created_at
+		 FROM leave_requests lr
+		 JOIN users u ON lr.employee_id = u.id
+		 WHERE u.grade_id=$1 AND lr.created_at >= $2 AND lr.created_at < $3
+		 ORDER BY lr.created_at`
+	ruleQuerySimulationExpenses = `SELECT er.id, er.status::TEXT, er.created_at, er.amount, er.category,
+		        u.role::TEXT, u.grade_id, u.created_at
+		 FROM expense_requests er
+		 JOIN users u ON er.employee_id = u.id
+		 WHERE u.grade_id=$1 AND er.created_at >= $2 AND er.created_at < $3
+		 ORDER BY er.created_at`
+	ruleQuerySimulationDiscounts = `SELECT dr.id, dr.status::TEXT, dr.created_at, dr.discount_percentage,
+		        u.role::TEXT, u.grade_id, u.created_at
+		 FROM discount_requests dr
+		 JOIN users u ON dr.employee_id = u.id
+		 WHERE u.grade_id=$1 AND dr.created_at >= $2 AND dr.created_at < $3
+		 ORDER BY dr.created_at`
 )
 
 type ruleRepository struct {
@@ -44,35 +95,95 @@ func NewRuleRepository(ctx context.Context, db interfaces.DB) interfaces.RuleRep
 	return &ruleRepository{db: db}
 }
 
-func (r *ruleRepository) GetByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error) {
-	var rule models.Rule
-	var conditionJSON []byte
+func (r *ruleRepository) GetActiveByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) ([]models.Rule, error) {
+	rows, err := r.db.Query(
+		ctx,
+		ruleQueryGetActiveByTypeAndGrade,
+		requestType, gradeID,
+	)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	rules, err := scanRules(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, apperrors.ErrNoRuleFound
+	}
+
+	return rules, nil
+}
+
+func (r *ruleRepository) GetEvaluationMode(ctx context.Context, requestType string, gradeID int64) (string, error) {
+	var mode string
 
 	err := r.db.QueryRow(
 		ctx,
-		ruleQueryGetByTypeAndGrade,
+		ruleQueryGetEvaluationMode,
 		requestType, gradeID,
-	).Scan(&rule.ID, &conditionJSON, &rule.Action)
+	).Scan(&mode)
 
+	// no explicit mode configured = first match
+	if err == pgx.ErrNoRows {
+		return constants.RuleModeFirstMatch, nil
+	}
 	if err != nil {
-		return nil, apperrors.ErrNoRuleFound
+		return "", utils.MapPgError(err)
 	}
 
-	err = json.Unmarshal(conditionJSON, &rule.Condition)
+	return mode, nil
+}
+
+// GetEvaluationModes lists every explicitly configured evaluation mode
+func (r *ruleRepository) GetEvaluationModes(ctx context.Context) ([]models.EvaluationMode, error) {
+	return getEvaluationModes(ctx, r.db)
+}
+
+// GetEvaluationModesInTx lists the configured evaluation modes as the transaction sees them
+func (r *ruleRepository) GetEvaluationModesInTx(ctx context.Context, tx interfaces.Tx) ([]models.EvaluationMode, error) {
+	return getEvaluationModes(ctx, tx)
+}
+
+func getEvaluationModes(ctx context.Context, q policyQuerier) ([]models.EvaluationMode, error) {
+	rows, err := q.Query(ctx, ruleQueryGetEvaluationModes)
 	if err != nil {
-		return nil, apperrors.ErrDatabase // Or a specific JSON error
+		return nil, utils.MapPgError(err)
 	}
+	defer rows.Close()
 
-	return &rule, nil
+	var modes []models.EvaluationMode
+	for rows.Next() {
+		var m models.EvaluationMode
+		if err := rows.Scan(&m.RequestType, &m.GradeID, &m.Mode); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		modes = append(modes, m)
+	}
+
+	return modes, utils.MapPgError(rows.Err())
 }
 
-func (r *ruleRepository) Create(ctx context.Context, rule *models.Rule) error {
+func (r *ruleRepository) SetEvaluationMode(ctx context.Context, tx interfaces.Tx, requestType string, gradeID int64, mode string) error {
+	_, err := tx.Exec(
+		ctx,
+		ruleQuerySetEvaluationMode,
+		requestType, gradeID, mode,
+	)
+
+	return utils.MapPgError(err)
+}
+
+func (r *ruleRepository) Create(ctx context.Context, tx interfaces.Tx, rule *models.Rule) error {
 	conditionJSON, err := json.Marshal(rule.Condition)
 	if err != nil {
 		return apperrors.ErrInvalidConditionJSON
 	}
 
-	_, err = r.db.Exec(
+	err = tx.QueryRow(
 		ctx,
 		ruleQueryCreate,
 		rule.RequestType,
@@ -80,13 +191,103 @@ func (r *ruleRepository) Create(ctx context.Context, rule *models.Rule) error {
 		rule.Action,
 		rule.GradeID,
 		rule.Active,
-	)
+		rule.Priority,
+		rule.IsDefault,
+		rule.Message,
+		rule.RouteToRole,
+		rule.RouteToUserID,
+		effectiveFromArg(rule.EffectiveFrom),
+	).Scan(&rule.ID, &rule.RuleKey, &rule.Version, &rule.EffectiveFrom)
+
+	return utils.MapPgError(err)
+}
+
+// InsertVersion stores a new version of an existing rule
+func (r *ruleRepository) InsertVersion(ctx context.Context, tx interfaces.Tx, rule *models.Rule) error {
+	conditionJSON, err := json.Marshal(rule.Condition)
+	if err != nil {
+		return apperrors.ErrInvalidConditionJSON
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		ruleQueryInsertVersion,
+		rule.RuleKey,
+		rule.Version,
+		rule.RequestType,
+		conditionJSON,
+		rule.Action,
+		rule.GradeID,
+		rule.Active,
+		rule.Priority,
+		rule.IsDefault,
+		rule.Message,
+		rule.RouteToRole,
+		rule.RouteToUserID,
+		rule.EffectiveFrom,
+	).Scan(&rule.ID)
 
 	return utils.MapPgError(err)
 }
 
+// GetLatestVersion locks and returns the newest version of the rule that ruleID belongs to
+func (r *ruleRepository) GetLatestVersion(ctx context.Context, tx interfaces.Tx, ruleID int64) (*models.Rule, error) {
+	rows, err := tx.Query(ctx, ruleQueryGetLatestVersion, ruleID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	rules, err := scanRules(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, apperrors.ErrNoRuleFound
+	}
+
+	return &rules[0], nil
+}
+
+// CloseOpenVersions ends at effectiveTo every version of a rule that is effective then
+// or scheduled to be: the current version and any scheduled one
+func (r *ruleRepository) CloseOpenVersions(ctx context.Context, tx interfaces.Tx, ruleKey int64, effectiveTo time.Time) error {
+	_, err := tx.Exec(ctx, ruleQueryCloseOpenVersions, effectiveTo, ruleKey)
+	return utils.MapPgError(err)
+}
+
+// GetHistory lists every version of the rule that ruleID belongs to, oldest first
+func (r *ruleRepository) GetHistory(ctx context.Context, ruleID int64) ([]models.Rule, error) {
+	rows, err := r.db.Query(ctx, ruleQueryGetHistory, ruleID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	rules, err := scanRules(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, apperrors.ErrNoRuleFound
+	}
+
+	return rules, nil
+}
+
 func (r *ruleRepository) GetAll(ctx context.Context) ([]models.Rule, error) {
-	rows, err := r.db.Query(
+	return getRules(ctx, r.db)
+}
+
+// GetAllInTx lists the current and scheduled rule versions as the transaction sees them
+func (r *ruleRepository) GetAllInTx(ctx context.Context, tx interfaces.Tx) ([]models.Rule, error) {
+	return getRules(ctx, tx)
+}
+
+func getRules(ctx context.Context, q policyQuerier) ([]models.Rule, error) {
+	rows, err := q.Query(
 		ctx,
 		ruleQueryGetAll,
 	)
@@ -95,6 +296,67 @@ func (r *ruleRepository) GetAll(ctx context.Context) ([]models.Rule, error) {
 	}
 	defer rows.Close()
 
+	return scanRules(rows)
+}
+
+// LockPolicy locks the grades, holidays, evaluation modes and rules against changes by
+// other transactions until tx ends
+func (r *ruleRepository) LockPolicy(ctx context.Context, tx interfaces.Tx) error {
+	_, err := tx.Exec(ctx, ruleQueryLockPolicy)
+	return utils.MapPgError(err)
+}
+
+// policyQuerier is satisfied by both interfaces.DB and interfaces.Tx
+type policyQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// GetSimulationSamples loads the historical requests of a grade created in [from, to)
+func (r *ruleRepository) GetSimulationSamples(ctx context.Context, requestType string, gradeID int64, from, to time.Time) ([]models.SimulationSample, error) {
+	var query string
+	switch requestType {
+	case "LEAVE":
+		query = ruleQuerySimulationLeaves
+	case "EXPENSE":
+		query = ruleQuerySimulationExpenses
+	case "DISCOUNT":
+		query = ruleQuerySimulationDiscounts
+	default:
+		return nil, apperrors.ErrInvalidRequestType
+	}
+
+	rows, err := r.db.Query(ctx, query, gradeID, from, to)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var samples []models.SimulationSample
+	for rows.Next() {
+		var sample models.SimulationSample
+
+		switch requestType {
+		case "LEAVE":
+			err = rows.Scan(&sample.RequestID, &sample.Status, &sample.CreatedAt, &sample.FromDate, &sample.ToDate,
+				&sample.Days, &sample.LeaveType, &sample.Role, &sample.GradeID, &sample.UserCreatedAt)
+		case "EXPENSE":
+			err = rows.Scan(&sample.RequestID, &sample.Status, &sample.CreatedAt, &sample.Amount, &sample.Category,
+				&sample.Role, &sample.GradeID, &sample.UserCreatedAt)
+		case "DISCOUNT":
+			err = rows.Scan(&sample.RequestID, &sample.Status, &sample.CreatedAt, &sample.Percent,
+				&sample.Role, &sample.GradeID, &sample.UserCreatedAt)
+		}
+		if err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		samples = append(samples, sample)
+	}
+
+	return samples, utils.MapPgError(rows.Err())
+}
+
+func scanRules(rows interfaces.Rows) ([]models.Rule, error) {
 	var rules []models.Rule
 
 	for rows.Next() {
@@ -108,6 +370,15 @@ func (r *ruleRepository) GetAll(ctx context.Context) ([]models.Rule, error) {
 			&rule.Action,
 			&rule.GradeID,
 			&rule.Active,
+			&rule.Priority,
+			&rule.IsDefault,
+			&rule.Message,
+			&rule.RouteToRole,
+			&rule.RouteToUserID,
+			&rule.RuleKey,
+			&rule.Version,
+			&rule.EffectiveFrom,
+			&rule.EffectiveTo,
 		); err != nil {
 			return nil, utils.MapPgError(err)
 		}
@@ -116,51 +387,13 @@ func (r *ruleRepository) GetAll(ctx context.Context) ([]models.Rule, error) {
 		rules = append(rules, rule)
 	}
 
-	return rules, nil
-}
-
-func (r *ruleRepository) Update(ctx context.Context, ruleID int64, rule *models.Rule) error {
-	conditionJSON, err := json.Marshal(rule.Condition)
-	if err != nil {
-		return apperrors.ErrInvalidConditionJSON
-	}
-
-	cmd, err := r.db.Exec(
-		ctx,
-		ruleQueryUpdate,
-		rule.RequestType,
-		conditionJSON,
-		rule.Action,
-		rule.GradeID,
-		rule.Active,
-		ruleID,
-	)
-
-	if err != nil {
-		return utils.MapPgError(err)
-	}
-
-	if cmd.RowsAffected() == 0 {
-		return apperrors.ErrNoRuleFound
-	}
-
-	return nil
+	return rules, utils.MapPgError(rows.Err())
 }
 
-func (r *ruleRepository) Delete(ctx context.Context, ruleID int64) error {
-	cmd, err := r.db.Exec(
-		ctx,
-		ruleQueryDelete,
-		ruleID,
-	)
-
-	if err != nil {
-		return utils.MapPgError(err)
-	}
-
-	if cmd.RowsAffected() == 0 {
-		return apperrors.ErrRuleNotFoundForDelete
+// effectiveFromArg lets the database default an unset effective date to NOW()
+func effectiveFromArg(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
 	}
-
-	return nil
+	return &t
 }
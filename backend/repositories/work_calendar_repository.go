@@ -0,0 +1,263 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// calendarQuerySelect lists calendars c with their working weekdays and the users
+	// following them; users without a calendar follow the default one
+	calendarQuerySelect = `SELECT c.id, c.name, c.is_default, c.updated_by, c.updated_at,
+		        ARRAY(SELECT w.weekday FROM work_week w
+		              WHERE w.calendar_id = c.id AND w.working ORDER BY w.weekday),
+		        (SELECT COUNT(*) FROM users u
+		         WHERE u.calendar_id = c.id OR (c.is_default AND u.calendar_id IS NULL))
+		 FROM work_calendars c`
+	calendarQueryGetAll     = calendarQuerySelect + ` ORDER BY c.is_default DESC, c.name`
+	calendarQueryGetByID    = calendarQuerySelect + ` WHERE c.id = $1`
+	calendarQueryGetDefault = calendarQuerySelect + ` WHERE c.is_default`
+
+	calendarQueryCreate = `INSERT INTO work_calendars (name, updated_by)
+		 VALUES ($1, $2)
+		 RETURNING id, is_default, updated_at`
+	calendarQueryUpdate = `UPDATE work_calendars
+		 SET name = $2, updated_by = $3, updated_at = NOW()
+		 WHERE id = $1
+		 RETURNING is_default, updated_at`
+	calendarQueryDelete      = `DELETE FROM work_calendars WHERE id = $1 AND NOT is_default`
+	calendarQuerySetWorkWeek = `INSERT INTO work_week (calendar_id, weekday, working, updated_by)
+		 SELECT $1, d, d = ANY($2::INT[]), $3 FROM generate_series(0, 6) AS d
+		 ON CONFLICT (calendar_id, weekday) DO UPDATE
+		 SET working = EXCLUDED.working, updated_by = EXCLUDED.updated_by, updated_at = NOW()`
+	calendarQueryAssignUsers = `UPDATE users SET calendar_id = $1 WHERE id = ANY($2)`
+
+	calendarQueryUserWorkWeek = `SELECT c.id,
+		        ARRAY(SELECT w.weekday FROM work_week w
+		              WHERE w.calendar_id = c.id AND w.working ORDER BY w.weekday)
+		 FROM users u
+		 JOIN work_calendars c
+		   ON c.id = COALESCE(u.calendar_id, (SELECT id FROM work_calendars WHERE is_default))
+		 WHERE u.id = $1`
+	calendarQueryHolidaysBetween = `SELECT TO_CHAR(holiday_date, 'YYYY-MM-DD'), COALESCE(description, '')
+		 FROM holidays WHERE calendar_id = $1 AND holiday_date BETWEEN $2 AND $3`
+	calendarQueryAllWorkWeeks = `SELECT c.id,
+		        ARRAY(SELECT w.weekday FROM work_week w
+		              WHERE w.calendar_id = c.id AND w.working ORDER BY w.weekday)
+		 FROM work_calendars c`
+	calendarQueryAllHolidays = `SELECT calendar_id, TO_CHAR(holiday_date, 'YYYY-MM-DD'), COALESCE(description, '')
+		 FROM holidays`
+)
+
+// The work calendar part of HolidayRepository: named calendars, their working weeks and
+// the users following them
+
+// GetCalendars lists the calendars, the default one first
+func (r *holidayRepository) GetCalendars(ctx context.Context) ([]models.Calendar, error) {
+	rows, err := r.db.Query(ctx, calendarQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	calendars := []models.Calendar{}
+	for rows.Next() {
+		c, err := scanCalendar(rows)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, *c)
+	}
+
+	return calendars, utils.MapPgError(rows.Err())
+}
+
+func (r *holidayRepository) GetCalendar(ctx context.Context, calendarID int64) (*models.Calendar, error) {
+	return scanCalendar(r.db.QueryRow(ctx, calendarQueryGetByID, calendarID))
+}
+
+func (r *holidayRepository) GetDefaultCalendar(ctx context.Context) (*models.Calendar, error) {
+	return scanCalendar(r.db.QueryRow(ctx, calendarQueryGetDefault))
+}
+
+// GetDefaultCalendarInTx returns the default calendar as the transaction sees it
+func (r *holidayRepository) GetDefaultCalendarInTx(ctx context.Context, tx interfaces.Tx) (*models.Calendar, error) {
+	return scanCalendar(tx.QueryRow(ctx, calendarQueryGetDefault))
+}
+
+func scanCalendar(row pgx.Row) (*models.Calendar, error) {
+	var c models.Calendar
+	var weekdays []int32
+
+	err := row.Scan(&c.ID, &c.Name, &c.IsDefault, &c.UpdatedBy, &c.UpdatedAt, &weekdays, &c.Users)
+	if err == pgx.ErrNoRows {
+		return nil, apperrors.ErrCalendarNotFound
+	}
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	c.WorkingDays = make([]string, len(weekdays))
+	for i, d := range weekdays {
+		c.WorkingDays[i] = time.Weekday(d).String()
+	}
+	return &c, nil
+}
+
+// CreateCalendar adds a calendar; its work week is set separately
+func (r *holidayRepository) CreateCalendar(ctx context.Context, tx interfaces.Tx, calendar *models.Calendar) error {
+	err := tx.QueryRow(
+		ctx,
+		calendarQueryCreate,
+		calendar.Name,
+		calendar.UpdatedBy,
+	).Scan(&calendar.ID, &calendar.IsDefault, &calendar.UpdatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// UpdateCalendar renames a calendar
+func (r *holidayRepository) UpdateCalendar(ctx context.Context, tx interfaces.Tx, calendar *models.Calendar) error {
+	err := tx.QueryRow(
+		ctx,
+		calendarQueryUpdate,
+		calendar.ID,
+		calendar.Name,
+		calendar.UpdatedBy,
+	).Scan(&calendar.IsDefault, &calendar.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return apperrors.ErrCalendarNotFound
+	}
+
+	return utils.MapPgError(err)
+}
+
+// DeleteCalendar removes a calendar other than the default one with its holidays. Its
+// users go back to the default calendar.
+func (r *holidayRepository) DeleteCalendar(ctx context.Context, calendarID int64) error {
+	tag, err := r.db.Exec(ctx, calendarQueryDelete, calendarID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrCalendarNotFound
+	}
+
+	return nil
+}
+
+// SetWorkWeek makes the given days the working days of a calendar and the others days off
+func (r *holidayRepository) SetWorkWeek(ctx context.Context, tx interfaces.Tx, calendarID int64, workingDays []time.Weekday, adminID int64) error {
+	days := make([]int32, len(workingDays))
+	for i, d := range workingDays {
+		days[i] = int32(d)
+	}
+
+	_, err := tx.Exec(ctx, calendarQuerySetWorkWeek, calendarID, days, adminID)
+	return utils.MapPgError(err)
+}
+
+// AssignCalendar moves users to a calendar and returns how many were found
+func (r *holidayRepository) AssignCalendar(ctx context.Context, calendarID int64, userIDs []int64) (int64, error) {
+	tag, err := r.db.Exec(ctx, calendarQueryAssignUsers, calendarID, userIDs)
+	if err != nil {
+		return 0, utils.MapPgError(err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// GetUserWorkCalendar returns the work week of a user's calendar with its holidays from
+// from to to
+func (r *holidayRepository) GetUserWorkCalendar(ctx context.Context, userID int64, from, to time.Time) (models.WorkCalendar, error) {
+	var calendar models.WorkCalendar
+	var weekdays []int32
+
+	err := r.db.QueryRow(ctx, calendarQueryUserWorkWeek, userID).Scan(&calendar.CalendarID, &weekdays)
+	if err == pgx.ErrNoRows {
+		return calendar, apperrors.ErrUserNotFound
+	}
+	if err != nil {
+		return calendar, utils.MapPgError(err)
+	}
+	calendar.WorkingDays = toWeekdays(weekdays)
+
+	rows, err := r.db.Query(ctx, calendarQueryHolidaysBetween, calendar.CalendarID, from, to)
+	if err != nil {
+		return calendar, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	calendar.Holidays = map[string]string{}
+	for rows.Next() {
+		var date, description string
+		if err := rows.Scan(&date, &description); err != nil {
+			return calendar, utils.MapPgError(err)
+		}
+		calendar.Holidays[date] = description
+	}
+
+	return calendar, utils.MapPgError(rows.Err())
+}
+
+// GetWorkCalendars returns every calendar with its work week and all its holidays, keyed
+// by calendar id, for callers that count days for many users at once
+func (r *holidayRepository) GetWorkCalendars(ctx context.Context) (map[int64]models.WorkCalendar, error) {
+	rows, err := r.db.Query(ctx, calendarQueryAllWorkWeeks)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	calendars := map[int64]models.WorkCalendar{}
+	for rows.Next() {
+		var id int64
+		var weekdays []int32
+		if err := rows.Scan(&id, &weekdays); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		calendars[id] = models.WorkCalendar{
+			CalendarID:  id,
+			WorkingDays: toWeekdays(weekdays),
+			Holidays:    map[string]string{},
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, utils.MapPgError(err)
+	}
+
+	holidays, err := r.db.Query(ctx, calendarQueryAllHolidays)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer holidays.Close()
+
+	for holidays.Next() {
+		var id int64
+		var date, description string
+		if err := holidays.Scan(&id, &date, &description); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		if calendar, ok := calendars[id]; ok {
+			calendar.Holidays[date] = description
+		}
+	}
+
+	return calendars, utils.MapPgError(holidays.Err())
+}
+
+func toWeekdays(days []int32) []time.Weekday {
+	weekdays := make([]time.Weekday, len(days))
+	for i, d := range days {
+		weekdays[i] = time.Weekday(d)
+	}
+	return weekdays
+}
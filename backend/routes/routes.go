@@ -4,14 +4,23 @@ import (
 	"context"
 	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auth"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/balance_policies"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/escalations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/feeds"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/job_runs"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_service"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_types"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/my_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/reports"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/scheduler"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/middleware"
 	"github.com/gin-gonic/gin"
@@ -33,6 +42,14 @@ func Register(
 	autoRejectService interfaces.AutoRejectService,
 	discountService interfaces.DiscountService,
 	discountApprovalService interfaces.DiscountApprovalService,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	escalationService interfaces.EscalationService,
+	jobRunService interfaces.JobRunService,
+	schedulerService interfaces.SchedulerService,
+	balancePolicyService interfaces.BalancePolicyService,
+	leaveTypeService interfaces.LeaveTypeService,
+	feedService interfaces.FeedService,
 ) {
 	// Initialize handlers
 	authHandler := auth.NewAuthHandler(ctx, authService)
@@ -47,6 +64,15 @@ func Register(
 	balanceHandler := domain_service.NewBalanceHandler(ctx, balanceService)
 	discountHandler := domain_service.NewDiscountHandler(ctx, discountService)
 	discountApprovalHandler := domain_service.NewDiscountApprovalHandler(ctx, discountApprovalService)
+	chainHandler := approval_chains.NewApprovalChainHandler(ctx, chainService)
+	delegationHandler := delegations.NewDelegationHandler(ctx, delegationService)
+	escalationHandler := escalations.NewEscalationHandler(ctx, escalationService)
+	systemHandler := auto_reject.NewSystemHandler(ctx, autoRejectService)
+	jobRunHandler := job_runs.NewJobRunHandler(ctx, jobRunService)
+	schedulerHandler := scheduler.NewSchedulerHandler(ctx, schedulerService)
+	balancePolicyHandler := balance_policies.NewBalancePolicyHandler(ctx, balancePolicyService)
+	leaveTypeHandler := leave_types.NewLeaveTypeHandler(ctx, leaveTypeService)
+	feedHandler := feeds.NewFeedHandler(ctx, feedService)
 
 	// Health check endpoint (root level, no auth required)
 	router.GET("/health", func(c *gin.Context) {
@@ -80,6 +106,10 @@ func Register(
 		authGroup.POST("/logout", authHandler.Logout) // Added logout
 	}
 
+	// iCalendar feeds calendar apps subscribe to, opened by the feed token in the path
+	public.GET("/feeds/:token/leaves.ics", feedHandler.GetLeaveFeed)
+	public.GET("/feeds/:token/holidays.ics", feedHandler.GetHolidayFeed)
+
 	// Protected routes
 	protected := router.Group("/api")
 	protected.Use(middleware.JWTAuth())
@@ -102,6 +132,8 @@ func Register(
 			leaves.GET("/pending", leaveApprovalHandler.GetPendingLeaves)
 			leaves.POST("/:id/approve", leaveApprovalHandler.ApproveLeave)
 			leaves.POST("/:id/reject", leaveApprovalHandler.RejectLeave)
+			leaves.GET("/:id/approval-steps", chainHandler.GetLeaveSteps)
+			leaves.GET("/:id/escalations", escalationHandler.GetLeaveEscalations)
 		}
 
 		// Expense routes
@@ -114,6 +146,8 @@ func Register(
 			expenses.GET("/pending", expenseApprovalHandler.GetPendingExpenses)
 			expenses.POST("/:id/approve", expenseApprovalHandler.ApproveExpense)
 			expenses.POST("/:id/reject", expenseApprovalHandler.RejectExpense)
+			expenses.GET("/:id/approval-steps", chainHandler.GetExpenseSteps)
+			expenses.GET("/:id/escalations", escalationHandler.GetExpenseEscalations)
 		}
 
 		// Discount routes
@@ -126,6 +160,8 @@ func Register(
 			discounts.GET("/pending", discountApprovalHandler.GetPendingDiscounts)
 			discounts.POST("/:id/approve", discountApprovalHandler.ApproveDiscount)
 			discounts.POST("/:id/reject", discountApprovalHandler.RejectDiscount)
+			discounts.GET("/:id/approval-steps", chainHandler.GetDiscountSteps)
+			discounts.GET("/:id/escalations", escalationHandler.GetDiscountEscalations)
 		}
 
 		// Rule routes
@@ -135,16 +171,69 @@ func Register(
 		{
 			admin.POST("/rules", ruleHandler.CreateRule)
 			admin.GET("/rules", ruleHandler.GetRules)
+			admin.PUT("/rules/evaluation-mode", ruleHandler.SetEvaluationMode)
+			admin.POST("/rules/simulate", ruleHandler.SimulateRules)
+			admin.GET("/rules/analysis", ruleHandler.AnalyzeRules)
+			admin.GET("/rules/export", ruleHandler.ExportRules)
+			admin.POST("/rules/import", ruleHandler.ImportRules)
 			admin.PUT("/rules/:id", ruleHandler.UpdateRule)
 			admin.DELETE("/rules/:id", ruleHandler.DeleteRule)
+			admin.GET("/rules/:id/history", ruleHandler.GetRuleHistory)
+			admin.POST("/rules/:id/rollback", ruleHandler.RollbackRule)
 
 			admin.POST("/holidays", holidayHandler.AddHoliday)
 			admin.GET("/holidays", holidayHandler.GetHolidays)
 			admin.DELETE("/holidays/:id", holidayHandler.DeleteHoliday)
+			admin.POST("/holidays/import", holidayHandler.ImportHolidays)
+			admin.GET("/holidays/export", holidayHandler.ExportHolidays)
+			admin.GET("/work-week", holidayHandler.GetWorkWeek)
+			admin.PUT("/work-week", holidayHandler.SetWorkWeek)
+
+			// Work calendars: each office's work week and holidays, and the users following it
+			admin.GET("/calendars", holidayHandler.GetCalendars)
+			admin.POST("/calendars", holidayHandler.CreateCalendar)
+			admin.PUT("/calendars/:id", holidayHandler.UpdateCalendar)
+			admin.DELETE("/calendars/:id", holidayHandler.DeleteCalendar)
+			admin.PUT("/calendars/:id/users", holidayHandler.AssignCalendar)
+
+			admin.POST("/approval-chains", chainHandler.CreateChain)
+			admin.GET("/approval-chains", chainHandler.GetChains)
+			admin.DELETE("/approval-chains/:id", chainHandler.DeleteChain)
+
+			// SLA policies for escalation and auto-rejection
+			admin.GET("/sla-policies", escalationHandler.GetPolicies)
+			admin.POST("/sla-policies", escalationHandler.CreatePolicy)
+			admin.PUT("/sla-policies/:id", escalationHandler.UpdatePolicy)
+			admin.DELETE("/sla-policies/:id", escalationHandler.DeletePolicy)
+
+			// Auto-reject job: dry run and manual trigger
+			admin.GET("/auto-reject/preview", systemHandler.PreviewAutoReject)
+			admin.POST("/auto-reject/run", systemHandler.RunAutoReject)
+			admin.GET("/job-runs", jobRunHandler.GetJobRuns)
+
+			// Scheduled background jobs
+			admin.GET("/jobs", schedulerHandler.GetJobs)
+			admin.POST("/jobs/:name/pause", schedulerHandler.PauseJob)
+			admin.POST("/jobs/:name/resume", schedulerHandler.ResumeJob)
+			admin.POST("/jobs/:name/trigger", schedulerHandler.TriggerJob)
+
+			// Balance reset, accrual and carry-forward policies
+			admin.GET("/balance-policies", balancePolicyHandler.GetPolicies)
+			admin.PUT("/balance-policies/:type", balancePolicyHandler.SetPolicy)
+			admin.DELETE("/balance-policies/:type", balancePolicyHandler.DeletePolicy)
+
+			// Leave type catalog
+			admin.PUT("/leave-types/:code", leaveTypeHandler.SetLeaveType)
+			admin.DELETE("/leave-types/:code", leaveTypeHandler.DeleteLeaveType)
+
+			// Balance adjustments
+			admin.POST("/users/:id/balances/adjust", balanceHandler.AdjustBalance)
+			admin.POST("/balances/adjust/bulk", balanceHandler.BulkAdjustBalances)
 
 			// Admin Reports
 			admin.GET("/reports/request-status-distribution", reportHandler.GetRequestStatusDistribution)
 			admin.GET("/reports/requests-by-type", reportHandler.GetRequestsByType)
+			admin.GET("/reports/leave-by-calendar", reportHandler.GetLeaveByCalendar)
 		}
 
 		// My Requests routes (Legacy/General)
@@ -152,10 +241,24 @@ func Register(
 		protected.GET("/my-requests/all", myRequestsHandler.GetMyAllRequests)
 		protected.GET("/pending/all", myRequestsHandler.GetPendingAllRequests)
 
+		// Approval delegation routes
+		protected.POST("/delegations", delegationHandler.CreateDelegation)
+		protected.GET("/delegations", delegationHandler.GetDelegations)
+		protected.DELETE("/delegations/:id", delegationHandler.RevokeDelegation)
+
+		// Calendar feed tokens
+		protected.POST("/feeds", feedHandler.CreateFeedToken)
+		protected.GET("/feeds", feedHandler.GetFeedTokens)
+		protected.DELETE("/feeds/:id", feedHandler.RevokeFeedToken)
+
 		// Report routes
 		protected.GET("/reports/dashboard", reportHandler.GetDashboardSummary)
 
 		// Balance routes
 		protected.GET("/balances", balanceHandler.GetBalances)
+		protected.GET("/balances/history", balanceHandler.GetBalanceHistory)
+
+		// Leave types requests can be made for
+		protected.GET("/leave-types", leaveTypeHandler.GetLeaveTypes)
 	}
 }